@@ -13,6 +13,9 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 )
 
+// generatedRegistry is the default registry with the generated struct codecs registered.
+var generatedRegistry = registerGeneratedCodecs(bson.NewRegistryBuilder()).Build()
+
 func BSONFlatStructDecoding(ctx context.Context, tm TimerManager, iters int) error {
 	r, err := loadSourceRaw(getProjectRoot(), perfDataDir, bsonDataDir, flatBSONData)
 	if err != nil {
@@ -101,3 +104,47 @@ func BSONFlatStructTagsDecoding(ctx context.Context, tm TimerManager, iters int)
 	}
 	return nil
 }
+
+func BSONFlatStructTagsGeneratedEncoding(ctx context.Context, tm TimerManager, iters int) error {
+	r, err := loadSourceRaw(getProjectRoot(), perfDataDir, bsonDataDir, flatBSONData)
+	if err != nil {
+		return err
+	}
+
+	doc := flatBSONTags{}
+	err = bson.UnmarshalWithRegistry(generatedRegistry, r, &doc)
+	if err != nil {
+		return err
+	}
+
+	var buf []byte
+
+	tm.ResetTimer()
+	for i := 0; i < iters; i++ {
+		buf, err = bson.MarshalAppendWithRegistry(generatedRegistry, buf[:0], doc)
+		if err != nil {
+			return err
+		}
+		if len(buf) == 0 {
+			return errors.New("encoding failed")
+		}
+	}
+	return nil
+}
+
+func BSONFlatStructTagsGeneratedDecoding(ctx context.Context, tm TimerManager, iters int) error {
+	r, err := loadSourceRaw(getProjectRoot(), perfDataDir, bsonDataDir, flatBSONData)
+	if err != nil {
+		return err
+	}
+
+	tm.ResetTimer()
+	for i := 0; i < iters; i++ {
+		out := flatBSONTags{}
+		err := bson.UnmarshalWithRegistry(generatedRegistry, r, &out)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
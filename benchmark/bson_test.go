@@ -31,5 +31,11 @@ func BenchmarkBSONDeepMapEncoding(b *testing.B) { WrapCase(BSONDeepMapEncoding)(
 
 func BenchmarkBSONFlatStructDecoding(b *testing.B)     { WrapCase(BSONFlatStructDecoding)(b) }
 func BenchmarkBSONFlatStructTagsDecoding(b *testing.B) { WrapCase(BSONFlatStructTagsDecoding)(b) }
+func BenchmarkBSONFlatStructTagsGeneratedDecoding(b *testing.B) {
+	WrapCase(BSONFlatStructTagsGeneratedDecoding)(b)
+}
 func BenchmarkBSONFlatStructEncoding(b *testing.B)     { WrapCase(BSONFlatStructEncoding)(b) }
 func BenchmarkBSONFlatStructTagsEncoding(b *testing.B) { WrapCase(BSONFlatStructTagsEncoding)(b) }
+func BenchmarkBSONFlatStructTagsGeneratedEncoding(b *testing.B) {
+	WrapCase(BSONFlatStructTagsGeneratedEncoding)(b)
+}
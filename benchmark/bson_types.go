@@ -6,6 +6,8 @@
 
 package benchmark
 
+//go:generate bsoncodecgen -type flatBSONTags -register registerGeneratedCodecs -output bson_types_bsoncodec.go
+
 import "go.mongodb.org/mongo-driver/bson/primitive"
 
 type flatBSONTags struct {
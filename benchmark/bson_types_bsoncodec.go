@@ -0,0 +1,3485 @@
+// Code generated by bsoncodecgen. DO NOT EDIT.
+
+package benchmark
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// registerGeneratedCodecs registers the generated BSON codecs with rb.
+func registerGeneratedCodecs(rb *bsoncodec.RegistryBuilder) *bsoncodec.RegistryBuilder {
+	rb.RegisterTypeEncoder(flatBSONTagsBSONCodecType, flatBSONTagsBSONCodec{})
+	rb.RegisterTypeDecoder(flatBSONTagsBSONCodecType, flatBSONTagsBSONCodec{})
+	return rb
+}
+
+var flatBSONTagsBSONCodecType = reflect.TypeOf(flatBSONTags{})
+
+// flatBSONTagsBSONCodec is the generated BSON codec for flatBSONTags.
+type flatBSONTagsBSONCodec struct{}
+
+// EncodeValue implements the bsoncodec.ValueEncoder interface.
+func (c flatBSONTagsBSONCodec) EncodeValue(ec bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != flatBSONTagsBSONCodecType {
+		return bsoncodec.ValueEncoderError{Name: "flatBSONTagsBSONCodec.EncodeValue", Types: []reflect.Type{flatBSONTagsBSONCodecType}, Received: val}
+	}
+
+	var s *flatBSONTags
+	if val.CanAddr() {
+		s = val.Addr().Interface().(*flatBSONTags)
+	} else {
+		v := val.Interface().(flatBSONTags)
+		s = &v
+	}
+
+	dw, err := vw.WriteDocument()
+	if err != nil {
+		return err
+	}
+	var evw bsonrw.ValueWriter
+
+	evw, err = dw.WriteDocumentElement("_id")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteObjectID(s.ID)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("_id", reflect.ValueOf(s.ID), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("AAgSNVyBb")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.AA)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("AAgSNVyBb", reflect.ValueOf(s.AA), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("aicoMxZq")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.AI)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("aicoMxZq", reflect.ValueOf(s.AI), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("AMQrGQmu")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.AM)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("AMQrGQmu", reflect.ValueOf(s.AM), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("AgYYbYPr")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.Ag); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("AgYYbYPr", reflect.ValueOf(s.Ag), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("ahFCBmqT")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.Ah)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("ahFCBmqT", reflect.ValueOf(s.Ah), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("AtWNZJXa")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.At)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("AtWNZJXa", reflect.ValueOf(s.At), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("BBqZInWV")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.BB)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("BBqZInWV", reflect.ValueOf(s.BB), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("bkuaZWRT")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.BK)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("bkuaZWRT", reflect.ValueOf(s.BK), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("BwTXiovJ")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.Bw); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("BwTXiovJ", reflect.ValueOf(s.Bw), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("CDIGOuIZ")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.CD); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("CDIGOuIZ", reflect.ValueOf(s.CD), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("CEtYKsdd")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.CEA)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("CEtYKsdd", reflect.ValueOf(s.CEA), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("cepcgozk")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.CEB)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("cepcgozk", reflect.ValueOf(s.CEB), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("CFujXoob")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.CF); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("CFujXoob", reflect.ValueOf(s.CF), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("cVjWCrlu")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.CV)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("cVjWCrlu", reflect.ValueOf(s.CV), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("cxOHMeDJ")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.CX)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("cxOHMeDJ", reflect.ValueOf(s.CX), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("CYhSCkWB")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.CY)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("CYhSCkWB", reflect.ValueOf(s.CY), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("CqCssWxW")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.Cq)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("CqCssWxW", reflect.ValueOf(s.Cq), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("dCLfYqqM")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.DC); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("dCLfYqqM", reflect.ValueOf(s.DC), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("ddPdLgGg")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.DDA); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("ddPdLgGg", reflect.ValueOf(s.DDA), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("ddVenEkK")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.DDB); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("ddVenEkK", reflect.ValueOf(s.DDB), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("dHsYhRbV")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.DH)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("dHsYhRbV", reflect.ValueOf(s.DH), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("DJsnHZIC")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.DJ); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("DJsnHZIC", reflect.ValueOf(s.DJ), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("dNSuxlSU")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.DN)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("dNSuxlSU", reflect.ValueOf(s.DN), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("doshbrpF")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.DO)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("doshbrpF", reflect.ValueOf(s.DO), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("dpbwfSRb")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.DP)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("dpbwfSRb", reflect.ValueOf(s.DP), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("DQBQcQFj")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.DQ)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("DQBQcQFj", reflect.ValueOf(s.DQ), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("dtywOLeD")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.DT)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("dtywOLeD", reflect.ValueOf(s.DT), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("dVkWIafN")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.DV); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("dVkWIafN", reflect.ValueOf(s.DV), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("egxZaSsw")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.EG)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("egxZaSsw", reflect.ValueOf(s.EG), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("eRTIdIJR")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.ER)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("eRTIdIJR", reflect.ValueOf(s.ER), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("FDYGeSiR")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.FD)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("FDYGeSiR", reflect.ValueOf(s.FD), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("fEheUtop")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.FE)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("fEheUtop", reflect.ValueOf(s.FE), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("FpduyhQP")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.Fp)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("FpduyhQP", reflect.ValueOf(s.Fp), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("gErhgZTh")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.GE)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("gErhgZTh", reflect.ValueOf(s.GE), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("gySFZeAE")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.GY); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("gySFZeAE", reflect.ValueOf(s.GY), err)
+	}
+
+	err = bsoncodec.EncodeStructField(ec, dw, "GiAHzFII", val.Field(36), false, false)
+	if err != nil {
+		return err
+	}
+
+	evw, err = dw.WriteDocumentElement("hnVgYIQi")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.HN)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("hnVgYIQi", reflect.ValueOf(s.HN), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("HQeCoswW")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.HQA); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("HQeCoswW", reflect.ValueOf(s.HQA), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("HQiykral")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.HQB); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("HQiykral", reflect.ValueOf(s.HQB), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("HVHyetUM")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.HV)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("HVHyetUM", reflect.ValueOf(s.HV), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("hwHOTmmW")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.HW); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("hwHOTmmW", reflect.ValueOf(s.HW), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("HicJbMpj")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.Hi)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("HicJbMpj", reflect.ValueOf(s.Hi), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("HrUPbFHD")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.Hr); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("HrUPbFHD", reflect.ValueOf(s.Hr), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("iFFGfTXc")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.IF)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("iFFGfTXc", reflect.ValueOf(s.IF), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("ijwXMKqI")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.IJ); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("ijwXMKqI", reflect.ValueOf(s.IJ), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("iwfbMdcv")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.IW); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("iwfbMdcv", reflect.ValueOf(s.IW), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("Ibrdrtgg")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.Ib)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("Ibrdrtgg", reflect.ValueOf(s.Ib), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("IsorvnMR")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.Is)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("IsorvnMR", reflect.ValueOf(s.Is), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("jbUymqiB")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.JB)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("jbUymqiB", reflect.ValueOf(s.JB), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("jmglLvAS")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.JM)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("jmglLvAS", reflect.ValueOf(s.JM), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("jWaFvVAz")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.JW); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("jWaFvVAz", reflect.ValueOf(s.JW), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("JXMyYkfb")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.JX); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("JXMyYkfb", reflect.ValueOf(s.JX), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("JhImQOkw")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.Jh)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("JhImQOkw", reflect.ValueOf(s.Jh), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("JrJzKiIx")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.Jr)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("JrJzKiIx", reflect.ValueOf(s.Jr), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("JzgaUWVG")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.Jz); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("JzgaUWVG", reflect.ValueOf(s.Jz), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("kfvcFmKw")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.KF)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("kfvcFmKw", reflect.ValueOf(s.KF), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("KMKBtlov")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.KM)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("KMKBtlov", reflect.ValueOf(s.KM), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("KnhgtAOJ")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.Kn)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("KnhgtAOJ", reflect.ValueOf(s.Kn), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("KyxOoCqS")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.Ky); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("KyxOoCqS", reflect.ValueOf(s.Ky), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("LUPqMOHS")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.LU)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("LUPqMOHS", reflect.ValueOf(s.LU), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("LVNIFCYm")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.LV)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("LVNIFCYm", reflect.ValueOf(s.LV), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("LngvlnTV")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.Ln); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("LngvlnTV", reflect.ValueOf(s.Ln), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("mlfZVfVT")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.ML); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("mlfZVfVT", reflect.ValueOf(s.ML), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("MNuWZMLP")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.MN)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("MNuWZMLP", reflect.ValueOf(s.MN), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("MXMxLVBk")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.MX); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("MXMxLVBk", reflect.ValueOf(s.MX), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("McpOBmaR")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.Mc)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("McpOBmaR", reflect.ValueOf(s.Mc), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("MeUYSkPS")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.Me)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("MeUYSkPS", reflect.ValueOf(s.Me), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("MqfkBZJF")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.Mq); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("MqfkBZJF", reflect.ValueOf(s.Mq), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("nBKWWUWk")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.NB); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("nBKWWUWk", reflect.ValueOf(s.NB), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("nKhiSITP")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.NK); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("nKhiSITP", reflect.ValueOf(s.NK), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("obcwwqWZ")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.OB); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("obcwwqWZ", reflect.ValueOf(s.OB), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("OCsIhHxq")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.OC)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("OCsIhHxq", reflect.ValueOf(s.OC), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("omnwvBbA")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.OM); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("omnwvBbA", reflect.ValueOf(s.OM), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("oRWMNJTE")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.OR)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("oRWMNJTE", reflect.ValueOf(s.OR), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("OfTmCvDx")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.Of)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("OfTmCvDx", reflect.ValueOf(s.Of), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("pacTBmxE")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.PA); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("pacTBmxE", reflect.ValueOf(s.PA), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("PFZSRHNN")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.PF); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("PFZSRHNN", reflect.ValueOf(s.PF), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("pKjOghFa")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.PK)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("pKjOghFa", reflect.ValueOf(s.PK), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("pOMEwSod")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.PO); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("pOMEwSod", reflect.ValueOf(s.PO), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("pPtPsgRl")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.PP)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("pPtPsgRl", reflect.ValueOf(s.PP), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("pQyCJaEd")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.PQ); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("pQyCJaEd", reflect.ValueOf(s.PQ), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("PjKiuWnQ")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.Pj); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("PjKiuWnQ", reflect.ValueOf(s.Pj), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("PvfnpsMV")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.Pv); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("PvfnpsMV", reflect.ValueOf(s.Pv), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("qHzOMXeT")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.QH); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("qHzOMXeT", reflect.ValueOf(s.QH), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("qrJASGzU")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.QR)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("qrJASGzU", reflect.ValueOf(s.QR), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("QobifTeZ")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.Qo)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("QobifTeZ", reflect.ValueOf(s.Qo), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("reiKnuza")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.RE)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("reiKnuza", reflect.ValueOf(s.RE), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("rmzUAgmk")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.RM)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("rmzUAgmk", reflect.ValueOf(s.RM), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("RPsQhgRD")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.RP)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("RPsQhgRD", reflect.ValueOf(s.RP), err)
+	}
+
+	err = bsoncodec.EncodeStructField(ec, dw, "Rbxpznea", val.Field(90), false, false)
+	if err != nil {
+		return err
+	}
+
+	evw, err = dw.WriteDocumentElement("RemSsnnR")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.ReA)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("RemSsnnR", reflect.ValueOf(s.ReA), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("ReOZakjB")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.ReB); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("ReOZakjB", reflect.ValueOf(s.ReB), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("RwAVVKHM")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.Rw)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("RwAVVKHM", reflect.ValueOf(s.Rw), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("sGWJTAcT")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.SG)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("sGWJTAcT", reflect.ValueOf(s.SG), err)
+	}
+
+	err = bsoncodec.EncodeStructField(ec, dw, "SUWXijHT", val.Field(95), false, false)
+	if err != nil {
+		return err
+	}
+
+	evw, err = dw.WriteDocumentElement("sYtnozSc")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.SYA)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("sYtnozSc", reflect.ValueOf(s.SYA), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("SYtZkQbC")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.SYB)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("SYtZkQbC", reflect.ValueOf(s.SYB), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("SqNvlUZF")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.Sq)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("SqNvlUZF", reflect.ValueOf(s.Sq), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("taoNnQYY")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.TA); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("taoNnQYY", reflect.ValueOf(s.TA), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("TDUzNJiH")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.TD)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("TDUzNJiH", reflect.ValueOf(s.TD), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("tIJEYSYM")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.TI)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("tIJEYSYM", reflect.ValueOf(s.TI), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("TRpgnInA")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.TR)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("TRpgnInA", reflect.ValueOf(s.TR), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("TgSwBbgp")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.Tg); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("TgSwBbgp", reflect.ValueOf(s.Tg), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("TkXMwZlU")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.Tk)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("TkXMwZlU", reflect.ValueOf(s.Tk), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("TmUnYUrv")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.Tm)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("TmUnYUrv", reflect.ValueOf(s.Tm), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("UKwbAKGw")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.UK); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("UKwbAKGw", reflect.ValueOf(s.UK), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("uMDWqLMf")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.UM)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("uMDWqLMf", reflect.ValueOf(s.UM), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("UpdMADoN")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.Up)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("UpdMADoN", reflect.ValueOf(s.Up), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("UtbwOKLt")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.Ut)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("UtbwOKLt", reflect.ValueOf(s.Ut), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("VCSKFCoE")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.VC)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("VCSKFCoE", reflect.ValueOf(s.VC), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("vkEDWgmN")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.VK)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("vkEDWgmN", reflect.ValueOf(s.VK), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("vlSZaxCV")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.VL)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("vlSZaxCV", reflect.ValueOf(s.VL), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("vSLTtfDF")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.VS)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("vSLTtfDF", reflect.ValueOf(s.VS), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("vvUeXASH")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.VVA)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("vvUeXASH", reflect.ValueOf(s.VVA), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("VVvwKVRG")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.VVB); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("VVvwKVRG", reflect.ValueOf(s.VVB), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("VcCSqSmp")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.Vc)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("VcCSqSmp", reflect.ValueOf(s.Vc), err)
+	}
+
+	err = bsoncodec.EncodeStructField(ec, dw, "VplFgewF", val.Field(117), false, false)
+	if err != nil {
+		return err
+	}
+
+	evw, err = dw.WriteDocumentElement("VtzeOlCT")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.Vt)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("VtzeOlCT", reflect.ValueOf(s.Vt), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("WHSQVLKG")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.WH)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("WHSQVLKG", reflect.ValueOf(s.WH), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("wjfyueDC")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.WJA)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("wjfyueDC", reflect.ValueOf(s.WJA), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("wjAWaOog")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.WJB)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("wjAWaOog", reflect.ValueOf(s.WJB), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("wmDLUkXt")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.WM)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("wmDLUkXt", reflect.ValueOf(s.WM), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("WYJdGJLu")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.WY)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("WYJdGJLu", reflect.ValueOf(s.WY), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("WmMOvgFc")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.Wm)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("WmMOvgFc", reflect.ValueOf(s.Wm), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("WoFGfdvb")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.Wo)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("WoFGfdvb", reflect.ValueOf(s.Wo), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("XEBqaXkB")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.XE); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("XEBqaXkB", reflect.ValueOf(s.XE), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("XGxlHrXf")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.XG)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("XGxlHrXf", reflect.ValueOf(s.XG), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("xrzGnsEK")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.XR)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("xrzGnsEK", reflect.ValueOf(s.XR), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("xWpeGNjl")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.XWA)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("xWpeGNjl", reflect.ValueOf(s.XWA), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("xWUlYggc")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.XWB)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("xWUlYggc", reflect.ValueOf(s.XWB), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("XXKbyIXG")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.XX)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("XXKbyIXG", reflect.ValueOf(s.XX), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("xZOksssj")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.XZ)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("xZOksssj", reflect.ValueOf(s.XZ), err)
+	}
+
+	err = bsoncodec.EncodeStructField(ec, dw, "XeRkAyCq", val.Field(133), false, false)
+	if err != nil {
+		return err
+	}
+
+	evw, err = dw.WriteDocumentElement("XxvXmHiQ")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.Xx); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("XxvXmHiQ", reflect.ValueOf(s.Xx), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("YDHWnEXV")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.YD)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("YDHWnEXV", reflect.ValueOf(s.YD), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("yeTUgNrU")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.YE)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("yeTUgNrU", reflect.ValueOf(s.YE), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("yKfZnGKG")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.YK); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("yKfZnGKG", reflect.ValueOf(s.YK), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("yXSBbPeT")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.YX)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("yXSBbPeT", reflect.ValueOf(s.YX), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("zDzSGNnW")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.ZD)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("zDzSGNnW", reflect.ValueOf(s.ZD), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("zEgGhhZf")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.ZE)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("zEgGhhZf", reflect.ValueOf(s.ZE), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("zMCFzcWY")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.ZM)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("zMCFzcWY", reflect.ValueOf(s.ZM), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("zSYvADVf")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.ZSA)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("zSYvADVf", reflect.ValueOf(s.ZSA), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("zswQbWEI")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.ZSB)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("zswQbWEI", reflect.ValueOf(s.ZSB), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("ZmtEJFSO")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.Zm)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("ZmtEJFSO", reflect.ValueOf(s.Zm), err)
+	}
+
+	return dw.WriteDocumentEnd()
+}
+
+// DecodeValue implements the bsoncodec.ValueDecoder interface.
+func (c flatBSONTagsBSONCodec) DecodeValue(dc bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != flatBSONTagsBSONCodecType {
+		return bsoncodec.ValueDecoderError{Name: "flatBSONTagsBSONCodec.DecodeValue", Types: []reflect.Type{flatBSONTagsBSONCodecType}, Received: val}
+	}
+
+	switch vrType := vr.Type(); vrType {
+	case bsontype.Type(0), bsontype.EmbeddedDocument:
+	case bsontype.Null:
+		if err := vr.ReadNull(); err != nil {
+			return err
+		}
+		val.Set(reflect.Zero(val.Type()))
+		return nil
+	case bsontype.Undefined:
+		if err := vr.ReadUndefined(); err != nil {
+			return err
+		}
+		val.Set(reflect.Zero(val.Type()))
+		return nil
+	default:
+		return fmt.Errorf("cannot decode %v into a %s", vrType, val.Type())
+	}
+
+	s := val.Addr().Interface().(*flatBSONTags)
+	dr, err := vr.ReadDocument()
+	if err != nil {
+		return err
+	}
+
+	var seenFields []bool
+	if dc.Strict {
+		seenFields = make([]bool, 145)
+	}
+
+	for {
+		name, evr, err := dr.ReadElement()
+		if err == bsonrw.ErrEOD {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		idx := c.fieldIndex(name)
+		if idx < 0 {
+			// Field names are matched case-insensitively if there is no exact match.
+			idx = c.fieldIndex(strings.ToLower(name))
+		}
+		if idx >= 0 {
+			if dc.Strict {
+				if seenFields[idx] {
+					return bsoncodec.NewDecodeError(name, bsoncodec.ErrDuplicateField)
+				}
+				seenFields[idx] = true
+			}
+			err = c.decodeField(dc, evr, val, s, idx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if dc.Strict {
+			return bsoncodec.NewDecodeError(name, bsoncodec.ErrUnknownField)
+		}
+		err = evr.Skip()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fieldIndex returns the index of the field with the BSON key name, or -1 if there is no such field.
+func (flatBSONTagsBSONCodec) fieldIndex(name string) int {
+	switch name {
+	case "_id":
+		return 0
+	case "AAgSNVyBb":
+		return 1
+	case "aicoMxZq":
+		return 2
+	case "AMQrGQmu":
+		return 3
+	case "AgYYbYPr":
+		return 4
+	case "ahFCBmqT":
+		return 5
+	case "AtWNZJXa":
+		return 6
+	case "BBqZInWV":
+		return 7
+	case "bkuaZWRT":
+		return 8
+	case "BwTXiovJ":
+		return 9
+	case "CDIGOuIZ":
+		return 10
+	case "CEtYKsdd":
+		return 11
+	case "cepcgozk":
+		return 12
+	case "CFujXoob":
+		return 13
+	case "cVjWCrlu":
+		return 14
+	case "cxOHMeDJ":
+		return 15
+	case "CYhSCkWB":
+		return 16
+	case "CqCssWxW":
+		return 17
+	case "dCLfYqqM":
+		return 18
+	case "ddPdLgGg":
+		return 19
+	case "ddVenEkK":
+		return 20
+	case "dHsYhRbV":
+		return 21
+	case "DJsnHZIC":
+		return 22
+	case "dNSuxlSU":
+		return 23
+	case "doshbrpF":
+		return 24
+	case "dpbwfSRb":
+		return 25
+	case "DQBQcQFj":
+		return 26
+	case "dtywOLeD":
+		return 27
+	case "dVkWIafN":
+		return 28
+	case "egxZaSsw":
+		return 29
+	case "eRTIdIJR":
+		return 30
+	case "FDYGeSiR":
+		return 31
+	case "fEheUtop":
+		return 32
+	case "FpduyhQP":
+		return 33
+	case "gErhgZTh":
+		return 34
+	case "gySFZeAE":
+		return 35
+	case "GiAHzFII":
+		return 36
+	case "hnVgYIQi":
+		return 37
+	case "HQeCoswW":
+		return 38
+	case "HQiykral":
+		return 39
+	case "HVHyetUM":
+		return 40
+	case "hwHOTmmW":
+		return 41
+	case "HicJbMpj":
+		return 42
+	case "HrUPbFHD":
+		return 43
+	case "iFFGfTXc":
+		return 44
+	case "ijwXMKqI":
+		return 45
+	case "iwfbMdcv":
+		return 46
+	case "Ibrdrtgg":
+		return 47
+	case "IsorvnMR":
+		return 48
+	case "jbUymqiB":
+		return 49
+	case "jmglLvAS":
+		return 50
+	case "jWaFvVAz":
+		return 51
+	case "JXMyYkfb":
+		return 52
+	case "JhImQOkw":
+		return 53
+	case "JrJzKiIx":
+		return 54
+	case "JzgaUWVG":
+		return 55
+	case "kfvcFmKw":
+		return 56
+	case "KMKBtlov":
+		return 57
+	case "KnhgtAOJ":
+		return 58
+	case "KyxOoCqS":
+		return 59
+	case "LUPqMOHS":
+		return 60
+	case "LVNIFCYm":
+		return 61
+	case "LngvlnTV":
+		return 62
+	case "mlfZVfVT":
+		return 63
+	case "MNuWZMLP":
+		return 64
+	case "MXMxLVBk":
+		return 65
+	case "McpOBmaR":
+		return 66
+	case "MeUYSkPS":
+		return 67
+	case "MqfkBZJF":
+		return 68
+	case "nBKWWUWk":
+		return 69
+	case "nKhiSITP":
+		return 70
+	case "obcwwqWZ":
+		return 71
+	case "OCsIhHxq":
+		return 72
+	case "omnwvBbA":
+		return 73
+	case "oRWMNJTE":
+		return 74
+	case "OfTmCvDx":
+		return 75
+	case "pacTBmxE":
+		return 76
+	case "PFZSRHNN":
+		return 77
+	case "pKjOghFa":
+		return 78
+	case "pOMEwSod":
+		return 79
+	case "pPtPsgRl":
+		return 80
+	case "pQyCJaEd":
+		return 81
+	case "PjKiuWnQ":
+		return 82
+	case "PvfnpsMV":
+		return 83
+	case "qHzOMXeT":
+		return 84
+	case "qrJASGzU":
+		return 85
+	case "QobifTeZ":
+		return 86
+	case "reiKnuza":
+		return 87
+	case "rmzUAgmk":
+		return 88
+	case "RPsQhgRD":
+		return 89
+	case "Rbxpznea":
+		return 90
+	case "RemSsnnR":
+		return 91
+	case "ReOZakjB":
+		return 92
+	case "RwAVVKHM":
+		return 93
+	case "sGWJTAcT":
+		return 94
+	case "SUWXijHT":
+		return 95
+	case "sYtnozSc":
+		return 96
+	case "SYtZkQbC":
+		return 97
+	case "SqNvlUZF":
+		return 98
+	case "taoNnQYY":
+		return 99
+	case "TDUzNJiH":
+		return 100
+	case "tIJEYSYM":
+		return 101
+	case "TRpgnInA":
+		return 102
+	case "TgSwBbgp":
+		return 103
+	case "TkXMwZlU":
+		return 104
+	case "TmUnYUrv":
+		return 105
+	case "UKwbAKGw":
+		return 106
+	case "uMDWqLMf":
+		return 107
+	case "UpdMADoN":
+		return 108
+	case "UtbwOKLt":
+		return 109
+	case "VCSKFCoE":
+		return 110
+	case "vkEDWgmN":
+		return 111
+	case "vlSZaxCV":
+		return 112
+	case "vSLTtfDF":
+		return 113
+	case "vvUeXASH":
+		return 114
+	case "VVvwKVRG":
+		return 115
+	case "VcCSqSmp":
+		return 116
+	case "VplFgewF":
+		return 117
+	case "VtzeOlCT":
+		return 118
+	case "WHSQVLKG":
+		return 119
+	case "wjfyueDC":
+		return 120
+	case "wjAWaOog":
+		return 121
+	case "wmDLUkXt":
+		return 122
+	case "WYJdGJLu":
+		return 123
+	case "WmMOvgFc":
+		return 124
+	case "WoFGfdvb":
+		return 125
+	case "XEBqaXkB":
+		return 126
+	case "XGxlHrXf":
+		return 127
+	case "xrzGnsEK":
+		return 128
+	case "xWpeGNjl":
+		return 129
+	case "xWUlYggc":
+		return 130
+	case "XXKbyIXG":
+		return 131
+	case "xZOksssj":
+		return 132
+	case "XeRkAyCq":
+		return 133
+	case "XxvXmHiQ":
+		return 134
+	case "YDHWnEXV":
+		return 135
+	case "yeTUgNrU":
+		return 136
+	case "yKfZnGKG":
+		return 137
+	case "yXSBbPeT":
+		return 138
+	case "zDzSGNnW":
+		return 139
+	case "zEgGhhZf":
+		return 140
+	case "zMCFzcWY":
+		return 141
+	case "zSYvADVf":
+		return 142
+	case "zswQbWEI":
+		return 143
+	case "ZmtEJFSO":
+		return 144
+	}
+	return -1
+}
+
+func (flatBSONTagsBSONCodec) decodeField(dc bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value, s *flatBSONTags, idx int) error {
+	switch idx {
+	case 0:
+		if vr.Type() == bsontype.ObjectID {
+			v, err := vr.ReadObjectID()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("_id", bsontype.ObjectID, reflect.TypeOf(s.ID), err)
+			}
+			s.ID = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "_id", val.Field(0), false)
+	case 1:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("AAgSNVyBb", bsontype.Int32, reflect.TypeOf(s.AA), err)
+			}
+			s.AA = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("AAgSNVyBb", bsontype.Int64, reflect.TypeOf(s.AA), err)
+			}
+			s.AA = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "AAgSNVyBb", val.Field(1), false)
+	case 2:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("aicoMxZq", bsontype.Boolean, reflect.TypeOf(s.AI), err)
+			}
+			s.AI = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "aicoMxZq", val.Field(2), false)
+	case 3:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("AMQrGQmu", bsontype.Int32, reflect.TypeOf(s.AM), err)
+			}
+			s.AM = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("AMQrGQmu", bsontype.Int64, reflect.TypeOf(s.AM), err)
+			}
+			s.AM = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "AMQrGQmu", val.Field(3), false)
+	case 4:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("AgYYbYPr", bsontype.Int32, reflect.TypeOf(s.Ag), err)
+			}
+			s.Ag = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "AgYYbYPr", val.Field(4), false)
+	case 5:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("ahFCBmqT", bsontype.Int32, reflect.TypeOf(s.Ah), err)
+			}
+			s.Ah = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("ahFCBmqT", bsontype.Int64, reflect.TypeOf(s.Ah), err)
+			}
+			s.Ah = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "ahFCBmqT", val.Field(5), false)
+	case 6:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("AtWNZJXa", bsontype.Int32, reflect.TypeOf(s.At), err)
+			}
+			s.At = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("AtWNZJXa", bsontype.Int64, reflect.TypeOf(s.At), err)
+			}
+			s.At = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "AtWNZJXa", val.Field(6), false)
+	case 7:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("BBqZInWV", bsontype.String, reflect.TypeOf(s.BB), err)
+			}
+			s.BB = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "BBqZInWV", val.Field(7), false)
+	case 8:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("bkuaZWRT", bsontype.Int32, reflect.TypeOf(s.BK), err)
+			}
+			s.BK = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("bkuaZWRT", bsontype.Int64, reflect.TypeOf(s.BK), err)
+			}
+			s.BK = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "bkuaZWRT", val.Field(8), false)
+	case 9:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("BwTXiovJ", bsontype.Int32, reflect.TypeOf(s.Bw), err)
+			}
+			s.Bw = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "BwTXiovJ", val.Field(9), false)
+	case 10:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("CDIGOuIZ", bsontype.Int32, reflect.TypeOf(s.CD), err)
+			}
+			s.CD = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "CDIGOuIZ", val.Field(10), false)
+	case 11:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("CEtYKsdd", bsontype.String, reflect.TypeOf(s.CEA), err)
+			}
+			s.CEA = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "CEtYKsdd", val.Field(11), false)
+	case 12:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("cepcgozk", bsontype.String, reflect.TypeOf(s.CEB), err)
+			}
+			s.CEB = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "cepcgozk", val.Field(12), false)
+	case 13:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("CFujXoob", bsontype.Int32, reflect.TypeOf(s.CF), err)
+			}
+			s.CF = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "CFujXoob", val.Field(13), false)
+	case 14:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("cVjWCrlu", bsontype.Int32, reflect.TypeOf(s.CV), err)
+			}
+			s.CV = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("cVjWCrlu", bsontype.Int64, reflect.TypeOf(s.CV), err)
+			}
+			s.CV = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "cVjWCrlu", val.Field(14), false)
+	case 15:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("cxOHMeDJ", bsontype.String, reflect.TypeOf(s.CX), err)
+			}
+			s.CX = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "cxOHMeDJ", val.Field(15), false)
+	case 16:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("CYhSCkWB", bsontype.String, reflect.TypeOf(s.CY), err)
+			}
+			s.CY = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "CYhSCkWB", val.Field(16), false)
+	case 17:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("CqCssWxW", bsontype.String, reflect.TypeOf(s.Cq), err)
+			}
+			s.Cq = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "CqCssWxW", val.Field(17), false)
+	case 18:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("dCLfYqqM", bsontype.Int32, reflect.TypeOf(s.DC), err)
+			}
+			s.DC = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "dCLfYqqM", val.Field(18), false)
+	case 19:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("ddPdLgGg", bsontype.Int32, reflect.TypeOf(s.DDA), err)
+			}
+			s.DDA = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "ddPdLgGg", val.Field(19), false)
+	case 20:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("ddVenEkK", bsontype.Int32, reflect.TypeOf(s.DDB), err)
+			}
+			s.DDB = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "ddVenEkK", val.Field(20), false)
+	case 21:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("dHsYhRbV", bsontype.String, reflect.TypeOf(s.DH), err)
+			}
+			s.DH = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "dHsYhRbV", val.Field(21), false)
+	case 22:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("DJsnHZIC", bsontype.Int32, reflect.TypeOf(s.DJ), err)
+			}
+			s.DJ = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "DJsnHZIC", val.Field(22), false)
+	case 23:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("dNSuxlSU", bsontype.String, reflect.TypeOf(s.DN), err)
+			}
+			s.DN = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "dNSuxlSU", val.Field(23), false)
+	case 24:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("doshbrpF", bsontype.Int32, reflect.TypeOf(s.DO), err)
+			}
+			s.DO = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("doshbrpF", bsontype.Int64, reflect.TypeOf(s.DO), err)
+			}
+			s.DO = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "doshbrpF", val.Field(24), false)
+	case 25:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("dpbwfSRb", bsontype.String, reflect.TypeOf(s.DP), err)
+			}
+			s.DP = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "dpbwfSRb", val.Field(25), false)
+	case 26:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("DQBQcQFj", bsontype.Int32, reflect.TypeOf(s.DQ), err)
+			}
+			s.DQ = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("DQBQcQFj", bsontype.Int64, reflect.TypeOf(s.DQ), err)
+			}
+			s.DQ = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "DQBQcQFj", val.Field(26), false)
+	case 27:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("dtywOLeD", bsontype.String, reflect.TypeOf(s.DT), err)
+			}
+			s.DT = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "dtywOLeD", val.Field(27), false)
+	case 28:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("dVkWIafN", bsontype.Int32, reflect.TypeOf(s.DV), err)
+			}
+			s.DV = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "dVkWIafN", val.Field(28), false)
+	case 29:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("egxZaSsw", bsontype.Boolean, reflect.TypeOf(s.EG), err)
+			}
+			s.EG = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "egxZaSsw", val.Field(29), false)
+	case 30:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("eRTIdIJR", bsontype.String, reflect.TypeOf(s.ER), err)
+			}
+			s.ER = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "eRTIdIJR", val.Field(30), false)
+	case 31:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("FDYGeSiR", bsontype.Int32, reflect.TypeOf(s.FD), err)
+			}
+			s.FD = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("FDYGeSiR", bsontype.Int64, reflect.TypeOf(s.FD), err)
+			}
+			s.FD = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "FDYGeSiR", val.Field(31), false)
+	case 32:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("fEheUtop", bsontype.String, reflect.TypeOf(s.FE), err)
+			}
+			s.FE = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "fEheUtop", val.Field(32), false)
+	case 33:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("FpduyhQP", bsontype.Boolean, reflect.TypeOf(s.Fp), err)
+			}
+			s.Fp = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "FpduyhQP", val.Field(33), false)
+	case 34:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("gErhgZTh", bsontype.String, reflect.TypeOf(s.GE), err)
+			}
+			s.GE = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "gErhgZTh", val.Field(34), false)
+	case 35:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("gySFZeAE", bsontype.Int32, reflect.TypeOf(s.GY), err)
+			}
+			s.GY = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "gySFZeAE", val.Field(35), false)
+	case 36:
+		return bsoncodec.DecodeStructField(dc, vr, "GiAHzFII", val.Field(36), false)
+	case 37:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("hnVgYIQi", bsontype.String, reflect.TypeOf(s.HN), err)
+			}
+			s.HN = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "hnVgYIQi", val.Field(37), false)
+	case 38:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("HQeCoswW", bsontype.Int32, reflect.TypeOf(s.HQA), err)
+			}
+			s.HQA = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "HQeCoswW", val.Field(38), false)
+	case 39:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("HQiykral", bsontype.Int32, reflect.TypeOf(s.HQB), err)
+			}
+			s.HQB = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "HQiykral", val.Field(39), false)
+	case 40:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("HVHyetUM", bsontype.Int32, reflect.TypeOf(s.HV), err)
+			}
+			s.HV = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("HVHyetUM", bsontype.Int64, reflect.TypeOf(s.HV), err)
+			}
+			s.HV = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "HVHyetUM", val.Field(40), false)
+	case 41:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("hwHOTmmW", bsontype.Int32, reflect.TypeOf(s.HW), err)
+			}
+			s.HW = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "hwHOTmmW", val.Field(41), false)
+	case 42:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("HicJbMpj", bsontype.Boolean, reflect.TypeOf(s.Hi), err)
+			}
+			s.Hi = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "HicJbMpj", val.Field(42), false)
+	case 43:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("HrUPbFHD", bsontype.Int32, reflect.TypeOf(s.Hr), err)
+			}
+			s.Hr = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "HrUPbFHD", val.Field(43), false)
+	case 44:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("iFFGfTXc", bsontype.String, reflect.TypeOf(s.IF), err)
+			}
+			s.IF = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "iFFGfTXc", val.Field(44), false)
+	case 45:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("ijwXMKqI", bsontype.Int32, reflect.TypeOf(s.IJ), err)
+			}
+			s.IJ = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "ijwXMKqI", val.Field(45), false)
+	case 46:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("iwfbMdcv", bsontype.Int32, reflect.TypeOf(s.IW), err)
+			}
+			s.IW = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "iwfbMdcv", val.Field(46), false)
+	case 47:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("Ibrdrtgg", bsontype.String, reflect.TypeOf(s.Ib), err)
+			}
+			s.Ib = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "Ibrdrtgg", val.Field(47), false)
+	case 48:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("IsorvnMR", bsontype.Boolean, reflect.TypeOf(s.Is), err)
+			}
+			s.Is = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "IsorvnMR", val.Field(48), false)
+	case 49:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("jbUymqiB", bsontype.String, reflect.TypeOf(s.JB), err)
+			}
+			s.JB = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "jbUymqiB", val.Field(49), false)
+	case 50:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("jmglLvAS", bsontype.String, reflect.TypeOf(s.JM), err)
+			}
+			s.JM = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "jmglLvAS", val.Field(50), false)
+	case 51:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("jWaFvVAz", bsontype.Int32, reflect.TypeOf(s.JW), err)
+			}
+			s.JW = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "jWaFvVAz", val.Field(51), false)
+	case 52:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("JXMyYkfb", bsontype.Int32, reflect.TypeOf(s.JX), err)
+			}
+			s.JX = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "JXMyYkfb", val.Field(52), false)
+	case 53:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("JhImQOkw", bsontype.Boolean, reflect.TypeOf(s.Jh), err)
+			}
+			s.Jh = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "JhImQOkw", val.Field(53), false)
+	case 54:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("JrJzKiIx", bsontype.String, reflect.TypeOf(s.Jr), err)
+			}
+			s.Jr = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "JrJzKiIx", val.Field(54), false)
+	case 55:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("JzgaUWVG", bsontype.Int32, reflect.TypeOf(s.Jz), err)
+			}
+			s.Jz = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "JzgaUWVG", val.Field(55), false)
+	case 56:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("kfvcFmKw", bsontype.Boolean, reflect.TypeOf(s.KF), err)
+			}
+			s.KF = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "kfvcFmKw", val.Field(56), false)
+	case 57:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("KMKBtlov", bsontype.Int32, reflect.TypeOf(s.KM), err)
+			}
+			s.KM = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("KMKBtlov", bsontype.Int64, reflect.TypeOf(s.KM), err)
+			}
+			s.KM = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "KMKBtlov", val.Field(57), false)
+	case 58:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("KnhgtAOJ", bsontype.String, reflect.TypeOf(s.Kn), err)
+			}
+			s.Kn = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "KnhgtAOJ", val.Field(58), false)
+	case 59:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("KyxOoCqS", bsontype.Int32, reflect.TypeOf(s.Ky), err)
+			}
+			s.Ky = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "KyxOoCqS", val.Field(59), false)
+	case 60:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("LUPqMOHS", bsontype.String, reflect.TypeOf(s.LU), err)
+			}
+			s.LU = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "LUPqMOHS", val.Field(60), false)
+	case 61:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("LVNIFCYm", bsontype.Boolean, reflect.TypeOf(s.LV), err)
+			}
+			s.LV = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "LVNIFCYm", val.Field(61), false)
+	case 62:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("LngvlnTV", bsontype.Int32, reflect.TypeOf(s.Ln), err)
+			}
+			s.Ln = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "LngvlnTV", val.Field(62), false)
+	case 63:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("mlfZVfVT", bsontype.Int32, reflect.TypeOf(s.ML), err)
+			}
+			s.ML = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "mlfZVfVT", val.Field(63), false)
+	case 64:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("MNuWZMLP", bsontype.Boolean, reflect.TypeOf(s.MN), err)
+			}
+			s.MN = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "MNuWZMLP", val.Field(64), false)
+	case 65:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("MXMxLVBk", bsontype.Int32, reflect.TypeOf(s.MX), err)
+			}
+			s.MX = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "MXMxLVBk", val.Field(65), false)
+	case 66:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("McpOBmaR", bsontype.String, reflect.TypeOf(s.Mc), err)
+			}
+			s.Mc = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "McpOBmaR", val.Field(66), false)
+	case 67:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("MeUYSkPS", bsontype.String, reflect.TypeOf(s.Me), err)
+			}
+			s.Me = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "MeUYSkPS", val.Field(67), false)
+	case 68:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("MqfkBZJF", bsontype.Int32, reflect.TypeOf(s.Mq), err)
+			}
+			s.Mq = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "MqfkBZJF", val.Field(68), false)
+	case 69:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("nBKWWUWk", bsontype.Int32, reflect.TypeOf(s.NB), err)
+			}
+			s.NB = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "nBKWWUWk", val.Field(69), false)
+	case 70:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("nKhiSITP", bsontype.Int32, reflect.TypeOf(s.NK), err)
+			}
+			s.NK = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "nKhiSITP", val.Field(70), false)
+	case 71:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("obcwwqWZ", bsontype.Int32, reflect.TypeOf(s.OB), err)
+			}
+			s.OB = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "obcwwqWZ", val.Field(71), false)
+	case 72:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("OCsIhHxq", bsontype.String, reflect.TypeOf(s.OC), err)
+			}
+			s.OC = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "OCsIhHxq", val.Field(72), false)
+	case 73:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("omnwvBbA", bsontype.Int32, reflect.TypeOf(s.OM), err)
+			}
+			s.OM = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "omnwvBbA", val.Field(73), false)
+	case 74:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("oRWMNJTE", bsontype.String, reflect.TypeOf(s.OR), err)
+			}
+			s.OR = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "oRWMNJTE", val.Field(74), false)
+	case 75:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("OfTmCvDx", bsontype.String, reflect.TypeOf(s.Of), err)
+			}
+			s.Of = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "OfTmCvDx", val.Field(75), false)
+	case 76:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("pacTBmxE", bsontype.Int32, reflect.TypeOf(s.PA), err)
+			}
+			s.PA = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "pacTBmxE", val.Field(76), false)
+	case 77:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("PFZSRHNN", bsontype.Int32, reflect.TypeOf(s.PF), err)
+			}
+			s.PF = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "PFZSRHNN", val.Field(77), false)
+	case 78:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("pKjOghFa", bsontype.Boolean, reflect.TypeOf(s.PK), err)
+			}
+			s.PK = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "pKjOghFa", val.Field(78), false)
+	case 79:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("pOMEwSod", bsontype.Int32, reflect.TypeOf(s.PO), err)
+			}
+			s.PO = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "pOMEwSod", val.Field(79), false)
+	case 80:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("pPtPsgRl", bsontype.String, reflect.TypeOf(s.PP), err)
+			}
+			s.PP = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "pPtPsgRl", val.Field(80), false)
+	case 81:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("pQyCJaEd", bsontype.Int32, reflect.TypeOf(s.PQ), err)
+			}
+			s.PQ = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "pQyCJaEd", val.Field(81), false)
+	case 82:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("PjKiuWnQ", bsontype.Int32, reflect.TypeOf(s.Pj), err)
+			}
+			s.Pj = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "PjKiuWnQ", val.Field(82), false)
+	case 83:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("PvfnpsMV", bsontype.Int32, reflect.TypeOf(s.Pv), err)
+			}
+			s.Pv = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "PvfnpsMV", val.Field(83), false)
+	case 84:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("qHzOMXeT", bsontype.Int32, reflect.TypeOf(s.QH), err)
+			}
+			s.QH = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "qHzOMXeT", val.Field(84), false)
+	case 85:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("qrJASGzU", bsontype.Boolean, reflect.TypeOf(s.QR), err)
+			}
+			s.QR = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "qrJASGzU", val.Field(85), false)
+	case 86:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("QobifTeZ", bsontype.String, reflect.TypeOf(s.Qo), err)
+			}
+			s.Qo = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "QobifTeZ", val.Field(86), false)
+	case 87:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("reiKnuza", bsontype.Int32, reflect.TypeOf(s.RE), err)
+			}
+			s.RE = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("reiKnuza", bsontype.Int64, reflect.TypeOf(s.RE), err)
+			}
+			s.RE = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "reiKnuza", val.Field(87), false)
+	case 88:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("rmzUAgmk", bsontype.String, reflect.TypeOf(s.RM), err)
+			}
+			s.RM = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "rmzUAgmk", val.Field(88), false)
+	case 89:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("RPsQhgRD", bsontype.String, reflect.TypeOf(s.RP), err)
+			}
+			s.RP = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "RPsQhgRD", val.Field(89), false)
+	case 90:
+		return bsoncodec.DecodeStructField(dc, vr, "Rbxpznea", val.Field(90), false)
+	case 91:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("RemSsnnR", bsontype.Boolean, reflect.TypeOf(s.ReA), err)
+			}
+			s.ReA = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "RemSsnnR", val.Field(91), false)
+	case 92:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("ReOZakjB", bsontype.Int32, reflect.TypeOf(s.ReB), err)
+			}
+			s.ReB = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "ReOZakjB", val.Field(92), false)
+	case 93:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("RwAVVKHM", bsontype.String, reflect.TypeOf(s.Rw), err)
+			}
+			s.Rw = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "RwAVVKHM", val.Field(93), false)
+	case 94:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("sGWJTAcT", bsontype.Boolean, reflect.TypeOf(s.SG), err)
+			}
+			s.SG = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "sGWJTAcT", val.Field(94), false)
+	case 95:
+		return bsoncodec.DecodeStructField(dc, vr, "SUWXijHT", val.Field(95), false)
+	case 96:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("sYtnozSc", bsontype.Int32, reflect.TypeOf(s.SYA), err)
+			}
+			s.SYA = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("sYtnozSc", bsontype.Int64, reflect.TypeOf(s.SYA), err)
+			}
+			s.SYA = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "sYtnozSc", val.Field(96), false)
+	case 97:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("SYtZkQbC", bsontype.String, reflect.TypeOf(s.SYB), err)
+			}
+			s.SYB = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "SYtZkQbC", val.Field(97), false)
+	case 98:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("SqNvlUZF", bsontype.Int32, reflect.TypeOf(s.Sq), err)
+			}
+			s.Sq = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("SqNvlUZF", bsontype.Int64, reflect.TypeOf(s.Sq), err)
+			}
+			s.Sq = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "SqNvlUZF", val.Field(98), false)
+	case 99:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("taoNnQYY", bsontype.Int32, reflect.TypeOf(s.TA), err)
+			}
+			s.TA = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "taoNnQYY", val.Field(99), false)
+	case 100:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("TDUzNJiH", bsontype.String, reflect.TypeOf(s.TD), err)
+			}
+			s.TD = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "TDUzNJiH", val.Field(100), false)
+	case 101:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("tIJEYSYM", bsontype.String, reflect.TypeOf(s.TI), err)
+			}
+			s.TI = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "tIJEYSYM", val.Field(101), false)
+	case 102:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("TRpgnInA", bsontype.Boolean, reflect.TypeOf(s.TR), err)
+			}
+			s.TR = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "TRpgnInA", val.Field(102), false)
+	case 103:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("TgSwBbgp", bsontype.Int32, reflect.TypeOf(s.Tg), err)
+			}
+			s.Tg = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "TgSwBbgp", val.Field(103), false)
+	case 104:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("TkXMwZlU", bsontype.Int32, reflect.TypeOf(s.Tk), err)
+			}
+			s.Tk = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("TkXMwZlU", bsontype.Int64, reflect.TypeOf(s.Tk), err)
+			}
+			s.Tk = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "TkXMwZlU", val.Field(104), false)
+	case 105:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("TmUnYUrv", bsontype.Int32, reflect.TypeOf(s.Tm), err)
+			}
+			s.Tm = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("TmUnYUrv", bsontype.Int64, reflect.TypeOf(s.Tm), err)
+			}
+			s.Tm = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "TmUnYUrv", val.Field(105), false)
+	case 106:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("UKwbAKGw", bsontype.Int32, reflect.TypeOf(s.UK), err)
+			}
+			s.UK = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "UKwbAKGw", val.Field(106), false)
+	case 107:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("uMDWqLMf", bsontype.String, reflect.TypeOf(s.UM), err)
+			}
+			s.UM = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "uMDWqLMf", val.Field(107), false)
+	case 108:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("UpdMADoN", bsontype.Boolean, reflect.TypeOf(s.Up), err)
+			}
+			s.Up = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "UpdMADoN", val.Field(108), false)
+	case 109:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("UtbwOKLt", bsontype.Int32, reflect.TypeOf(s.Ut), err)
+			}
+			s.Ut = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("UtbwOKLt", bsontype.Int64, reflect.TypeOf(s.Ut), err)
+			}
+			s.Ut = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "UtbwOKLt", val.Field(109), false)
+	case 110:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("VCSKFCoE", bsontype.Int32, reflect.TypeOf(s.VC), err)
+			}
+			s.VC = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("VCSKFCoE", bsontype.Int64, reflect.TypeOf(s.VC), err)
+			}
+			s.VC = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "VCSKFCoE", val.Field(110), false)
+	case 111:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("vkEDWgmN", bsontype.String, reflect.TypeOf(s.VK), err)
+			}
+			s.VK = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "vkEDWgmN", val.Field(111), false)
+	case 112:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("vlSZaxCV", bsontype.String, reflect.TypeOf(s.VL), err)
+			}
+			s.VL = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "vlSZaxCV", val.Field(112), false)
+	case 113:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("vSLTtfDF", bsontype.String, reflect.TypeOf(s.VS), err)
+			}
+			s.VS = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "vSLTtfDF", val.Field(113), false)
+	case 114:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("vvUeXASH", bsontype.Boolean, reflect.TypeOf(s.VVA), err)
+			}
+			s.VVA = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "vvUeXASH", val.Field(114), false)
+	case 115:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("VVvwKVRG", bsontype.Int32, reflect.TypeOf(s.VVB), err)
+			}
+			s.VVB = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "VVvwKVRG", val.Field(115), false)
+	case 116:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("VcCSqSmp", bsontype.Boolean, reflect.TypeOf(s.Vc), err)
+			}
+			s.Vc = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "VcCSqSmp", val.Field(116), false)
+	case 117:
+		return bsoncodec.DecodeStructField(dc, vr, "VplFgewF", val.Field(117), false)
+	case 118:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("VtzeOlCT", bsontype.String, reflect.TypeOf(s.Vt), err)
+			}
+			s.Vt = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "VtzeOlCT", val.Field(118), false)
+	case 119:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("WHSQVLKG", bsontype.Boolean, reflect.TypeOf(s.WH), err)
+			}
+			s.WH = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "WHSQVLKG", val.Field(119), false)
+	case 120:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("wjfyueDC", bsontype.Boolean, reflect.TypeOf(s.WJA), err)
+			}
+			s.WJA = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "wjfyueDC", val.Field(120), false)
+	case 121:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("wjAWaOog", bsontype.String, reflect.TypeOf(s.WJB), err)
+			}
+			s.WJB = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "wjAWaOog", val.Field(121), false)
+	case 122:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("wmDLUkXt", bsontype.Int32, reflect.TypeOf(s.WM), err)
+			}
+			s.WM = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("wmDLUkXt", bsontype.Int64, reflect.TypeOf(s.WM), err)
+			}
+			s.WM = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "wmDLUkXt", val.Field(122), false)
+	case 123:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("WYJdGJLu", bsontype.String, reflect.TypeOf(s.WY), err)
+			}
+			s.WY = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "WYJdGJLu", val.Field(123), false)
+	case 124:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("WmMOvgFc", bsontype.Boolean, reflect.TypeOf(s.Wm), err)
+			}
+			s.Wm = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "WmMOvgFc", val.Field(124), false)
+	case 125:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("WoFGfdvb", bsontype.String, reflect.TypeOf(s.Wo), err)
+			}
+			s.Wo = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "WoFGfdvb", val.Field(125), false)
+	case 126:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("XEBqaXkB", bsontype.Int32, reflect.TypeOf(s.XE), err)
+			}
+			s.XE = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "XEBqaXkB", val.Field(126), false)
+	case 127:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("XGxlHrXf", bsontype.Boolean, reflect.TypeOf(s.XG), err)
+			}
+			s.XG = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "XGxlHrXf", val.Field(127), false)
+	case 128:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("xrzGnsEK", bsontype.String, reflect.TypeOf(s.XR), err)
+			}
+			s.XR = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "xrzGnsEK", val.Field(128), false)
+	case 129:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("xWpeGNjl", bsontype.Int32, reflect.TypeOf(s.XWA), err)
+			}
+			s.XWA = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("xWpeGNjl", bsontype.Int64, reflect.TypeOf(s.XWA), err)
+			}
+			s.XWA = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "xWpeGNjl", val.Field(129), false)
+	case 130:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("xWUlYggc", bsontype.String, reflect.TypeOf(s.XWB), err)
+			}
+			s.XWB = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "xWUlYggc", val.Field(130), false)
+	case 131:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("XXKbyIXG", bsontype.Int32, reflect.TypeOf(s.XX), err)
+			}
+			s.XX = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("XXKbyIXG", bsontype.Int64, reflect.TypeOf(s.XX), err)
+			}
+			s.XX = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "XXKbyIXG", val.Field(131), false)
+	case 132:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("xZOksssj", bsontype.Int32, reflect.TypeOf(s.XZ), err)
+			}
+			s.XZ = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("xZOksssj", bsontype.Int64, reflect.TypeOf(s.XZ), err)
+			}
+			s.XZ = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "xZOksssj", val.Field(132), false)
+	case 133:
+		return bsoncodec.DecodeStructField(dc, vr, "XeRkAyCq", val.Field(133), false)
+	case 134:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("XxvXmHiQ", bsontype.Int32, reflect.TypeOf(s.Xx), err)
+			}
+			s.Xx = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "XxvXmHiQ", val.Field(134), false)
+	case 135:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("YDHWnEXV", bsontype.String, reflect.TypeOf(s.YD), err)
+			}
+			s.YD = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "YDHWnEXV", val.Field(135), false)
+	case 136:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("yeTUgNrU", bsontype.Boolean, reflect.TypeOf(s.YE), err)
+			}
+			s.YE = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "yeTUgNrU", val.Field(136), false)
+	case 137:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("yKfZnGKG", bsontype.Int32, reflect.TypeOf(s.YK), err)
+			}
+			s.YK = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "yKfZnGKG", val.Field(137), false)
+	case 138:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("yXSBbPeT", bsontype.String, reflect.TypeOf(s.YX), err)
+			}
+			s.YX = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "yXSBbPeT", val.Field(138), false)
+	case 139:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("zDzSGNnW", bsontype.Boolean, reflect.TypeOf(s.ZD), err)
+			}
+			s.ZD = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "zDzSGNnW", val.Field(139), false)
+	case 140:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("zEgGhhZf", bsontype.Boolean, reflect.TypeOf(s.ZE), err)
+			}
+			s.ZE = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "zEgGhhZf", val.Field(140), false)
+	case 141:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("zMCFzcWY", bsontype.String, reflect.TypeOf(s.ZM), err)
+			}
+			s.ZM = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "zMCFzcWY", val.Field(141), false)
+	case 142:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("zSYvADVf", bsontype.Int32, reflect.TypeOf(s.ZSA), err)
+			}
+			s.ZSA = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("zSYvADVf", bsontype.Int64, reflect.TypeOf(s.ZSA), err)
+			}
+			s.ZSA = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "zSYvADVf", val.Field(142), false)
+	case 143:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("zswQbWEI", bsontype.Int32, reflect.TypeOf(s.ZSB), err)
+			}
+			s.ZSB = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("zswQbWEI", bsontype.Int64, reflect.TypeOf(s.ZSB), err)
+			}
+			s.ZSB = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "zswQbWEI", val.Field(143), false)
+	case 144:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("ZmtEJFSO", bsontype.String, reflect.TypeOf(s.Zm), err)
+			}
+			s.Zm = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "ZmtEJFSO", val.Field(144), false)
+	}
+	return nil
+}
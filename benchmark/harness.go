@@ -168,6 +168,12 @@ func getAllCases() []*CaseDefinition {
 			Size:    75310000,
 			Runtime: StandardRuntime,
 		},
+		{
+			Bench:   BSONFlatStructTagsGeneratedDecoding,
+			Count:   tenThousand,
+			Size:    75310000,
+			Runtime: StandardRuntime,
+		},
 		{
 			Bench:   BSONFlatStructEncoding,
 			Count:   tenThousand,
@@ -180,6 +186,12 @@ func getAllCases() []*CaseDefinition {
 			Size:    75310000,
 			Runtime: StandardRuntime,
 		},
+		{
+			Bench:   BSONFlatStructTagsGeneratedEncoding,
+			Count:   tenThousand,
+			Size:    75310000,
+			Runtime: StandardRuntime,
+		},
 		{
 			Bench:   SingleRunCommand,
 			Count:   tenThousand,
@@ -132,3 +132,33 @@ func BenchmarkEncodingNested(b *testing.B) {
 		_, _ = Marshal(nestedInstance)
 	}
 }
+
+func BenchmarkDecoding(b *testing.B) {
+	data, err := Marshal(encodetestInstance)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var out encodetest
+		_ = Unmarshal(data, &out)
+	}
+}
+
+// BenchmarkDecodingParallel decodes from many goroutines at once, which exercises the struct description cache of
+// the default registry's struct codec. Run it with -cpu to set the number of goroutines, e.g. -cpu 64.
+func BenchmarkDecodingParallel(b *testing.B) {
+	data, err := Marshal(nestedInstance)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			var out nestedtest1
+			_ = Unmarshal(data, &out)
+		}
+	})
+}
@@ -114,6 +114,9 @@ type EncodeContext struct {
 type DecodeContext struct {
 	*Registry
 	Truncate bool
+	// Strict causes structs to be decoded strictly. A document that contains a key that does not match a struct field
+	// or that contains the same key more than once will return an error instead of being decoded.
+	Strict bool
 	// Ancestor is the type of a containing document. This is mainly used to determine what type
 	// should be used when decoding an embedded document into an empty interface. For example, if
 	// Ancestor is a bson.M, BSON embedded document values being decoded into an empty interface
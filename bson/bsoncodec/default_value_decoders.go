@@ -948,9 +948,10 @@ func (dvd DefaultValueDecoders) MapDecodeValue(dc DecodeContext, vr bsonrw.Value
 
 		elem := reflect.New(eType).Elem()
 
+		bsonType := vr.Type()
 		err = decoder.DecodeValue(dc, vr, elem)
 		if err != nil {
-			return err
+			return wrapDecodeError(key, bsonType, eType, err)
 		}
 
 		val.SetMapIndex(reflect.ValueOf(key).Convert(keyType), elem)
@@ -1219,9 +1220,10 @@ func (dvd DefaultValueDecoders) decodeDefault(dc DecodeContext, vr bsonrw.ValueR
 
 		elem := reflect.New(eType).Elem()
 
+		bsonType := vr.Type()
 		err = decoder.DecodeValue(dc, vr, elem)
 		if err != nil {
-			return nil, newDecodeError(strconv.Itoa(idx), err)
+			return nil, wrapDecodeError(strconv.Itoa(idx), bsonType, eType, err)
 		}
 		elems = append(elems, elem)
 		idx++
@@ -1306,9 +1308,10 @@ func (DefaultValueDecoders) decodeElemsFromDocumentReader(dc DecodeContext, dr b
 		}
 
 		val := reflect.New(tEmpty).Elem()
+		bsonType := vr.Type()
 		err = decoder.DecodeValue(dc, vr, val)
 		if err != nil {
-			return nil, newDecodeError(key, err)
+			return nil, wrapDecodeError(key, bsonType, tEmpty, err)
 		}
 
 		elems = append(elems, reflect.ValueOf(primitive.E{Key: key, Value: val.Interface()}))
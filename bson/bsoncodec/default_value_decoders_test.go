@@ -926,7 +926,12 @@ func TestDefaultValueDecoders(t *testing.T) {
 					&DecodeContext{Registry: buildDefaultRegistry()},
 					&bsonrwtest.ValueReaderWriter{BSONType: bsontype.Array},
 					bsonrwtest.ReadValue,
-					&DecodeError{keys: []string{"0"}, wrapped: errors.New("cannot decode array into a string type")},
+					&DecodeError{
+						keys:     []string{"0"},
+						wrapped:  errors.New("cannot decode array into a string type"),
+						bsonType: bsontype.Array,
+						goType:   tString,
+					},
 				},
 				{
 					"Document but not D",
@@ -1020,7 +1025,12 @@ func TestDefaultValueDecoders(t *testing.T) {
 					&DecodeContext{Registry: buildDefaultRegistry()},
 					&bsonrwtest.ValueReaderWriter{BSONType: bsontype.Array},
 					bsonrwtest.ReadValue,
-					&DecodeError{keys: []string{"0"}, wrapped: errors.New("cannot decode array into a string type")},
+					&DecodeError{
+						keys:     []string{"0"},
+						wrapped:  errors.New("cannot decode array into a string type"),
+						bsonType: bsontype.Array,
+						goType:   tString,
+					},
 				},
 				{
 					"Document but not D",
@@ -3371,8 +3381,10 @@ func TestDefaultValueDecoders(t *testing.T) {
 			bsoncore.AppendInt32Element(nil, "foo", 10),
 		)
 		docEmptyInterfaceErr := &DecodeError{
-			keys:    []string{"foo"},
-			wrapped: decodeValueError,
+			keys:     []string{"foo"},
+			wrapped:  decodeValueError,
+			bsonType: bsontype.Int32,
+			goType:   tEmpty,
 		}
 
 		// Set up struct definitions where Foo maps to interface{} and string. When decoded using the registry defined
@@ -3385,12 +3397,16 @@ func TestDefaultValueDecoders(t *testing.T) {
 			Foo string
 		}
 		emptyInterfaceStructErr := &DecodeError{
-			keys:    []string{"foo"},
-			wrapped: decodeValueError,
+			keys:     []string{"foo"},
+			wrapped:  decodeValueError,
+			bsonType: bsontype.Int32,
+			goType:   tEmpty,
 		}
 		stringStructErr := &DecodeError{
-			keys:    []string{"foo"},
-			wrapped: ErrNoDecoder{reflect.TypeOf("")},
+			keys:     []string{"foo"},
+			wrapped:  ErrNoDecoder{reflect.TypeOf("")},
+			bsonType: bsontype.Int32,
+			goType:   tString,
 		}
 
 		// Test a deeply nested struct mixed with maps and slices.
@@ -3420,8 +3436,10 @@ func TestDefaultValueDecoders(t *testing.T) {
 			RegisterTypeDecoder(tEmpty, ValueDecoderFunc(emptyInterfaceErrorDecode)).
 			Build()
 		nestedErr := &DecodeError{
-			keys:    []string{"fourth", "1", "third", "randomKey", "second", "first"},
-			wrapped: decodeValueError,
+			keys:     []string{"fourth", "1", "third", "randomKey", "second", "first"},
+			wrapped:  decodeValueError,
+			bsonType: bsontype.String,
+			goType:   tEmpty,
 		}
 
 		testCases := []struct {
@@ -3449,8 +3467,10 @@ func TestDefaultValueDecoders(t *testing.T) {
 				nil,
 				defaultSliceCodec,
 				&DecodeError{
-					keys:    []string{"0"},
-					wrapped: errors.New("cannot decode array into a string type"),
+					keys:     []string{"0"},
+					wrapped:  errors.New("cannot decode array into a string type"),
+					bsonType: bsontype.Array,
+					goType:   tString,
 				},
 			},
 			{
@@ -3474,8 +3494,10 @@ func TestDefaultValueDecoders(t *testing.T) {
 				nil,
 				ValueDecoderFunc(dvd.ArrayDecodeValue),
 				&DecodeError{
-					keys:    []string{"0"},
-					wrapped: errors.New("cannot decode array into a string type"),
+					keys:     []string{"0"},
+					wrapped:  errors.New("cannot decode array into a string type"),
+					bsonType: bsontype.Array,
+					goType:   tString,
 				},
 			},
 			{
@@ -13,6 +13,7 @@ import (
 	"math"
 	"net/url"
 	"reflect"
+	"strconv"
 	"sync"
 	"time"
 
@@ -46,12 +47,12 @@ func encodeElement(ec EncodeContext, dw bsonrw.DocumentWriter, e primitive.E) er
 	}
 	encoder, err := ec.LookupEncoder(reflect.TypeOf(e.Value))
 	if err != nil {
-		return err
+		return newEncodeError(e.Key, reflect.ValueOf(e.Value), err)
 	}
 
 	err = encoder.EncodeValue(ec, vw, reflect.ValueOf(e.Value))
 	if err != nil {
-		return err
+		return newEncodeError(e.Key, reflect.ValueOf(e.Value), err)
 	}
 	return nil
 }
@@ -315,7 +316,7 @@ func (dve DefaultValueEncoders) mapEncodeValue(ec EncodeContext, dw bsonrw.Docum
 
 		currEncoder, currVal, lookupErr := dve.lookupElementEncoder(ec, encoder, val.MapIndex(key))
 		if lookupErr != nil && lookupErr != errInvalidValue {
-			return lookupErr
+			return newEncodeError(key.String(), currVal, lookupErr)
 		}
 
 		vw, err := dw.WriteDocumentElement(key.String())
@@ -334,13 +335,13 @@ func (dve DefaultValueEncoders) mapEncodeValue(ec EncodeContext, dw bsonrw.Docum
 		if enc, ok := currEncoder.(ValueEncoder); ok {
 			err = enc.EncodeValue(ec, vw, currVal)
 			if err != nil {
-				return err
+				return newEncodeError(key.String(), currVal, err)
 			}
 			continue
 		}
 		err = encoder.EncodeValue(ec, vw, currVal)
 		if err != nil {
-			return err
+			return newEncodeError(key.String(), currVal, err)
 		}
 	}
 
@@ -394,7 +395,7 @@ func (dve DefaultValueEncoders) ArrayEncodeValue(ec EncodeContext, vw bsonrw.Val
 	for idx := 0; idx < val.Len(); idx++ {
 		currEncoder, currVal, lookupErr := dve.lookupElementEncoder(ec, encoder, val.Index(idx))
 		if lookupErr != nil && lookupErr != errInvalidValue {
-			return lookupErr
+			return newEncodeError(strconv.Itoa(idx), currVal, lookupErr)
 		}
 
 		vw, err := aw.WriteArrayElement()
@@ -412,7 +413,7 @@ func (dve DefaultValueEncoders) ArrayEncodeValue(ec EncodeContext, vw bsonrw.Val
 
 		err = currEncoder.EncodeValue(ec, vw, currVal)
 		if err != nil {
-			return err
+			return newEncodeError(strconv.Itoa(idx), currVal, err)
 		}
 	}
 	return aw.WriteArrayEnd()
@@ -463,7 +464,7 @@ func (dve DefaultValueEncoders) SliceEncodeValue(ec EncodeContext, vw bsonrw.Val
 	for idx := 0; idx < val.Len(); idx++ {
 		currEncoder, currVal, lookupErr := dve.lookupElementEncoder(ec, encoder, val.Index(idx))
 		if lookupErr != nil && lookupErr != errInvalidValue {
-			return lookupErr
+			return newEncodeError(strconv.Itoa(idx), currVal, lookupErr)
 		}
 
 		vw, err := aw.WriteArrayElement()
@@ -481,7 +482,7 @@ func (dve DefaultValueEncoders) SliceEncodeValue(ec EncodeContext, vw bsonrw.Val
 
 		err = currEncoder.EncodeValue(ec, vw, currVal)
 		if err != nil {
-			return err
+			return newEncodeError(strconv.Itoa(idx), currVal, err)
 		}
 	}
 	return aw.WriteArrayEnd()
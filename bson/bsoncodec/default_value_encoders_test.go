@@ -264,7 +264,7 @@ func TestDefaultValueEncoders(t *testing.T) {
 					&EncodeContext{Registry: buildDefaultRegistry()},
 					&bsonrwtest.ValueReaderWriter{Err: errors.New("ev error"), ErrAfter: bsonrwtest.WriteString},
 					bsonrwtest.WriteString,
-					errors.New("ev error"),
+					&EncodeError{keys: []string{"foo"}, wrapped: errors.New("ev error"), goType: tString},
 				},
 				{
 					"empty map/success",
@@ -344,7 +344,7 @@ func TestDefaultValueEncoders(t *testing.T) {
 					&EncodeContext{Registry: buildDefaultRegistry()},
 					&bsonrwtest.ValueReaderWriter{Err: errors.New("ev error"), ErrAfter: bsonrwtest.WriteString},
 					bsonrwtest.WriteString,
-					errors.New("ev error"),
+					&EncodeError{keys: []string{"0"}, wrapped: errors.New("ev error"), goType: tString},
 				},
 				{
 					"[1]primitive.E/success",
@@ -422,7 +422,7 @@ func TestDefaultValueEncoders(t *testing.T) {
 					&EncodeContext{Registry: buildDefaultRegistry()},
 					&bsonrwtest.ValueReaderWriter{Err: errors.New("ev error"), ErrAfter: bsonrwtest.WriteString},
 					bsonrwtest.WriteString,
-					errors.New("ev error"),
+					&EncodeError{keys: []string{"0"}, wrapped: errors.New("ev error"), goType: tString},
 				},
 				{
 					"D/success",
@@ -110,7 +110,7 @@ func (mc *MapCodec) mapEncodeValue(ec EncodeContext, dw bsonrw.DocumentWriter, v
 
 		currEncoder, currVal, lookupErr := defaultValueEncoders.lookupElementEncoder(ec, encoder, val.MapIndex(key))
 		if lookupErr != nil && lookupErr != errInvalidValue {
-			return lookupErr
+			return newEncodeError(keyStr, currVal, lookupErr)
 		}
 
 		vw, err := dw.WriteDocumentElement(keyStr)
@@ -129,13 +129,13 @@ func (mc *MapCodec) mapEncodeValue(ec EncodeContext, dw bsonrw.DocumentWriter, v
 		if enc, ok := currEncoder.(ValueEncoder); ok {
 			err = enc.EncodeValue(ec, vw, currVal)
 			if err != nil {
-				return err
+				return newEncodeError(keyStr, currVal, err)
 			}
 			continue
 		}
 		err = encoder.EncodeValue(ec, vw, currVal)
 		if err != nil {
-			return err
+			return newEncodeError(keyStr, currVal, err)
 		}
 	}
 
@@ -200,9 +200,10 @@ func (mc *MapCodec) DecodeValue(dc DecodeContext, vr bsonrw.ValueReader, val ref
 		}
 
 		elem := reflect.New(eType).Elem()
+		bsonType := vr.Type()
 		err = decoder.DecodeValue(dc, vr, elem)
 		if err != nil {
-			return newDecodeError(key, err)
+			return wrapDecodeError(key, bsonType, eType, err)
 		}
 
 		val.SetMapIndex(k, elem)
@@ -9,6 +9,7 @@ package bsoncodec
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 
 	"go.mongodb.org/mongo-driver/bson/bsonoptions"
 	"go.mongodb.org/mongo-driver/bson/bsonrw"
@@ -88,7 +89,7 @@ func (sc SliceCodec) EncodeValue(ec EncodeContext, vw bsonrw.ValueWriter, val re
 	for idx := 0; idx < val.Len(); idx++ {
 		currEncoder, currVal, lookupErr := defaultValueEncoders.lookupElementEncoder(ec, encoder, val.Index(idx))
 		if lookupErr != nil && lookupErr != errInvalidValue {
-			return lookupErr
+			return newEncodeError(strconv.Itoa(idx), currVal, lookupErr)
 		}
 
 		vw, err := aw.WriteArrayElement()
@@ -106,7 +107,7 @@ func (sc SliceCodec) EncodeValue(ec EncodeContext, vw bsonrw.ValueWriter, val re
 
 		err = currEncoder.EncodeValue(ec, vw, currVal)
 		if err != nil {
-			return err
+			return newEncodeError(strconv.Itoa(idx), currVal, err)
 		}
 	}
 	return aw.WriteArrayEnd()
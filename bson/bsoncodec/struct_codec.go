@@ -12,6 +12,7 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/bsonoptions"
@@ -24,10 +25,21 @@ var defaultStructCodec = &StructCodec{
 	parser: DefaultStructTagParser,
 }
 
-// DecodeError represents an error that occurs when unmarshalling BSON bytes into a native Go type.
+// ErrUnknownField is returned, wrapped in a *DecodeError, when strict decoding is enabled and a document contains a
+// key that does not match a struct field and the struct does not have an inline map.
+var ErrUnknownField = errors.New("no struct field matches the key")
+
+// ErrDuplicateField is returned, wrapped in a *DecodeError, when strict decoding is enabled and a document contains the
+// same key more than once.
+var ErrDuplicateField = errors.New("duplicate key")
+
+// DecodeError represents an error that occurs when unmarshalling BSON bytes into a native Go type. It records the path
+// of the value that could not be decoded, the BSON type of the value, and the Go type it was being decoded into.
 type DecodeError struct {
-	keys    []string
-	wrapped error
+	keys     []string
+	wrapped  error
+	bsonType bsontype.Type
+	goType   reflect.Type
 }
 
 // Unwrap returns the underlying error
@@ -37,19 +49,77 @@ func (de *DecodeError) Unwrap() error {
 
 // Error implements the error interface.
 func (de *DecodeError) Error() string {
-	// The keys are stored in reverse order because the de.keys slice is builtup while propagating the error up the
-	// stack of BSON keys, so we call de.Keys(), which reverses them.
-	keyPath := strings.Join(de.Keys(), ".")
-	return fmt.Sprintf("error decoding key %s: %v", keyPath, de.wrapped)
+	if de.bsonType == 0 || de.goType == nil {
+		return fmt.Sprintf("error decoding key %s: %v", de.Path(), de.wrapped)
+	}
+	return fmt.Sprintf("error decoding key %s (BSON %v into Go %v): %v", de.Path(), de.bsonType, de.goType, de.wrapped)
 }
 
 // Keys returns the BSON key path that caused an error as a slice of strings. The keys in the slice are in top-down
 // order. For example, if the document being unmarshalled was {a: {b: {c: 1}}} and the value for c was supposed to be
-// a string, the keys slice will be ["a", "b", "c"].
+// a string, the keys slice will be ["a", "b", "c"]. Array elements are identified by their index.
 func (de *DecodeError) Keys() []string {
-	reversedKeys := make([]string, 0, len(de.keys))
-	for idx := len(de.keys) - 1; idx >= 0; idx-- {
-		reversedKeys = append(reversedKeys, de.keys[idx])
+	return reverseKeys(de.keys)
+}
+
+// Path returns the keys returned by Keys joined with dots, e.g. "orders.3.lineItems.0.qty".
+func (de *DecodeError) Path() string {
+	return strings.Join(de.Keys(), ".")
+}
+
+// BSONType returns the BSON type of the value that could not be decoded, or 0 if it is not known.
+func (de *DecodeError) BSONType() bsontype.Type {
+	return de.bsonType
+}
+
+// GoType returns the Go type that the value was being decoded into, or nil if it is not known.
+func (de *DecodeError) GoType() reflect.Type {
+	return de.goType
+}
+
+// EncodeError represents an error that occurs when marshalling a native Go type into BSON. It records the path of the
+// value that could not be encoded and its Go type.
+type EncodeError struct {
+	keys    []string
+	wrapped error
+	goType  reflect.Type
+}
+
+// Unwrap returns the underlying error
+func (ee *EncodeError) Unwrap() error {
+	return ee.wrapped
+}
+
+// Error implements the error interface.
+func (ee *EncodeError) Error() string {
+	if ee.goType == nil {
+		return fmt.Sprintf("error encoding key %s: %v", ee.Path(), ee.wrapped)
+	}
+	return fmt.Sprintf("error encoding key %s (Go %v): %v", ee.Path(), ee.goType, ee.wrapped)
+}
+
+// Keys returns the BSON key path of the value that caused an error as a slice of strings in top-down order. Array
+// elements are identified by their index.
+func (ee *EncodeError) Keys() []string {
+	return reverseKeys(ee.keys)
+}
+
+// Path returns the keys returned by Keys joined with dots, e.g. "orders.3.lineItems.0.qty".
+func (ee *EncodeError) Path() string {
+	return strings.Join(ee.Keys(), ".")
+}
+
+// GoType returns the Go type of the value that could not be encoded, or nil if it is not known.
+func (ee *EncodeError) GoType() reflect.Type {
+	return ee.goType
+}
+
+// reverseKeys returns a reversed copy of keys. The keys of DecodeError and EncodeError are stored in reverse order
+// because they are built up while propagating the error up the stack of BSON keys.
+func reverseKeys(keys []string) []string {
+	reversedKeys := make([]string, 0, len(keys))
+	for idx := len(keys) - 1; idx >= 0; idx-- {
+		reversedKeys = append(reversedKeys, keys[idx])
 	}
 
 	return reversedKeys
@@ -64,6 +134,11 @@ type Zeroer interface {
 
 // StructCodec is the Codec used for struct values.
 type StructCodec struct {
+	// The cache counters are accessed atomically, so they are kept at the start of the struct to guarantee 64-bit
+	// alignment on 32-bit platforms.
+	cacheHits   uint64
+	cacheMisses uint64
+
 	cache                   map[reflect.Type]*structDescription
 	l                       sync.RWMutex
 	parser                  StructTagParser
@@ -71,11 +146,22 @@ type StructCodec struct {
 	DecodeDeepZeroInline    bool
 	EncodeOmitDefaultStruct bool
 	AllowUnexportedFields   bool
+	DecodeStrict            bool
 }
 
 var _ ValueEncoder = &StructCodec{}
 var _ ValueDecoder = &StructCodec{}
 
+// StructCacheStats contains statistics about the struct descriptions cached by a StructCodec.
+type StructCacheStats struct {
+	// Entries is the number of struct types with a cached description.
+	Entries uint64
+	// Hits is the number of times a cached description was used.
+	Hits uint64
+	// Misses is the number of times a struct type was not cached and had to be described.
+	Misses uint64
+}
+
 // NewStructCodec returns a StructCodec that uses p for struct tag parsing.
 func NewStructCodec(p StructTagParser, opts ...*bsonoptions.StructCodecOptions) (*StructCodec, error) {
 	if p == nil {
@@ -101,6 +187,9 @@ func NewStructCodec(p StructTagParser, opts ...*bsonoptions.StructCodecOptions)
 	if structOpt.AllowUnexportedFields != nil {
 		codec.AllowUnexportedFields = *structOpt.AllowUnexportedFields
 	}
+	if structOpt.DecodeStrict != nil {
+		codec.DecodeStrict = *structOpt.DecodeStrict
+	}
 
 	return codec, nil
 }
@@ -121,7 +210,8 @@ func (sc *StructCodec) EncodeValue(r EncodeContext, vw bsonrw.ValueWriter, val r
 		return err
 	}
 	var rv reflect.Value
-	for _, desc := range sd.fl {
+	for i := range sd.fl {
+		desc := &sd.fl[i]
 		if desc.inline == nil {
 			rv = val.Field(desc.idx)
 		} else {
@@ -131,78 +221,86 @@ func (sc *StructCodec) EncodeValue(r EncodeContext, vw bsonrw.ValueWriter, val r
 			}
 		}
 
-		desc.encoder, rv, err = defaultValueEncoders.lookupElementEncoder(r, desc.encoder, rv)
-
-		if err != nil && err != errInvalidValue {
+		err = sc.encodeField(r, dw, desc.name, desc.encoder, rv, desc.omitEmpty, desc.minSize, desc.isZero)
+		if err != nil {
 			return err
 		}
+	}
 
-		if err == errInvalidValue {
-			if desc.omitEmpty {
-				continue
-			}
-			vw2, err := dw.WriteDocumentElement(desc.name)
-			if err != nil {
-				return err
-			}
-			err = vw2.WriteNull()
-			if err != nil {
-				return err
-			}
-			continue
+	if sd.inlineMap >= 0 {
+		rv := val.Field(sd.inlineMap)
+		collisionFn := func(key string) bool {
+			_, exists := sd.fm[key]
+			return exists
 		}
 
-		if desc.encoder == nil {
-			return ErrNoEncoder{Type: rv.Type()}
-		}
+		return defaultMapCodec.mapEncodeValue(r, dw, rv, collisionFn)
+	}
 
-		encoder := desc.encoder
+	return dw.WriteDocumentEnd()
+}
 
-		var isZero bool
-		rvInterface := rv.Interface()
-		if cz, ok := encoder.(CodecZeroer); ok {
-			isZero = cz.IsTypeZero(rvInterface)
-		} else if rv.Kind() == reflect.Interface {
-			// sc.isZero will not treat an interface rv as an interface, so we need to check for the zero interface separately.
-			isZero = rv.IsNil()
-		} else {
-			isZero = sc.isZero(rvInterface)
-		}
-		if desc.omitEmpty && isZero {
-			continue
-		}
+// encodeField encodes rv as the element named name using encoder, applying the omitempty and minsize struct tag
+// options. If isZero is non-nil, it is used instead of inspecting rv to determine if the value is empty.
+func (sc *StructCodec) encodeField(r EncodeContext, dw bsonrw.DocumentWriter, name string, encoder ValueEncoder,
+	rv reflect.Value, omitEmpty, minSize bool, isZero func(reflect.Value) bool) error {
 
-		vw2, err := dw.WriteDocumentElement(desc.name)
-		if err != nil {
-			return err
-		}
+	encoder, rv, err := defaultValueEncoders.lookupElementEncoder(r, encoder, rv)
+	if err != nil && err != errInvalidValue {
+		return newEncodeError(name, rv, err)
+	}
 
-		ectx := EncodeContext{Registry: r.Registry, MinSize: desc.minSize}
-		err = encoder.EncodeValue(ectx, vw2, rv)
+	if err == errInvalidValue {
+		if omitEmpty {
+			return nil
+		}
+		vw2, err := dw.WriteDocumentElement(name)
 		if err != nil {
 			return err
 		}
+		return vw2.WriteNull()
 	}
 
-	if sd.inlineMap >= 0 {
-		rv := val.Field(sd.inlineMap)
-		collisionFn := func(key string) bool {
-			_, exists := sd.fm[key]
-			return exists
+	if encoder == nil {
+		return newEncodeError(name, rv, ErrNoEncoder{Type: rv.Type()})
+	}
+
+	if omitEmpty {
+		if isZero == nil {
+			isZero = sc.zeroChecker(rv.Type(), encoder)
 		}
+		if isZero(rv) {
+			return nil
+		}
+	}
 
-		return defaultMapCodec.mapEncodeValue(r, dw, rv, collisionFn)
+	vw2, err := dw.WriteDocumentElement(name)
+	if err != nil {
+		return err
 	}
 
-	return dw.WriteDocumentEnd()
+	ectx := EncodeContext{Registry: r.Registry, MinSize: minSize}
+	err = encoder.EncodeValue(ectx, vw2, rv)
+	if err != nil {
+		return newEncodeError(name, rv, err)
+	}
+	return nil
 }
 
 func newDecodeError(key string, original error) error {
+	return wrapDecodeError(key, 0, nil, original)
+}
+
+// wrapDecodeError adds key to the path of original if it is a *DecodeError. Otherwise, it returns a new *DecodeError
+// for key that records the BSON type of the value and the Go type it was being decoded into.
+func wrapDecodeError(key string, bsonType bsontype.Type, goType reflect.Type, original error) error {
 	de, ok := original.(*DecodeError)
 	if !ok {
 		return &DecodeError{
-			keys:    []string{key},
-			wrapped: original,
+			keys:     []string{key},
+			wrapped:  original,
+			bsonType: bsonType,
+			goType:   goType,
 		}
 	}
 
@@ -210,6 +308,29 @@ func newDecodeError(key string, original error) error {
 	return de
 }
 
+// newEncodeError adds key to the path of original if it is an *EncodeError. Otherwise, it returns a new *EncodeError
+// for key that records the Go type of val. If val is a non-nil interface, the type of the value it holds is recorded.
+func newEncodeError(key string, val reflect.Value, original error) error {
+	ee, ok := original.(*EncodeError)
+	if !ok {
+		if val.Kind() == reflect.Interface && !val.IsNil() {
+			val = val.Elem()
+		}
+		var goType reflect.Type
+		if val.IsValid() {
+			goType = val.Type()
+		}
+		return &EncodeError{
+			keys:    []string{key},
+			wrapped: original,
+			goType:  goType,
+		}
+	}
+
+	ee.keys = append(ee.keys, key)
+	return ee
+}
+
 // DecodeValue implements the Codec interface.
 // By default, map types in val will not be cleared. If a map has existing key/value pairs, it will be extended with the new ones from vr.
 // For slices, the decoder will set the length of the slice to zero and append all elements. The underlying array will not be cleared.
@@ -265,6 +386,13 @@ func (sc *StructCodec) DecodeValue(r DecodeContext, vr bsonrw.ValueReader, val r
 		return err
 	}
 
+	strict := sc.DecodeStrict || r.Strict
+	var seenFields []bool
+	var seenKeys map[string]struct{}
+	if strict {
+		seenFields = make([]bool, len(sd.fl))
+	}
+
 	for {
 		name, vr, err := dr.ReadElement()
 		if err == bsonrw.ErrEOD {
@@ -274,15 +402,28 @@ func (sc *StructCodec) DecodeValue(r DecodeContext, vr bsonrw.ValueReader, val r
 			return err
 		}
 
-		fd, exists := sd.fm[name]
+		idx, exists := sd.fm[name]
 		if !exists {
 			// if the original name isn't found in the struct description, try again with the name in lowercase
 			// this could match if a BSON tag isn't specified because by default, describeStruct lowercases all field
 			// names
-			fd, exists = sd.fm[strings.ToLower(name)]
+			idx, exists = sd.fm[strings.ToLower(name)]
 		}
 
 		if !exists {
+			if strict && sd.inlineMap < 0 {
+				return newDecodeError(name, ErrUnknownField)
+			}
+			if strict {
+				if _, dup := seenKeys[name]; dup {
+					return newDecodeError(name, ErrDuplicateField)
+				}
+				if seenKeys == nil {
+					seenKeys = make(map[string]struct{})
+				}
+				seenKeys[name] = struct{}{}
+			}
+
 			if sd.inlineMap < 0 {
 				// The encoding/json package requires a flag to return on error for non-existent fields.
 				// This functionality seems appropriate for the struct codec.
@@ -299,14 +440,23 @@ func (sc *StructCodec) DecodeValue(r DecodeContext, vr bsonrw.ValueReader, val r
 
 			elem := reflect.New(inlineMap.Type().Elem()).Elem()
 			r.Ancestor = inlineMap.Type()
+			bsonType := vr.Type()
 			err = decoder.DecodeValue(r, vr, elem)
 			if err != nil {
-				return err
+				return wrapDecodeError(name, bsonType, elem.Type(), err)
 			}
 			inlineMap.SetMapIndex(reflect.ValueOf(name), elem)
 			continue
 		}
 
+		if strict {
+			if seenFields[idx] {
+				return newDecodeError(name, ErrDuplicateField)
+			}
+			seenFields[idx] = true
+		}
+
+		fd := &sd.fl[idx]
 		var field reflect.Value
 		if fd.inline == nil {
 			field = val.Field(fd.idx)
@@ -317,36 +467,78 @@ func (sc *StructCodec) DecodeValue(r DecodeContext, vr bsonrw.ValueReader, val r
 			}
 		}
 
-		if !field.CanSet() { // Being settable is a super set of being addressable.
-			innerErr := fmt.Errorf("field %v is not settable", field)
-			return newDecodeError(fd.name, innerErr)
-		}
-		if field.Kind() == reflect.Ptr && field.IsNil() {
-			field.Set(reflect.New(field.Type().Elem()))
+		err = decodeField(r, vr, fd.name, field, fd.decoder, fd.truncate)
+		if err != nil {
+			return err
 		}
-		field = field.Addr()
+	}
 
-		dctx := DecodeContext{Registry: r.Registry, Truncate: fd.truncate || r.Truncate}
-		if fd.decoder == nil {
-			return newDecodeError(fd.name, ErrNoDecoder{Type: field.Elem().Type()})
-		}
+	return nil
+}
 
-		if decoder, ok := fd.decoder.(ValueDecoder); ok {
-			err = decoder.DecodeValue(dctx, vr, field.Elem())
-			if err != nil {
-				return newDecodeError(fd.name, err)
-			}
-			continue
-		}
-		err = fd.decoder.DecodeValue(dctx, vr, field)
-		if err != nil {
-			return newDecodeError(fd.name, err)
-		}
+// decodeField decodes the value in vr into field using decoder, allocating field first if it is a nil pointer. Errors
+// are wrapped in a DecodeError for the key name.
+func decodeField(r DecodeContext, vr bsonrw.ValueReader, name string, field reflect.Value, decoder ValueDecoder,
+	truncate bool) error {
+
+	bsonType := vr.Type()
+	if !field.CanSet() { // Being settable is a super set of being addressable.
+		innerErr := fmt.Errorf("field %v is not settable", field)
+		return wrapDecodeError(name, bsonType, field.Type(), innerErr)
+	}
+	if field.Kind() == reflect.Ptr && field.IsNil() {
+		field.Set(reflect.New(field.Type().Elem()))
 	}
+	field = field.Addr()
 
+	dctx := DecodeContext{Registry: r.Registry, Truncate: truncate || r.Truncate, Strict: r.Strict}
+	if decoder == nil {
+		return wrapDecodeError(name, bsonType, field.Elem().Type(), ErrNoDecoder{Type: field.Elem().Type()})
+	}
+
+	err := decoder.DecodeValue(dctx, vr, field.Elem())
+	if err != nil {
+		return wrapDecodeError(name, bsonType, field.Elem().Type(), err)
+	}
 	return nil
 }
 
+// zeroChecker returns a function that reports whether a value of type t, which is encoded with encoder, is empty. The
+// function gives the same result as checking the value with the encoder's CodecZeroer implementation or sc.isZero.
+func (sc *StructCodec) zeroChecker(t reflect.Type, encoder ValueEncoder) func(reflect.Value) bool {
+	if cz, ok := encoder.(CodecZeroer); ok {
+		return func(v reflect.Value) bool { return cz.IsTypeZero(v.Interface()) }
+	}
+	if t.Kind() == reflect.Interface {
+		// sc.isZero will not treat an interface value as an interface, so the zero interface is checked separately.
+		return func(v reflect.Value) bool { return v.IsNil() }
+	}
+	if t.Implements(tZeroer) {
+		if t.Kind() == reflect.Ptr {
+			return func(v reflect.Value) bool { return v.IsNil() || v.Interface().(Zeroer).IsZero() }
+		}
+		return func(v reflect.Value) bool { return v.Interface().(Zeroer).IsZero() }
+	}
+
+	switch t.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return func(v reflect.Value) bool { return v.Len() == 0 }
+	case reflect.Bool:
+		return func(v reflect.Value) bool { return !v.Bool() }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(v reflect.Value) bool { return v.Int() == 0 }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(v reflect.Value) bool { return v.Uint() == 0 }
+	case reflect.Float32, reflect.Float64:
+		return func(v reflect.Value) bool { return v.Float() == 0 }
+	case reflect.Ptr:
+		return func(v reflect.Value) bool { return v.IsNil() }
+	case reflect.Struct:
+		return func(v reflect.Value) bool { return sc.isZero(v.Interface()) }
+	}
+	return func(reflect.Value) bool { return false }
+}
+
 func (sc *StructCodec) isZero(i interface{}) bool {
 	v := reflect.ValueOf(i)
 
@@ -394,9 +586,10 @@ func (sc *StructCodec) isZero(i interface{}) bool {
 	return false
 }
 
+// structDescription is the precomputed plan for encoding and decoding a struct type.
 type structDescription struct {
-	fm        map[string]fieldDescription
-	fl        []fieldDescription
+	fl        []fieldDescription // fields in encoding order
+	fm        map[string]int     // index in fl of the field with each BSON key; never modified once cached
 	inlineMap int
 	inline    bool
 }
@@ -411,6 +604,7 @@ type fieldDescription struct {
 	inline    []int
 	encoder   ValueEncoder
 	decoder   ValueDecoder
+	isZero    func(reflect.Value) bool // nil if the field is not omitempty or its type is an interface
 }
 
 func (sc *StructCodec) describeStruct(r *Registry, t reflect.Type) (*structDescription, error) {
@@ -420,12 +614,14 @@ func (sc *StructCodec) describeStruct(r *Registry, t reflect.Type) (*structDescr
 	ds, exists := sc.cache[t]
 	sc.l.RUnlock()
 	if exists {
+		atomic.AddUint64(&sc.cacheHits, 1)
 		return ds, nil
 	}
+	atomic.AddUint64(&sc.cacheMisses, 1)
 
 	numFields := t.NumField()
+	fm := make(map[string]fieldDescription, numFields)
 	sd := &structDescription{
-		fm:        make(map[string]fieldDescription, numFields),
 		fl:        make([]fieldDescription, 0, numFields),
 		inlineMap: -1,
 	}
@@ -465,6 +661,10 @@ func (sc *StructCodec) describeStruct(r *Registry, t reflect.Type) (*structDescr
 		description.omitEmpty = stags.OmitEmpty
 		description.minSize = stags.MinSize
 		description.truncate = stags.Truncate
+		if description.omitEmpty && sfType.Kind() != reflect.Interface {
+			// The element encoder of an interface field depends on the dynamic type, so it is checked when encoding.
+			description.isZero = sc.zeroChecker(sfType, encoder)
+		}
 
 		if stags.Inline {
 			sd.inline = true
@@ -489,7 +689,7 @@ func (sc *StructCodec) describeStruct(r *Registry, t reflect.Type) (*structDescr
 					return nil, err
 				}
 				for _, fd := range inlinesf.fl {
-					if _, exists := sd.fm[fd.name]; exists {
+					if _, exists := fm[fd.name]; exists {
 						return nil, fmt.Errorf("(struct %s) duplicated key %s", t.String(), fd.name)
 					}
 					if fd.inline == nil {
@@ -497,7 +697,7 @@ func (sc *StructCodec) describeStruct(r *Registry, t reflect.Type) (*structDescr
 					} else {
 						fd.inline = append([]int{i}, fd.inline...)
 					}
-					sd.fm[fd.name] = fd
+					fm[fd.name] = fd
 					sd.fl = append(sd.fl, fd)
 				}
 			default:
@@ -506,14 +706,19 @@ func (sc *StructCodec) describeStruct(r *Registry, t reflect.Type) (*structDescr
 			continue
 		}
 
-		if _, exists := sd.fm[description.name]; exists {
+		if _, exists := fm[description.name]; exists {
 			return nil, fmt.Errorf("struct %s) duplicated key %s", t.String(), description.name)
 		}
 
-		sd.fm[description.name] = description
+		fm[description.name] = description
 		sd.fl = append(sd.fl, description)
 	}
 
+	sd.fm = make(map[string]int, len(sd.fl))
+	for i, fd := range sd.fl {
+		sd.fm[fd.name] = i
+	}
+
 	sc.l.Lock()
 	sc.cache[t] = sd
 	sc.l.Unlock()
@@ -521,6 +726,19 @@ func (sc *StructCodec) describeStruct(r *Registry, t reflect.Type) (*structDescr
 	return sd, nil
 }
 
+// CacheStats returns statistics about the struct descriptions cached by sc.
+func (sc *StructCodec) CacheStats() StructCacheStats {
+	sc.l.RLock()
+	entries := len(sc.cache)
+	sc.l.RUnlock()
+
+	return StructCacheStats{
+		Entries: uint64(entries),
+		Hits:    atomic.LoadUint64(&sc.cacheHits),
+		Misses:  atomic.LoadUint64(&sc.cacheMisses),
+	}
+}
+
 func fieldByIndexErr(v reflect.Value, index []int) (result reflect.Value, err error) {
 	defer func() {
 		if recovered := recover(); recovered != nil {
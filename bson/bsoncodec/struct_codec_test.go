@@ -7,10 +7,15 @@
 package bsoncodec
 
 import (
+	"reflect"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/bsonoptions"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
 )
 
 func TestZeoerInterfaceUsedByDecoder(t *testing.T) {
@@ -45,3 +50,145 @@ func TestZeoerInterfaceUsedByDecoder(t *testing.T) {
 	var zp *zeroTest
 	assert.True(t, enc.isZero(zp))
 }
+
+func TestZeroCheckerMatchesIsZero(t *testing.T) {
+	sc := &StructCodec{}
+	var nilTime *time.Time
+	values := []interface{}{
+		0, 1, int8(0), uint(0), uint(1), 0.0, 1.5, "", "a", false, true,
+		[]int(nil), []int{1}, map[string]int{}, [0]int{}, [1]int{},
+		nilTime, &time.Time{}, time.Time{}, time.Now(),
+		zeroTest{}, zeroTest{reportZero: true}, (*zeroTest)(nil), &zeroTest{reportZero: true},
+		nonZeroer{}, struct{ A int }{},
+	}
+	for _, val := range values {
+		rv := reflect.ValueOf(val)
+		want := sc.isZero(val)
+		got := sc.zeroChecker(rv.Type(), nil)(rv)
+		assert.Equal(t, want, got, "zero check mismatch for %#v", val)
+	}
+
+	sc.EncodeOmitDefaultStruct = true
+	rv := reflect.ValueOf(struct{ A int }{})
+	assert.True(t, sc.zeroChecker(rv.Type(), nil)(rv), "expected empty struct to be zero")
+}
+
+func TestStructDescriptionCache(t *testing.T) {
+	type inner struct {
+		B string `bson:"b"`
+	}
+	type outer struct {
+		C     int
+		A     int   `bson:"a"`
+		Inner inner `bson:",inline"`
+	}
+
+	sc, err := NewStructCodec(DefaultStructTagParser)
+	assert.Nil(t, err, "NewStructCodec error: %v", err)
+	r := buildDefaultRegistry()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := sc.describeStruct(r, reflect.TypeOf(outer{}))
+			assert.Nil(t, err, "describeStruct error: %v", err)
+		}()
+	}
+	wg.Wait()
+
+	before := sc.CacheStats()
+	assert.Equal(t, uint64(2), before.Entries, "expected 2 cached types, got %d", before.Entries)
+	assert.True(t, before.Misses >= 2, "expected at least 2 misses, got %d", before.Misses)
+
+	sd, err := sc.describeStruct(r, reflect.TypeOf(outer{}))
+	assert.Nil(t, err, "describeStruct error: %v", err)
+	after := sc.CacheStats()
+	assert.Equal(t, before.Hits+1, after.Hits, "expected hits to increase by 1")
+	assert.Equal(t, before.Misses, after.Misses, "expected misses not to change")
+	for _, name := range []string{"a", "b", "c"} {
+		idx, ok := sd.fm[name]
+		assert.True(t, ok, "expected field %s to be found", name)
+		if ok {
+			assert.Equal(t, name, sd.fl[idx].name, "expected field %s, got %s", name, sd.fl[idx].name)
+		}
+	}
+	_, ok := sd.fm["d"]
+	assert.False(t, ok, "expected field d not to be found")
+}
+
+func TestStructCodecDecodeStrict(t *testing.T) {
+	type strict struct {
+		A int
+	}
+	sc, err := NewStructCodec(DefaultStructTagParser, bsonoptions.StructCodec().SetDecodeStrict(true))
+	assert.Nil(t, err, "NewStructCodec error: %v", err)
+	doc := bsoncore.BuildDocument(nil,
+		bsoncore.AppendInt32Element(nil, "a", 1),
+		bsoncore.AppendInt32Element(nil, "b", 2),
+	)
+	var got strict
+	err = sc.DecodeValue(DecodeContext{Registry: buildDefaultRegistry()}, bsonrw.NewBSONDocumentReader(doc), reflect.ValueOf(&got).Elem())
+	de, ok := err.(*DecodeError)
+	assert.True(t, ok, "expected error of type *DecodeError, got %v", err)
+	if ok {
+		assert.Equal(t, ErrUnknownField, de.Unwrap(), "wrapped error mismatch")
+		assert.Equal(t, []string{"b"}, de.Keys(), "keys mismatch")
+	}
+}
+
+// benchStruct is a struct with a typical number of fields for benchmarking field lookups.
+type benchStruct struct {
+	Name     string
+	Email    string
+	Age      int
+	Score    float64
+	Active   bool
+	Tags     []string
+	Created  time.Time
+	Updated  time.Time
+	Owner    string
+	Group    string
+	Priority int32
+	Version  int64
+}
+
+func BenchmarkStructDescriptionLookup(b *testing.B) {
+	sc, err := NewStructCodec(DefaultStructTagParser)
+	if err != nil {
+		b.Fatal(err)
+	}
+	sd, err := sc.describeStruct(buildDefaultRegistry(), reflect.TypeOf(benchStruct{}))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, fd := range sd.fl {
+			_ = sd.fm[fd.name]
+		}
+	}
+}
+
+// BenchmarkDescribeStructParallel looks up a cached struct description from many goroutines at once. Run it with
+// -cpu to set the number of goroutines, e.g. -cpu 64.
+func BenchmarkDescribeStructParallel(b *testing.B) {
+	sc, err := NewStructCodec(DefaultStructTagParser)
+	if err != nil {
+		b.Fatal(err)
+	}
+	r := buildDefaultRegistry()
+	t := reflect.TypeOf(benchStruct{})
+	if _, err = sc.describeStruct(r, t); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = sc.describeStruct(r, t)
+		}
+	})
+}
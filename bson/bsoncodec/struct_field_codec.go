@@ -0,0 +1,82 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsoncodec
+
+import (
+	"reflect"
+
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// The functions in this file are used by struct codecs generated by cmd/bsoncodecgen. They encode and decode single
+// struct fields in exactly the same way the default StructCodec does, so generated codecs only need to specialize the
+// fields they can handle directly.
+
+// EncodeStructField encodes val as the element named name using the encoder registered in ec for the type of val. The
+// omitEmpty and minSize parameters correspond to the omitempty and minsize struct tag options.
+func EncodeStructField(ec EncodeContext, dw bsonrw.DocumentWriter, name string, val reflect.Value, omitEmpty, minSize bool) error {
+	// A failed lookup is reported the same way the StructCodec reports it, which is when the field is encoded.
+	encoder, _ := ec.LookupEncoder(val.Type())
+	return defaultStructCodec.encodeField(ec, dw, name, encoder, val, omitEmpty, minSize, nil)
+}
+
+// DecodeStructField decodes the value in vr into val using the decoder registered in dc for the type of val. If val is
+// a nil pointer, a new value is allocated for it. The truncate parameter corresponds to the truncate struct tag option.
+// Errors are returned as a *DecodeError for the key name.
+func DecodeStructField(dc DecodeContext, vr bsonrw.ValueReader, name string, val reflect.Value, truncate bool) error {
+	decoder, _ := dc.LookupDecoder(val.Type())
+	return decodeField(dc, vr, name, val, decoder, truncate)
+}
+
+// EncodeInlineMap encodes the entries of the inline map val as elements of dw and then ends the document. The
+// collisionFn function reports whether a key is already used by a struct field.
+func EncodeInlineMap(ec EncodeContext, dw bsonrw.DocumentWriter, val reflect.Value, collisionFn func(string) bool) error {
+	return defaultMapCodec.mapEncodeValue(ec, dw, val, collisionFn)
+}
+
+// DecodeInlineMapElement decodes the value in vr and stores it in the inline map val under the key name. If val is a
+// nil map, a new map is allocated for it.
+func DecodeInlineMapElement(dc DecodeContext, vr bsonrw.ValueReader, name string, val reflect.Value) error {
+	decoder, err := dc.LookupDecoder(val.Type().Elem())
+	if err != nil {
+		return err
+	}
+
+	if val.IsNil() {
+		val.Set(reflect.MakeMap(val.Type()))
+	}
+
+	elem := reflect.New(val.Type().Elem()).Elem()
+	dc.Ancestor = val.Type()
+	bsonType := vr.Type()
+	err = decoder.DecodeValue(dc, vr, elem)
+	if err != nil {
+		return wrapDecodeError(name, bsonType, elem.Type(), err)
+	}
+	val.SetMapIndex(reflect.ValueOf(name), elem)
+	return nil
+}
+
+// NewDecodeError returns err wrapped in a *DecodeError for the key name. If err is already a *DecodeError, name is
+// added as the parent of the keys it already contains.
+func NewDecodeError(name string, err error) error {
+	return newDecodeError(name, err)
+}
+
+// WrapDecodeError returns err wrapped in a *DecodeError for the key name that records a failure to decode a value of
+// type bsonType into a Go value of type goType. If err is already a *DecodeError, name is added as the parent of the
+// keys it already contains and the types it already records are kept.
+func WrapDecodeError(name string, bsonType bsontype.Type, goType reflect.Type, err error) error {
+	return wrapDecodeError(name, bsonType, goType, err)
+}
+
+// WrapEncodeError returns err wrapped in an *EncodeError for the key name that records the Go type of val. If err is
+// already an *EncodeError, name is added as the parent of the keys it already contains.
+func WrapEncodeError(name string, val reflect.Value, err error) error {
+	return newEncodeError(name, val, err)
+}
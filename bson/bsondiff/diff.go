@@ -0,0 +1,318 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsondiff
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// ChangeType is the kind of a Change.
+type ChangeType uint8
+
+// These constants are the kinds of changes reported by Compare.
+const (
+	// Added indicates a field or array element that only exists in the new document.
+	Added ChangeType = iota + 1
+
+	// Removed indicates a field or array element that only exists in the old document.
+	Removed
+
+	// Modified indicates a value that exists in both documents but is different. Values of different BSON types are
+	// different even if they are numerically equal.
+	Modified
+
+	// Moved indicates an element of an array with an identity key that is at a different index in the new document.
+	Moved
+)
+
+func (ct ChangeType) String() string {
+	switch ct {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case Modified:
+		return "modified"
+	case Moved:
+		return "moved"
+	default:
+		return fmt.Sprintf("ChangeType(%d)", uint8(ct))
+	}
+}
+
+// Change is a single difference between two documents.
+type Change struct {
+	Type ChangeType
+
+	// Path is the path of the value. Array elements are identified by their index in the new document, except that
+	// the path of a removed array element ends with its index in the old document.
+	Path []string
+
+	// From is the path of the element in the old document for Moved changes.
+	From []string
+
+	// Old is the value in the old document. It is empty for Added changes.
+	Old bson.RawValue
+
+	// New is the value in the new document. It is empty for Removed changes.
+	New bson.RawValue
+}
+
+func (c Change) String() string {
+	switch c.Type {
+	case Added:
+		return fmt.Sprintf("%s %s: %v", c.Type, dotted(c.Path), c.New)
+	case Removed:
+		return fmt.Sprintf("%s %s: %v", c.Type, dotted(c.Path), c.Old)
+	case Moved:
+		return fmt.Sprintf("%s %s: from %s", c.Type, dotted(c.Path), dotted(c.From))
+	default:
+		return fmt.Sprintf("%s %s: %v -> %v", c.Type, dotted(c.Path), c.Old, c.New)
+	}
+}
+
+// Options configures how documents are compared.
+type Options struct {
+	// ArrayKeys maps the path of an array to the key that identifies its elements. Array indexes in a path can be
+	// replaced with "*" to match every element of an array, e.g. "orders.*.items". Arrays without an identity key
+	// are compared by index.
+	ArrayKeys map[string]string
+}
+
+// NewOptions creates a new Options instance.
+func NewOptions() *Options {
+	return &Options{}
+}
+
+// SetArrayKey specifies that the elements of the array at path are documents identified by the value of key.
+// Elements of the old and new array with the same key value are compared with each other regardless of their index.
+// If an element of the array is not a document, is missing the key, or has the same key value as another element,
+// the array is compared by index instead.
+func (o *Options) SetArrayKey(path, key string) *Options {
+	if o.ArrayKeys == nil {
+		o.ArrayKeys = make(map[string]string)
+	}
+	o.ArrayKeys[path] = key
+	return o
+}
+
+// mergeOptions combines the given Options instances into a single Options in a last-one-wins fashion.
+func mergeOptions(opts ...*Options) *Options {
+	merged := NewOptions()
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		for path, key := range opt.ArrayKeys {
+			merged.SetArrayKey(path, key)
+		}
+	}
+	return merged
+}
+
+// Diff is the set of changes between two documents.
+type Diff struct {
+	Changes []Change
+
+	old, new bson.Raw
+}
+
+// Equal returns true if the documents are equal.
+func (d *Diff) Equal() bool {
+	return len(d.Changes) == 0
+}
+
+// Compare returns the changes that turn the document old into the document new.
+func Compare(old, new bson.Raw, opts ...*Options) (*Diff, error) {
+	if err := old.Validate(); err != nil {
+		return nil, err
+	}
+	if err := new.Validate(); err != nil {
+		return nil, err
+	}
+
+	c := &comparer{opts: mergeOptions(opts...)}
+	c.compareDocuments(nil, old, new)
+	return &Diff{Changes: c.changes, old: old, new: new}, nil
+}
+
+type comparer struct {
+	opts    *Options
+	changes []Change
+}
+
+func (c *comparer) add(ct ChangeType, path []string, old, new bson.RawValue) {
+	c.changes = append(c.changes, Change{Type: ct, Path: path, Old: old, New: new})
+}
+
+// compareValues compares two values that are at the same path in both documents.
+func (c *comparer) compareValues(path []string, old, new bson.RawValue) {
+	if equal(old, new) {
+		return
+	}
+
+	switch {
+	case old.Type == bsontype.EmbeddedDocument && new.Type == bsontype.EmbeddedDocument:
+		c.compareDocuments(path, old.Document(), new.Document())
+	case old.Type == bsontype.Array && new.Type == bsontype.Array:
+		c.compareArrays(path, old.Array(), new.Array())
+	default:
+		c.add(Modified, path, old, new)
+	}
+}
+
+func (c *comparer) compareDocuments(path []string, old, new bson.Raw) {
+	// The documents were validated before they were traversed, so these cannot fail.
+	oldElems, _ := old.Elements()
+	newElems, _ := new.Elements()
+
+	for _, oe := range oldElems {
+		key := oe.Key()
+		nv, err := new.LookupErr(key)
+		if err != nil {
+			c.add(Removed, appendPath(path, key), oe.Value(), bson.RawValue{})
+			continue
+		}
+		c.compareValues(appendPath(path, key), oe.Value(), nv)
+	}
+	for _, ne := range newElems {
+		key := ne.Key()
+		if _, err := old.LookupErr(key); err != nil {
+			c.add(Added, appendPath(path, key), bson.RawValue{}, ne.Value())
+		}
+	}
+}
+
+func (c *comparer) compareArrays(path []string, old, new bson.Raw) {
+	oldVals, _ := old.Values()
+	newVals, _ := new.Values()
+
+	if key, ok := c.arrayKey(path); ok {
+		oldIDs, oldOK := identities(oldVals, key)
+		newIDs, newOK := identities(newVals, key)
+		if oldOK && newOK {
+			c.compareKeyedArrays(path, oldVals, newVals, oldIDs, newIDs)
+			return
+		}
+	}
+
+	for i := 0; i < len(oldVals) && i < len(newVals); i++ {
+		c.compareValues(appendPath(path, strconv.Itoa(i)), oldVals[i], newVals[i])
+	}
+	for i := len(newVals); i < len(oldVals); i++ {
+		c.add(Removed, appendPath(path, strconv.Itoa(i)), oldVals[i], bson.RawValue{})
+	}
+	for i := len(oldVals); i < len(newVals); i++ {
+		c.add(Added, appendPath(path, strconv.Itoa(i)), bson.RawValue{}, newVals[i])
+	}
+}
+
+func (c *comparer) compareKeyedArrays(path []string, oldVals, newVals []bson.RawValue, oldIDs, newIDs []string) {
+	oldIndexes := make(map[string]int, len(oldIDs))
+	for i, id := range oldIDs {
+		oldIndexes[id] = i
+	}
+
+	matched := make([]bool, len(oldVals))
+	for j, id := range newIDs {
+		elemPath := appendPath(path, strconv.Itoa(j))
+		i, ok := oldIndexes[id]
+		if !ok {
+			c.add(Added, elemPath, bson.RawValue{}, newVals[j])
+			continue
+		}
+		matched[i] = true
+		if i != j {
+			c.changes = append(c.changes, Change{
+				Type: Moved,
+				Path: elemPath,
+				From: appendPath(path, strconv.Itoa(i)),
+				Old:  oldVals[i],
+				New:  newVals[j],
+			})
+		}
+		c.compareValues(elemPath, oldVals[i], newVals[j])
+	}
+	for i, ok := range matched {
+		if !ok {
+			c.add(Removed, appendPath(path, strconv.Itoa(i)), oldVals[i], bson.RawValue{})
+		}
+	}
+}
+
+// arrayKey returns the identity key configured for the array at path. A pattern without wildcards takes precedence.
+func (c *comparer) arrayKey(path []string) (string, bool) {
+	if key, ok := c.opts.ArrayKeys[dotted(path)]; ok {
+		return key, true
+	}
+	for pattern, key := range c.opts.ArrayKeys {
+		if matchPath(strings.Split(pattern, "."), path) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+func matchPath(pattern, path []string) bool {
+	if len(pattern) != len(path) {
+		return false
+	}
+	for i, seg := range pattern {
+		if seg != "*" && seg != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// identities returns the identity of every element of vals, which is the value of key in the element. It returns
+// false if an element is not a document, does not contain key, or has the same identity as another element.
+func identities(vals []bson.RawValue, key string) ([]string, bool) {
+	ids := make([]string, 0, len(vals))
+	seen := make(map[string]bool, len(vals))
+	for _, val := range vals {
+		doc, ok := val.DocumentOK()
+		if !ok {
+			return nil, false
+		}
+		idv, err := doc.LookupErr(key)
+		if err != nil {
+			return nil, false
+		}
+		id := string(idv.Type) + string(idv.Value)
+		if seen[id] {
+			return nil, false
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids, true
+}
+
+// equal reports whether a and b have the same type and the same bytes. An empty value is only equal to another empty
+// value.
+func equal(a, b bson.RawValue) bool {
+	return a.Type == b.Type && bytes.Equal(a.Value, b.Value)
+}
+
+// appendPath returns a new path that consists of path followed by key. The result never shares memory with path.
+func appendPath(path []string, key string) []string {
+	p := make([]string, len(path)+1)
+	copy(p, path)
+	p[len(path)] = key
+	return p
+}
+
+func dotted(path []string) string {
+	return strings.Join(path, ".")
+}
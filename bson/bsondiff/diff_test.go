@@ -0,0 +1,207 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsondiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func marshal(t *testing.T, doc bson.D) bson.Raw {
+	t.Helper()
+
+	b, err := bson.Marshal(doc)
+	require.NoError(t, err, "Marshal error")
+	return b
+}
+
+func rawValue(t *testing.T, val interface{}) bson.RawValue {
+	t.Helper()
+
+	typ, data, err := bson.MarshalValue(val)
+	require.NoError(t, err, "MarshalValue error")
+	return bson.RawValue{Type: typ, Value: data}
+}
+
+// summary describes a change without its values.
+type summary struct {
+	Type ChangeType
+	Path string
+	From string
+}
+
+func summarize(changes []Change) []summary {
+	s := make([]summary, 0, len(changes))
+	for _, ch := range changes {
+		s = append(s, summary{Type: ch.Type, Path: dotted(ch.Path), From: dotted(ch.From)})
+	}
+	return s
+}
+
+func TestCompare(t *testing.T) {
+	old := marshal(t, bson.D{
+		{"_id", 1},
+		{"name", "a"},
+		{"qty", int32(5)},
+		{"address", bson.D{{"city", "x"}, {"zip", "1"}}},
+		{"tags", bson.A{"a", "b", "c"}},
+		{"items", bson.A{
+			bson.D{{"sku", "s1"}, {"qty", 1}},
+			bson.D{{"sku", "s2"}, {"qty", 2}},
+			bson.D{{"sku", "s3"}, {"qty", 3}},
+		}},
+	})
+	new := marshal(t, bson.D{
+		{"_id", 1},
+		{"name", "b"},
+		{"qty", int64(5)},
+		{"address", bson.D{{"city", "x"}, {"street", "y"}}},
+		{"tags", bson.A{"a", "c"}},
+		{"items", bson.A{
+			bson.D{{"sku", "s0"}, {"qty", 0}},
+			bson.D{{"sku", "s1"}, {"qty", 1}},
+			bson.D{{"sku", "s3"}, {"qty", 4}},
+		}},
+		{"note", "n"},
+	})
+
+	t.Run("by index", func(t *testing.T) {
+		diff, err := Compare(old, new)
+		require.NoError(t, err, "Compare error")
+
+		want := []summary{
+			{Type: Modified, Path: "name"},
+			{Type: Modified, Path: "qty"},
+			{Type: Removed, Path: "address.zip"},
+			{Type: Added, Path: "address.street"},
+			{Type: Modified, Path: "tags.1"},
+			{Type: Removed, Path: "tags.2"},
+			{Type: Modified, Path: "items.0.sku"},
+			{Type: Modified, Path: "items.0.qty"},
+			{Type: Modified, Path: "items.1.sku"},
+			{Type: Modified, Path: "items.1.qty"},
+			{Type: Modified, Path: "items.2.qty"},
+			{Type: Added, Path: "note"},
+		}
+		require.Equal(t, want, summarize(diff.Changes), "changes do not match")
+		require.Equal(t, rawValue(t, "a"), diff.Changes[0].Old, "expected old value")
+		require.Equal(t, rawValue(t, "b"), diff.Changes[0].New, "expected new value")
+		require.False(t, diff.Equal(), "expected documents to differ")
+	})
+	t.Run("by identity key", func(t *testing.T) {
+		diff, err := Compare(old, new, NewOptions().SetArrayKey("items", "sku"))
+		require.NoError(t, err, "Compare error")
+
+		want := []summary{
+			{Type: Modified, Path: "name"},
+			{Type: Modified, Path: "qty"},
+			{Type: Removed, Path: "address.zip"},
+			{Type: Added, Path: "address.street"},
+			{Type: Modified, Path: "tags.1"},
+			{Type: Removed, Path: "tags.2"},
+			{Type: Added, Path: "items.0"},
+			{Type: Moved, Path: "items.1", From: "items.0"},
+			{Type: Modified, Path: "items.2.qty"},
+			{Type: Removed, Path: "items.1"},
+			{Type: Added, Path: "note"},
+		}
+		require.Equal(t, want, summarize(diff.Changes), "changes do not match")
+	})
+	t.Run("wildcard identity key", func(t *testing.T) {
+		a := marshal(t, bson.D{{"orders", bson.A{bson.D{{"lines", bson.A{bson.D{{"id", 1}}, bson.D{{"id", 2}}}}}}}})
+		b := marshal(t, bson.D{{"orders", bson.A{bson.D{{"lines", bson.A{bson.D{{"id", 2}}}}}}}})
+		diff, err := Compare(a, b, NewOptions().SetArrayKey("orders.*.lines", "id"))
+		require.NoError(t, err, "Compare error")
+
+		want := []summary{
+			{Type: Moved, Path: "orders.0.lines.0", From: "orders.0.lines.1"},
+			{Type: Removed, Path: "orders.0.lines.0"},
+		}
+		require.Equal(t, want, summarize(diff.Changes), "changes do not match")
+	})
+	t.Run("duplicate identities fall back to index", func(t *testing.T) {
+		a := marshal(t, bson.D{{"items", bson.A{bson.D{{"sku", "s"}}, bson.D{{"sku", "s"}}}}})
+		b := marshal(t, bson.D{{"items", bson.A{bson.D{{"sku", "s"}}}}})
+		diff, err := Compare(a, b, NewOptions().SetArrayKey("items", "sku"))
+		require.NoError(t, err, "Compare error")
+		require.Equal(t, []summary{{Type: Removed, Path: "items.1"}}, summarize(diff.Changes), "changes do not match")
+	})
+	t.Run("equal", func(t *testing.T) {
+		diff, err := Compare(old, old)
+		require.NoError(t, err, "Compare error")
+		require.True(t, diff.Equal(), "expected documents to be equal, got %v", diff.Changes)
+	})
+	t.Run("invalid document", func(t *testing.T) {
+		_, err := Compare(old, new[:len(new)-2])
+		require.Error(t, err, "expected error for invalid document")
+	})
+}
+
+func TestDiffUpdate(t *testing.T) {
+	old := marshal(t, bson.D{
+		{"_id", 1},
+		{"name", "a"},
+		{"address", bson.D{{"city", "x"}, {"zip", "1"}}},
+		{"tags", bson.A{"a", "b", "c"}},
+		{"items", bson.A{bson.D{{"sku", "s1"}, {"qty", 1}}, bson.D{{"sku", "s2"}, {"qty", 2}}}},
+	})
+	new := marshal(t, bson.D{
+		{"_id", 1},
+		{"name", "b"},
+		{"address", bson.D{{"city", "x"}}},
+		{"tags", bson.A{"a", "c"}},
+		{"items", bson.A{bson.D{{"sku", "s1"}, {"qty", 1}}, bson.D{{"sku", "s2"}, {"qty", 5}}}},
+		{"note", bson.D{{"text", "n"}}},
+	})
+
+	diff, err := Compare(old, new)
+	require.NoError(t, err, "Compare error")
+	update, err := diff.Update()
+	require.NoError(t, err, "Update error")
+
+	want := bson.D{
+		{"$set", bson.D{
+			{"name", rawValue(t, "b")},
+			{"tags", rawValue(t, bson.A{"a", "c"})},
+			{"items.1.qty", rawValue(t, 5)},
+			{"note", rawValue(t, bson.D{{"text", "n"}})},
+		}},
+		{"$unset", bson.D{{"address.zip", ""}}},
+	}
+	require.Equal(t, marshal(t, want), marshal(t, update), "expected update %v, got %v", want, update)
+
+	t.Run("moved elements replace the array", func(t *testing.T) {
+		a := marshal(t, bson.D{{"items", bson.A{bson.D{{"sku", "s1"}, {"qty", 1}}, bson.D{{"sku", "s2"}}}}})
+		b := marshal(t, bson.D{{"items", bson.A{bson.D{{"sku", "s2"}}, bson.D{{"sku", "s1"}, {"qty", 2}}}}})
+		diff, err := Compare(a, b, NewOptions().SetArrayKey("items", "sku"))
+		require.NoError(t, err, "Compare error")
+		update, err := diff.Update()
+		require.NoError(t, err, "Update error")
+
+		items, err := b.LookupErr("items")
+		require.NoError(t, err, "LookupErr error")
+		want := bson.D{{"$set", bson.D{{"items", items}}}}
+		require.Equal(t, want, update, "expected update %v, got %v", want, update)
+	})
+	t.Run("equal documents", func(t *testing.T) {
+		diff, err := Compare(old, old)
+		require.NoError(t, err, "Compare error")
+		update, err := diff.Update()
+		require.NoError(t, err, "Update error")
+		require.Equal(t, bson.D{}, update, "expected empty update, got %v", update)
+	})
+	t.Run("invalid key", func(t *testing.T) {
+		a := marshal(t, bson.D{{"a.b", 1}})
+		b := marshal(t, bson.D{{"a.b", 2}})
+		diff, err := Compare(a, b)
+		require.NoError(t, err, "Compare error")
+		_, err = diff.Update()
+		require.Error(t, err, "expected error for key containing a dot")
+	})
+}
@@ -0,0 +1,32 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package bsondiff computes structural differences between BSON documents and merges concurrent changes to them.
+//
+// Compare returns the changes that turn one document into another. Embedded documents are compared field by field.
+// Arrays are compared element by element, either by index or, if an identity key is configured for the array with
+// Options.SetArrayKey, by the value of that key in each element, so that inserting an element does not report every
+// following element as changed.
+//
+// Diff.Update turns the changes into a minimal update document that uses $set and $unset. It can be used to save a
+// modified document without overwriting the fields that other clients changed concurrently:
+//
+//	diff, err := bsondiff.Compare(original, modified)
+//	if err != nil {
+//		return err
+//	}
+//	if diff.Equal() {
+//		return nil
+//	}
+//	update, err := diff.Update()
+//	if err != nil {
+//		return err
+//	}
+//	_, err = coll.UpdateOne(ctx, bson.D{{"_id", id}}, update)
+//
+// Merge combines the changes that two clients made to the same base document, reporting the fields that both
+// clients changed in different ways as conflicts.
+package bsondiff
@@ -0,0 +1,112 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsondiff
+
+import (
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// Conflict is a value that was changed in different ways by both sides of a merge. A value that does not exist in a
+// document is empty.
+type Conflict struct {
+	Path   []string
+	Base   bson.RawValue
+	Ours   bson.RawValue
+	Theirs bson.RawValue
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("conflict at %s: base %v, ours %v, theirs %v", dotted(c.Path), c.Base, c.Ours, c.Theirs)
+}
+
+// Merge combines the changes made to the document base in the documents ours and theirs. A field that was only
+// changed on one side has the value of that side in the result. Embedded documents that were changed on both sides
+// are merged field by field. Any other value that was changed on both sides in different ways is a conflict: the
+// result contains the value of ours and the conflict is returned. Arrays are merged as a whole.
+//
+// The fields of the result are in the order of ours, followed by the fields that were only added by theirs.
+func Merge(base, ours, theirs bson.Raw) (bson.Raw, []Conflict, error) {
+	for _, doc := range []bson.Raw{base, ours, theirs} {
+		if err := doc.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var m merger
+	merged := m.mergeDocuments(nil, nil, base, ours, theirs)
+	return bson.Raw(merged), m.conflicts, nil
+}
+
+type merger struct {
+	conflicts []Conflict
+}
+
+// mergeDocuments appends the merge of the documents base, ours, and theirs to dst.
+func (m *merger) mergeDocuments(dst []byte, path []string, base, ours, theirs bson.Raw) []byte {
+	idx, dst := bsoncore.AppendDocumentStart(dst)
+
+	// The documents were validated before they were traversed, so these cannot fail.
+	ourElems, _ := ours.Elements()
+	theirElems, _ := theirs.Elements()
+
+	for _, oe := range ourElems {
+		key := oe.Key()
+		b := lookup(base, key)
+		t := lookup(theirs, key)
+		dst = m.mergeValues(dst, appendPath(path, key), b, oe.Value(), t)
+	}
+	for _, te := range theirElems {
+		key := te.Key()
+		if _, err := ours.LookupErr(key); err == nil {
+			continue
+		}
+		// The field is missing from ours, so it was either removed by ours or added by theirs.
+		dst = m.mergeValues(dst, appendPath(path, key), lookup(base, key), bson.RawValue{}, te.Value())
+	}
+
+	dst, _ = bsoncore.AppendDocumentEnd(dst, idx)
+	return dst
+}
+
+// mergeValues appends the merge of the values for the last key of path to dst. An empty value indicates that the
+// field does not exist in the document.
+func (m *merger) mergeValues(dst []byte, path []string, base, ours, theirs bson.RawValue) []byte {
+	var val bson.RawValue
+	switch {
+	case equal(ours, theirs), equal(base, theirs):
+		val = ours
+	case equal(base, ours):
+		val = theirs
+	case base.Type == bsontype.EmbeddedDocument && ours.Type == bsontype.EmbeddedDocument &&
+		theirs.Type == bsontype.EmbeddedDocument:
+
+		key := path[len(path)-1]
+		dst = bsoncore.AppendHeader(dst, bsontype.EmbeddedDocument, key)
+		return m.mergeDocuments(dst, path, base.Document(), ours.Document(), theirs.Document())
+	default:
+		m.conflicts = append(m.conflicts, Conflict{Path: path, Base: base, Ours: ours, Theirs: theirs})
+		val = ours
+	}
+
+	if val.Type == 0 {
+		return dst
+	}
+	return bsoncore.AppendValueElement(dst, path[len(path)-1], bsoncore.Value{Type: val.Type, Data: val.Value})
+}
+
+// lookup returns the value for key in doc, or an empty value if doc doesn't contain key.
+func lookup(doc bson.Raw, key string) bson.RawValue {
+	val, err := doc.LookupErr(key)
+	if err != nil {
+		return bson.RawValue{}
+	}
+	return val
+}
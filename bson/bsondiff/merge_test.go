@@ -0,0 +1,95 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsondiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestMerge(t *testing.T) {
+	base := marshal(t, bson.D{
+		{"_id", 1},
+		{"name", "a"},
+		{"status", "new"},
+		{"address", bson.D{{"city", "x"}, {"zip", "1"}}},
+		{"tags", bson.A{"a"}},
+		{"temp", true},
+		{"qty", 1},
+	})
+	ours := marshal(t, bson.D{
+		{"_id", 1},
+		{"name", "b"},
+		{"status", "new"},
+		{"address", bson.D{{"city", "y"}, {"zip", "1"}}},
+		{"tags", bson.A{"a", "b"}},
+		{"qty", 2},
+	})
+	theirs := marshal(t, bson.D{
+		{"_id", 1},
+		{"name", "a"},
+		{"status", "shipped"},
+		{"address", bson.D{{"city", "x"}, {"zip", "2"}}},
+		{"tags", bson.A{"a", "c"}},
+		{"temp", true},
+		{"qty", 3},
+		{"note", "n"},
+	})
+
+	merged, conflicts, err := Merge(base, ours, theirs)
+	require.NoError(t, err, "Merge error")
+
+	want := marshal(t, bson.D{
+		{"_id", 1},
+		{"name", "b"},
+		{"status", "shipped"},
+		{"address", bson.D{{"city", "y"}, {"zip", "2"}}},
+		{"tags", bson.A{"a", "b"}},
+		{"qty", 2},
+		{"note", "n"},
+	})
+	require.Equal(t, want, merged, "expected %v, got %v", want, merged)
+
+	wantConflicts := []Conflict{
+		{
+			Path:   []string{"tags"},
+			Base:   rawValue(t, bson.A{"a"}),
+			Ours:   rawValue(t, bson.A{"a", "b"}),
+			Theirs: rawValue(t, bson.A{"a", "c"}),
+		},
+		{Path: []string{"qty"}, Base: rawValue(t, 1), Ours: rawValue(t, 2), Theirs: rawValue(t, 3)},
+	}
+	require.Equal(t, wantConflicts, conflicts, "conflicts do not match")
+
+	t.Run("removed on one side and modified on the other", func(t *testing.T) {
+		base := marshal(t, bson.D{{"a", 1}, {"b", 1}})
+		ours := marshal(t, bson.D{{"b", 2}})
+		theirs := marshal(t, bson.D{{"a", 2}})
+
+		merged, conflicts, err := Merge(base, ours, theirs)
+		require.NoError(t, err, "Merge error")
+		want := marshal(t, bson.D{{"b", 2}})
+		require.Equal(t, want, merged, "expected %v, got %v", want, merged)
+		require.Equal(t, 2, len(conflicts), "expected 2 conflicts, got %v", conflicts)
+		require.Equal(t, []string{"b"}, conflicts[0].Path, "expected conflict for b")
+		require.Equal(t, bson.RawValue{}, conflicts[0].Theirs, "expected b to be removed by theirs")
+		require.Equal(t, []string{"a"}, conflicts[1].Path, "expected conflict for a")
+		require.Equal(t, bson.RawValue{}, conflicts[1].Ours, "expected a to be removed by ours")
+	})
+	t.Run("same change on both sides", func(t *testing.T) {
+		merged, conflicts, err := Merge(base, ours, ours)
+		require.NoError(t, err, "Merge error")
+		require.Equal(t, ours, merged, "expected %v, got %v", ours, merged)
+		require.Empty(t, conflicts, "expected no conflicts")
+	})
+	t.Run("invalid document", func(t *testing.T) {
+		_, _, err := Merge(base, ours, theirs[:5])
+		require.Error(t, err, "expected error for invalid document")
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsondiff
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// Update returns an update document that turns the old document into the new document when it is applied with
+// Collection.UpdateOne. Added and modified values are set with $set and removed fields are removed with $unset. An
+// array that had elements added, removed, or moved is set as a whole, because removing an element with $unset leaves
+// a null in the array. If the documents are equal, an empty document is returned.
+//
+// An error is returned if a changed field has a key that cannot be used in an update path, i.e. an empty key, a key
+// that contains a '.', or a key that starts with a '$'.
+func (d *Diff) Update() (bson.D, error) {
+	replaced := d.replacedArrays()
+
+	var set, unset bson.D
+	added := make(map[string]bool, len(replaced))
+	for _, ch := range d.Changes {
+		if prefix := coveringPath(replaced, ch.Path); prefix != nil {
+			key := dotted(prefix)
+			if added[key] {
+				continue
+			}
+			added[key] = true
+
+			if err := checkPath(prefix); err != nil {
+				return nil, err
+			}
+			// The array exists in the new document because its elements were compared.
+			val, err := d.new.LookupErr(prefix...)
+			if err != nil {
+				return nil, err
+			}
+			set = append(set, bson.E{Key: key, Value: val})
+			continue
+		}
+
+		if err := checkPath(ch.Path); err != nil {
+			return nil, err
+		}
+		switch ch.Type {
+		case Added, Modified:
+			set = append(set, bson.E{Key: dotted(ch.Path), Value: ch.New})
+		case Removed:
+			unset = append(unset, bson.E{Key: dotted(ch.Path), Value: ""})
+		}
+	}
+
+	update := bson.D{}
+	if len(set) > 0 {
+		update = append(update, bson.E{Key: "$set", Value: set})
+	}
+	if len(unset) > 0 {
+		update = append(update, bson.E{Key: "$unset", Value: unset})
+	}
+	return update, nil
+}
+
+// replacedArrays returns the paths of the arrays that had elements added, removed, or moved.
+func (d *Diff) replacedArrays() [][]string {
+	var replaced [][]string
+	for _, ch := range d.Changes {
+		if ch.Type == Modified || len(ch.Path) < 2 {
+			continue
+		}
+		parent := ch.Path[:len(ch.Path)-1]
+		if val, err := d.new.LookupErr(parent...); err == nil && val.Type == bsontype.Array {
+			replaced = append(replaced, parent)
+		}
+	}
+	return replaced
+}
+
+// coveringPath returns the shortest path in paths that is a prefix of path, or nil if there is none.
+func coveringPath(paths [][]string, path []string) []string {
+	var shortest []string
+	for _, p := range paths {
+		if shortest != nil && len(p) >= len(shortest) {
+			continue
+		}
+		if hasPrefix(path, p) {
+			shortest = p
+		}
+	}
+	return shortest
+}
+
+func hasPrefix(path, prefix []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+	for i, key := range prefix {
+		if path[i] != key {
+			return false
+		}
+	}
+	return true
+}
+
+func checkPath(path []string) error {
+	for _, key := range path {
+		if key == "" || strings.Contains(key, ".") || strings.HasPrefix(key, "$") {
+			return fmt.Errorf("cannot use key %q of path %q in an update", key, dotted(path))
+		}
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ type StructCodecOptions struct {
 	DecodeDeepZeroInline    *bool // Specifies if structs should be recursively zeroed when a inline value is decoded. Defaults to false.
 	EncodeOmitDefaultStruct *bool // Specifies if default structs should be considered empty by omitempty. Defaults to false.
 	AllowUnexportedFields   *bool // Specifies if unexported fields should be marshaled/unmarshaled. Defaults to false.
+	DecodeStrict            *bool // Specifies if unknown and duplicate keys should cause an error when decoding. Defaults to false.
 }
 
 // StructCodec creates a new *StructCodecOptions
@@ -44,6 +45,13 @@ func (t *StructCodecOptions) SetAllowUnexportedFields(b bool) *StructCodecOption
 	return t
 }
 
+// SetDecodeStrict specifies if unknown and duplicate keys should cause an error when decoding. A key is unknown if it
+// does not match a struct field and the struct does not have an inline map. Defaults to false.
+func (t *StructCodecOptions) SetDecodeStrict(b bool) *StructCodecOptions {
+	t.DecodeStrict = &b
+	return t
+}
+
 // MergeStructCodecOptions combines the given *StructCodecOptions into a single *StructCodecOptions in a last one wins fashion.
 func MergeStructCodecOptions(opts ...*StructCodecOptions) *StructCodecOptions {
 	s := StructCodec()
@@ -64,6 +72,9 @@ func MergeStructCodecOptions(opts ...*StructCodecOptions) *StructCodecOptions {
 		if opt.AllowUnexportedFields != nil {
 			s.AllowUnexportedFields = opt.AllowUnexportedFields
 		}
+		if opt.DecodeStrict != nil {
+			s.DecodeStrict = opt.DecodeStrict
+		}
 	}
 
 	return s
@@ -0,0 +1,230 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsonrw
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// ErrStreamClosed is returned when a document is written to an ExtJSONStreamWriter that has been closed.
+var ErrStreamClosed = errors.New("the Extended JSON stream is closed")
+
+// ExtJSONMode is the syntax in which an ExtJSONStreamWriter writes values.
+type ExtJSONMode uint8
+
+// These constants are the modes supported by ExtJSONStreamWriter.
+const (
+	// ExtJSONRelaxed writes relaxed Extended JSON, which uses native JSON numbers and ISO-8601 dates where possible.
+	ExtJSONRelaxed ExtJSONMode = iota
+
+	// ExtJSONCanonical writes canonical Extended JSON, which preserves the type of every value.
+	ExtJSONCanonical
+
+	// ExtJSONShell writes values in the syntax of the mongo shell, e.g. ObjectId("...") and NumberLong("..."). The
+	// output is not valid JSON and cannot be read by an ExtJSONStreamReader.
+	ExtJSONShell
+)
+
+func (m ExtJSONMode) String() string {
+	switch m {
+	case ExtJSONRelaxed:
+		return "relaxed"
+	case ExtJSONCanonical:
+		return "canonical"
+	case ExtJSONShell:
+		return "shell"
+	default:
+		return fmt.Sprintf("ExtJSONMode(%d)", uint8(m))
+	}
+}
+
+// ExtJSONStreamFormat is the way an ExtJSONStreamWriter separates documents.
+type ExtJSONStreamFormat uint8
+
+// These constants are the stream formats supported by ExtJSONStreamWriter.
+const (
+	// ExtJSONLines writes every document on its own line.
+	ExtJSONLines ExtJSONStreamFormat = iota
+
+	// ExtJSONArray writes the documents as the elements of a single JSON array.
+	ExtJSONArray
+)
+
+// ExtJSONStreamWriter writes a stream of BSON documents as Extended JSON. Every document is written to the underlying
+// io.Writer before WriteDocument returns, so the memory used by the writer is bounded by the size of the largest
+// document.
+type ExtJSONStreamWriter struct {
+	w      io.Writer
+	vw     *extJSONValueWriter
+	mode   ExtJSONMode
+	format ExtJSONStreamFormat
+	buf    []byte
+	count  int64
+	closed bool
+}
+
+// NewExtJSONStreamWriter creates an ExtJSONStreamWriter that writes documents to w using the given mode and format.
+func NewExtJSONStreamWriter(w io.Writer, mode ExtJSONMode, format ExtJSONStreamFormat) (*ExtJSONStreamWriter, error) {
+	if w == nil {
+		return nil, errNilWriter
+	}
+	switch mode {
+	case ExtJSONRelaxed, ExtJSONCanonical, ExtJSONShell:
+	default:
+		return nil, fmt.Errorf("invalid Extended JSON mode %v", mode)
+	}
+	switch format {
+	case ExtJSONLines, ExtJSONArray:
+	default:
+		return nil, fmt.Errorf("invalid Extended JSON stream format %d", format)
+	}
+
+	return &ExtJSONStreamWriter{
+		w:      w,
+		vw:     newExtJSONWriterFromSlice(nil, mode == ExtJSONCanonical, false),
+		mode:   mode,
+		format: format,
+	}, nil
+}
+
+// WriteDocument writes the BSON document doc to the stream. If doc is not a valid BSON document, an error is returned
+// and nothing is written.
+func (sw *ExtJSONStreamWriter) WriteDocument(doc []byte) error {
+	if sw.closed {
+		return ErrStreamClosed
+	}
+
+	sw.buf = sw.buf[:0]
+	switch {
+	case sw.format == ExtJSONArray && sw.count == 0:
+		sw.buf = append(sw.buf, '[')
+	case sw.format == ExtJSONArray:
+		sw.buf = append(sw.buf, ',')
+	}
+
+	sw.vw.reset(sw.buf, sw.mode == ExtJSONCanonical, false)
+	sw.vw.shell = sw.mode == ExtJSONShell
+	if err := (Copier{}).CopyDocumentFromBytes(sw.vw, doc); err != nil {
+		return err
+	}
+	sw.buf = sw.vw.buf
+	if sw.format == ExtJSONLines {
+		sw.buf = append(sw.buf, '\n')
+	}
+
+	if _, err := sw.w.Write(sw.buf); err != nil {
+		return err
+	}
+	sw.count++
+	return nil
+}
+
+// Count returns the number of documents written to the stream.
+func (sw *ExtJSONStreamWriter) Count() int64 { return sw.count }
+
+// Close finishes the stream. For the ExtJSONArray format, the closing bracket of the array is written. Close does not
+// close the underlying io.Writer.
+func (sw *ExtJSONStreamWriter) Close() error {
+	if sw.closed {
+		return nil
+	}
+	sw.closed = true
+
+	if sw.format != ExtJSONArray {
+		return nil
+	}
+	end := "]\n"
+	if sw.count == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(sw.w, end)
+	return err
+}
+
+// ExtJSONStreamReader reads a stream of Extended JSON documents as BSON. The stream can contain either a sequence of
+// documents separated by whitespace, like the output of an ExtJSONStreamWriter in the ExtJSONLines format, or a single
+// array of documents. The input is read incrementally, so the memory used by the reader is bounded by the size of the
+// largest document.
+type ExtJSONStreamReader struct {
+	r         io.Reader
+	canonical bool
+
+	vr  *extJSONValueReader
+	ar  ArrayReader
+	err error
+}
+
+// NewExtJSONStreamReader creates an ExtJSONStreamReader that reads documents from r. If canonical is true, the input
+// must be canonical Extended JSON. Otherwise, both canonical and relaxed Extended JSON are accepted.
+func NewExtJSONStreamReader(r io.Reader, canonical bool) *ExtJSONStreamReader {
+	return &ExtJSONStreamReader{r: r, canonical: canonical}
+}
+
+// ReadDocument reads the next document from the stream and appends it to dst as BSON. When there are no more
+// documents, io.EOF is returned. Once an error is returned, all subsequent calls return the same error.
+func (sr *ExtJSONStreamReader) ReadDocument(dst []byte) ([]byte, error) {
+	if sr.err != nil {
+		return dst, sr.err
+	}
+
+	doc, err := sr.readDocument(dst)
+	if err != nil {
+		sr.err = err
+		return dst, err
+	}
+	return doc, nil
+}
+
+func (sr *ExtJSONStreamReader) readDocument(dst []byte) ([]byte, error) {
+	if sr.vr == nil {
+		if sr.r == nil {
+			return nil, errors.New("cannot read from a nil io.Reader")
+		}
+		vr, err := newExtJSONValueReader(sr.r, sr.canonical)
+		if err != nil {
+			return nil, err
+		}
+		sr.vr = vr
+
+		switch vr.Type() {
+		case bsontype.EmbeddedDocument:
+		case bsontype.Array:
+			if sr.ar, err = vr.ReadArray(); err != nil {
+				return nil, err
+			}
+		case bsontype.Type(0):
+			// The stream is empty.
+			return nil, io.EOF
+		default:
+			return nil, fmt.Errorf("expected a document or an array of documents, but got %v", vr.Type())
+		}
+	}
+
+	if sr.ar == nil {
+		return (Copier{}).AppendDocumentBytes(dst, sr.vr)
+	}
+
+	evr, err := sr.ar.ReadValue()
+	if err == ErrEOA {
+		// Make sure that nothing follows the array.
+		if _, err = sr.vr.p.peekType(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, err
+	}
+	if evr.Type() != bsontype.EmbeddedDocument {
+		return nil, fmt.Errorf("expected the array to contain documents, but got %v", evr.Type())
+	}
+	return (Copier{}).AppendDocumentBytes(dst, evr)
+}
@@ -0,0 +1,264 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsonrw
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+const corpusDir = "../../data"
+
+type corpusTest struct {
+	Description string `json:"description"`
+	Valid       []struct {
+		Description   string `json:"description"`
+		CanonicalBSON string `json:"canonical_bson"`
+		Lossy         *bool  `json:"lossy"`
+	} `json:"valid"`
+}
+
+// corpusDocuments returns the canonical BSON of the valid test cases in the bson-corpus files.
+func corpusDocuments(t *testing.T) map[string][][]byte {
+	t.Helper()
+
+	entries, err := ioutil.ReadDir(corpusDir)
+	if err != nil {
+		t.Fatalf("error reading corpus directory: %v", err)
+	}
+
+	docs := make(map[string][][]byte)
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		content, err := ioutil.ReadFile(path.Join(corpusDir, entry.Name()))
+		if err != nil {
+			t.Fatalf("error reading %s: %v", entry.Name(), err)
+		}
+		var test corpusTest
+		if err := json.Unmarshal(content, &test); err != nil {
+			t.Fatalf("error parsing %s: %v", entry.Name(), err)
+		}
+		for _, v := range test.Valid {
+			if v.Lossy != nil && *v.Lossy {
+				continue
+			}
+			doc, err := hex.DecodeString(v.CanonicalBSON)
+			if err != nil {
+				t.Fatalf("error decoding %s: %s: %v", entry.Name(), v.Description, err)
+			}
+			docs[entry.Name()] = append(docs[entry.Name()], doc)
+		}
+	}
+	return docs
+}
+
+func writeStream(t *testing.T, mode ExtJSONMode, format ExtJSONStreamFormat, docs [][]byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	sw, err := NewExtJSONStreamWriter(&buf, mode, format)
+	noerr(t, err)
+	for _, doc := range docs {
+		noerr(t, sw.WriteDocument(doc))
+	}
+	noerr(t, sw.Close())
+	if sw.Count() != int64(len(docs)) {
+		t.Errorf("Counts do not match. got %d; want %d", sw.Count(), len(docs))
+	}
+	return buf.Bytes()
+}
+
+func readStream(t *testing.T, data []byte, canonical bool) [][]byte {
+	t.Helper()
+
+	sr := NewExtJSONStreamReader(bytes.NewReader(data), canonical)
+	var docs [][]byte
+	for {
+		doc, err := sr.ReadDocument(nil)
+		if err == io.EOF {
+			return docs
+		}
+		if err != nil {
+			t.Fatalf("error reading stream %s: %v", data, err)
+		}
+		docs = append(docs, doc)
+	}
+}
+
+func TestExtJSONStreamCorpus(t *testing.T) {
+	for file, docs := range corpusDocuments(t) {
+		t.Run(file, func(t *testing.T) {
+			for _, format := range []ExtJSONStreamFormat{ExtJSONLines, ExtJSONArray} {
+				// canonical Extended JSON preserves the type of every value.
+				data := writeStream(t, ExtJSONCanonical, format, docs)
+				got := readStream(t, data, true)
+				if len(got) != len(docs) {
+					t.Fatalf("Document counts do not match. got %d; want %d", len(got), len(docs))
+				}
+				for i := range docs {
+					if !bytes.Equal(got[i], docs[i]) {
+						t.Errorf("Documents do not match. got %v; want %v", bsoncore.Document(got[i]),
+							bsoncore.Document(docs[i]))
+					}
+				}
+
+				// relaxed Extended JSON can change the type of numbers, but writing it again produces the same
+				// output.
+				data = writeStream(t, ExtJSONRelaxed, format, docs)
+				again := writeStream(t, ExtJSONRelaxed, format, readStream(t, data, false))
+				if !bytes.Equal(data, again) {
+					t.Errorf("Relaxed outputs do not match. got %s; want %s", again, data)
+				}
+			}
+		})
+	}
+}
+
+func TestExtJSONStreamWriter(t *testing.T) {
+	oid, err := primitive.ObjectIDFromHex("5f8a1b2c3d4e5f6a7b8c9d0e")
+	noerr(t, err)
+	doc := bsoncore.BuildDocumentFromElements(nil,
+		bsoncore.AppendObjectIDElement(nil, "_id", oid),
+		bsoncore.AppendInt32Element(nil, "i32", 1),
+		bsoncore.AppendInt64Element(nil, "i64", 2),
+		bsoncore.AppendDoubleElement(nil, "d", 1.5),
+		bsoncore.AppendDateTimeElement(nil, "date", 1602800000000),
+		bsoncore.AppendBinaryElement(nil, "bin", 4, []byte{0x01, 0x02}),
+		bsoncore.AppendRegexElement(nil, "re", "a/b", "mi"),
+		bsoncore.AppendTimestampElement(nil, "ts", 10, 1),
+		bsoncore.AppendDecimal128Element(nil, "dec", primitive.NewDecimal128(0x3040000000000000, 5)),
+		bsoncore.AppendMinKeyElement(nil, "min"),
+		bsoncore.AppendMaxKeyElement(nil, "max"),
+		bsoncore.AppendUndefinedElement(nil, "u"),
+		bsoncore.AppendDBPointerElement(nil, "ptr", "db.coll", oid),
+		bsoncore.BuildArrayElement(nil, "arr", bsoncore.Value{Type: bsontype.String, Data: bsoncore.AppendString(nil, "x")}),
+	)
+
+	t.Run("shell", func(t *testing.T) {
+		got := string(writeStream(t, ExtJSONShell, ExtJSONLines, [][]byte{doc}))
+		want := `{"_id":ObjectId("5f8a1b2c3d4e5f6a7b8c9d0e"),"i32":NumberInt(1),"i64":NumberLong("2"),"d":1.5,` +
+			`"date":ISODate("2020-10-15T22:13:20Z"),"bin":BinData(4,"AQI="),"re":/a\/b/im,"ts":Timestamp(10,1),` +
+			`"dec":NumberDecimal("5"),"min":MinKey,"max":MaxKey,"u":undefined,` +
+			`"ptr":DBPointer("db.coll",ObjectId("5f8a1b2c3d4e5f6a7b8c9d0e")),"arr":["x"]}` + "\n"
+		if got != want {
+			t.Errorf("Outputs do not match. got %s; want %s", got, want)
+		}
+	})
+	t.Run("array", func(t *testing.T) {
+		small := bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "a", 1))
+		got := string(writeStream(t, ExtJSONCanonical, ExtJSONArray, [][]byte{small, small}))
+		want := `[{"a":{"$numberInt":"1"}},{"a":{"$numberInt":"1"}}]` + "\n"
+		if got != want {
+			t.Errorf("Outputs do not match. got %s; want %s", got, want)
+		}
+		if got := string(writeStream(t, ExtJSONRelaxed, ExtJSONArray, nil)); got != "[]\n" {
+			t.Errorf("Outputs do not match. got %s; want %s", got, "[]\n")
+		}
+	})
+	t.Run("invalid document", func(t *testing.T) {
+		var buf bytes.Buffer
+		sw, err := NewExtJSONStreamWriter(&buf, ExtJSONRelaxed, ExtJSONLines)
+		noerr(t, err)
+		if err := sw.WriteDocument(doc[:len(doc)-5]); err == nil {
+			t.Errorf("Expected an error writing an invalid document")
+		}
+		if buf.Len() != 0 || sw.Count() != 0 {
+			t.Errorf("Expected nothing to be written, got %q", buf.String())
+		}
+		noerr(t, sw.Close())
+		if err := sw.WriteDocument(doc); err != ErrStreamClosed {
+			t.Errorf("Errors do not match. got %v; want %v", err, ErrStreamClosed)
+		}
+	})
+	t.Run("write error", func(t *testing.T) {
+		errWrite := errors.New("write error")
+		sw, err := NewExtJSONStreamWriter(&errWriter{err: errWrite}, ExtJSONRelaxed, ExtJSONLines)
+		noerr(t, err)
+		if err := sw.WriteDocument(doc); err != errWrite {
+			t.Errorf("Errors do not match. got %v; want %v", err, errWrite)
+		}
+	})
+	t.Run("nil writer", func(t *testing.T) {
+		if _, err := NewExtJSONStreamWriter(nil, ExtJSONRelaxed, ExtJSONLines); err != errNilWriter {
+			t.Errorf("Errors do not match. got %v; want %v", err, errNilWriter)
+		}
+	})
+}
+
+func TestExtJSONStreamReader(t *testing.T) {
+	doc := func(i int32) []byte {
+		return bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "a", i))
+	}
+
+	testCases := []struct {
+		name  string
+		input string
+		want  [][]byte
+		err   bool
+	}{
+		{"empty", "", nil, false},
+		{"whitespace", " \n ", nil, false},
+		{"lines", "{\"a\":1}\n{\"a\":{\"$numberInt\":\"2\"}}\n", [][]byte{doc(1), doc(2)}, false},
+		{"array", " [{\"a\":1},\n{\"a\":2}]\n", [][]byte{doc(1), doc(2)}, false},
+		{"empty array", "[]", nil, false},
+		{"array of values", "[{\"a\":1},2]", [][]byte{doc(1)}, true},
+		{"data after array", "[{\"a\":1}] {\"a\":2}", [][]byte{doc(1)}, true},
+		{"value", "1", nil, true},
+		{"truncated", "{\"a\":1}\n{\"a\":", [][]byte{doc(1)}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sr := NewExtJSONStreamReader(strings.NewReader(tc.input), false)
+			var got [][]byte
+			var err error
+			for {
+				var d []byte
+				d, err = sr.ReadDocument(nil)
+				if err != nil {
+					break
+				}
+				got = append(got, d)
+			}
+			if tc.err == (err == io.EOF) {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("Document counts do not match. got %d; want %d", len(got), len(tc.want))
+			}
+			for i := range got {
+				if !bytes.Equal(got[i], tc.want[i]) {
+					t.Errorf("Documents do not match. got %v; want %v", bsoncore.Document(got[i]), bsoncore.Document(tc.want[i]))
+				}
+			}
+			if _, again := sr.ReadDocument(nil); again != err {
+				t.Errorf("Expected the error to be sticky. got %v; want %v", again, err)
+			}
+		})
+	}
+
+	t.Run("appends to dst", func(t *testing.T) {
+		sr := NewExtJSONStreamReader(strings.NewReader(`{"a":1}`), true)
+		got, err := sr.ReadDocument([]byte{0xFF})
+		noerr(t, err)
+		if !bytes.Equal(got, append([]byte{0xFF}, doc(1)...)) {
+			t.Errorf("Expected the document to be appended to dst, got %v", got)
+		}
+	})
+}
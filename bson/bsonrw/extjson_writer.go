@@ -85,6 +85,10 @@ type extJSONValueWriter struct {
 	frame      int64
 	canonical  bool
 	escapeHTML bool
+
+	// shell indicates that values are written in the syntax of the mongo shell, e.g. ObjectId("...") instead of
+	// {"$oid":"..."}. Types that have no shell representation are written as canonical Extended JSON.
+	shell bool
 }
 
 // NewExtJSONValueWriter creates a ValueWriter that writes Extended JSON to w.
@@ -130,6 +134,7 @@ func (ejvw *extJSONValueWriter) reset(buf []byte, canonical, escapeHTML bool) {
 	ejvw.stack[0] = ejvwState{mode: mTopLevel}
 	ejvw.canonical = canonical
 	ejvw.escapeHTML = escapeHTML
+	ejvw.shell = false
 	ejvw.frame = 0
 	ejvw.buf = buf
 	ejvw.w = nil
@@ -203,6 +208,13 @@ func (ejvw *extJSONValueWriter) writeExtendedSingleValue(key string, value strin
 	ejvw.buf = append(ejvw.buf, []byte(s)...)
 }
 
+// writeShellValue writes a value in mongo shell syntax, like ObjectId("..."), followed by a comma.
+func (ejvw *extJSONValueWriter) writeShellValue(format string, args ...interface{}) {
+	ejvw.buf = append(ejvw.buf, []byte(fmt.Sprintf(format, args...))...)
+	ejvw.buf = append(ejvw.buf, ',')
+	ejvw.pop()
+}
+
 func (ejvw *extJSONValueWriter) WriteArray() (ArrayWriter, error) {
 	if err := ejvw.ensureElementValue(mArray, "WriteArray"); err != nil {
 		return nil, err
@@ -223,6 +235,11 @@ func (ejvw *extJSONValueWriter) WriteBinaryWithSubtype(b []byte, btype byte) err
 		return err
 	}
 
+	if ejvw.shell {
+		ejvw.writeShellValue(`BinData(%d,"%s")`, btype, base64.StdEncoding.EncodeToString(b))
+		return nil
+	}
+
 	var buf bytes.Buffer
 	buf.WriteString(`{"$binary":{"base64":"`)
 	buf.WriteString(base64.StdEncoding.EncodeToString(b))
@@ -267,6 +284,13 @@ func (ejvw *extJSONValueWriter) WriteDBPointer(ns string, oid primitive.ObjectID
 		return err
 	}
 
+	if ejvw.shell {
+		var buf bytes.Buffer
+		writeStringWithEscapes(ns, &buf, ejvw.escapeHTML)
+		ejvw.writeShellValue(`DBPointer(%s,ObjectId("%s"))`, buf.String(), oid.Hex())
+		return nil
+	}
+
 	var buf bytes.Buffer
 	buf.WriteString(`{"$dbPointer":{"$ref":"`)
 	buf.WriteString(ns)
@@ -287,6 +311,15 @@ func (ejvw *extJSONValueWriter) WriteDateTime(dt int64) error {
 
 	t := time.Unix(dt/1e3, dt%1e3*1e6).UTC()
 
+	if ejvw.shell {
+		if t.Year() < 1970 || t.Year() > 9999 {
+			ejvw.writeShellValue("new Date(%d)", dt)
+		} else {
+			ejvw.writeShellValue(`ISODate("%s")`, t.Format(rfc3339Milli))
+		}
+		return nil
+	}
+
 	if ejvw.canonical || t.Year() < 1970 || t.Year() > 9999 {
 		s := fmt.Sprintf(`{"$numberLong":"%d"}`, dt)
 		ejvw.writeExtendedSingleValue("date", s, false)
@@ -305,6 +338,11 @@ func (ejvw *extJSONValueWriter) WriteDecimal128(d primitive.Decimal128) error {
 		return err
 	}
 
+	if ejvw.shell {
+		ejvw.writeShellValue(`NumberDecimal("%s")`, d.String())
+		return nil
+	}
+
 	ejvw.writeExtendedSingleValue("numberDecimal", d.String(), true)
 	ejvw.buf = append(ejvw.buf, ',')
 
@@ -334,6 +372,11 @@ func (ejvw *extJSONValueWriter) WriteDouble(f float64) error {
 
 	s := formatDouble(f)
 
+	if ejvw.shell {
+		ejvw.writeShellValue("%s", s)
+		return nil
+	}
+
 	if ejvw.canonical {
 		ejvw.writeExtendedSingleValue("numberDouble", s, true)
 	} else {
@@ -361,6 +404,11 @@ func (ejvw *extJSONValueWriter) WriteInt32(i int32) error {
 
 	s := strconv.FormatInt(int64(i), 10)
 
+	if ejvw.shell {
+		ejvw.writeShellValue("NumberInt(%s)", s)
+		return nil
+	}
+
 	if ejvw.canonical {
 		ejvw.writeExtendedSingleValue("numberInt", s, true)
 	} else {
@@ -380,6 +428,11 @@ func (ejvw *extJSONValueWriter) WriteInt64(i int64) error {
 
 	s := strconv.FormatInt(i, 10)
 
+	if ejvw.shell {
+		ejvw.writeShellValue(`NumberLong("%s")`, s)
+		return nil
+	}
+
 	if ejvw.canonical {
 		ejvw.writeExtendedSingleValue("numberLong", s, true)
 	} else {
@@ -412,6 +465,11 @@ func (ejvw *extJSONValueWriter) WriteMaxKey() error {
 		return err
 	}
 
+	if ejvw.shell {
+		ejvw.writeShellValue("MaxKey")
+		return nil
+	}
+
 	ejvw.writeExtendedSingleValue("maxKey", "1", false)
 	ejvw.buf = append(ejvw.buf, ',')
 
@@ -424,6 +482,11 @@ func (ejvw *extJSONValueWriter) WriteMinKey() error {
 		return err
 	}
 
+	if ejvw.shell {
+		ejvw.writeShellValue("MinKey")
+		return nil
+	}
+
 	ejvw.writeExtendedSingleValue("minKey", "1", false)
 	ejvw.buf = append(ejvw.buf, ',')
 
@@ -448,6 +511,11 @@ func (ejvw *extJSONValueWriter) WriteObjectID(oid primitive.ObjectID) error {
 		return err
 	}
 
+	if ejvw.shell {
+		ejvw.writeShellValue(`ObjectId("%s")`, oid.Hex())
+		return nil
+	}
+
 	ejvw.writeExtendedSingleValue("oid", oid.Hex(), true)
 	ejvw.buf = append(ejvw.buf, ',')
 
@@ -460,6 +528,11 @@ func (ejvw *extJSONValueWriter) WriteRegex(pattern string, options string) error
 		return err
 	}
 
+	if ejvw.shell {
+		ejvw.writeShellValue("/%s/%s", escapeRegexDelimiters(pattern), sortStringAlphebeticAscending(options))
+		return nil
+	}
+
 	var buf bytes.Buffer
 	buf.WriteString(`{"$regularExpression":{"pattern":`)
 	writeStringWithEscapes(pattern, &buf, ejvw.escapeHTML)
@@ -508,6 +581,11 @@ func (ejvw *extJSONValueWriter) WriteTimestamp(t uint32, i uint32) error {
 		return err
 	}
 
+	if ejvw.shell {
+		ejvw.writeShellValue("Timestamp(%d,%d)", t, i)
+		return nil
+	}
+
 	var buf bytes.Buffer
 	buf.WriteString(`{"$timestamp":{"t":`)
 	buf.WriteString(strconv.FormatUint(uint64(t), 10))
@@ -526,6 +604,11 @@ func (ejvw *extJSONValueWriter) WriteUndefined() error {
 		return err
 	}
 
+	if ejvw.shell {
+		ejvw.writeShellValue("undefined")
+		return nil
+	}
+
 	ejvw.writeExtendedSingleValue("undefined", "true", false)
 	ejvw.buf = append(ejvw.buf, ',')
 
@@ -612,6 +695,25 @@ func (ejvw *extJSONValueWriter) WriteArrayEnd() error {
 	return nil
 }
 
+// escapeRegexDelimiters escapes the forward slashes in pattern that are not already escaped so that it can be written
+// as a /pattern/options regular expression literal.
+func escapeRegexDelimiters(pattern string) string {
+	if !strings.Contains(pattern, "/") {
+		return pattern
+	}
+
+	var buf strings.Builder
+	escaped := false
+	for _, r := range pattern {
+		if r == '/' && !escaped {
+			buf.WriteByte('\\')
+		}
+		escaped = r == '\\' && !escaped
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
+
 func formatDouble(f float64) string {
 	var s string
 	if math.IsInf(f, 1) {
@@ -0,0 +1,37 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package bsonschema derives MongoDB $jsonSchema documents from Go types and validates BSON documents against them.
+//
+// Generate returns the $jsonSchema document for a struct type. It follows the same rules as the default StructCodec
+// to determine the key and BSON type of every field, so the schema describes the documents that Marshal produces for
+// values of the type:
+//
+// 1) Fields are named and skipped according to their bson struct tags. Unexported fields are skipped.
+//
+// 2) Fields without the omitempty option are required because they are always encoded.
+//
+// 3) Fields that are encoded as null when they are nil, like pointers, slices, and maps, also allow the null type.
+//
+// 4) The fields of inline structs are added to the enclosing struct. An inline map allows additional properties with
+// the schema of its values.
+//
+// 5) Fields of type interface{} and of types that implement Marshaler or ValueMarshaler are not constrained.
+//
+// The result of Validator can be passed to options.CreateCollectionOptions.SetValidator to enforce the schema on the
+// server:
+//
+//	validator, err := bsonschema.Validator(Order{})
+//	if err != nil {
+//		return err
+//	}
+//	opts := options.CreateCollection().SetValidator(validator)
+//
+// Compile parses a $jsonSchema document into a Schema that validates documents client-side. Schema.Validate returns
+// a *ValidationError that lists every violation found in the document along with the path of the offending value.
+// The keywords supported by the server's $jsonSchema operator are supported. Regular expressions in the pattern and
+// patternProperties keywords use the syntax of the Go regexp package instead of PCRE.
+package bsonschema
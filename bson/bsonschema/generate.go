@@ -0,0 +1,329 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var tByteSlice = reflect.TypeOf([]byte(nil))
+var tD = reflect.TypeOf(primitive.D{})
+var tE = reflect.TypeOf(primitive.E{})
+var tA = reflect.TypeOf(primitive.A{})
+var tMarshaler = reflect.TypeOf((*bsoncodec.Marshaler)(nil)).Elem()
+var tValueMarshaler = reflect.TypeOf((*bsoncodec.ValueMarshaler)(nil)).Elem()
+
+// fixedTypes holds the BSON type aliases of the types that have their own encoders in the default registry. Values
+// of the types with no aliases are not constrained.
+var fixedTypes = map[reflect.Type][]string{
+	reflect.TypeOf(time.Time{}):                {"date"},
+	reflect.TypeOf(url.URL{}):                  {"string"},
+	reflect.TypeOf(json.Number("")):            {"long", "double"},
+	reflect.TypeOf(primitive.ObjectID{}):       {"objectId"},
+	reflect.TypeOf(primitive.Decimal128{}):     {"decimal"},
+	reflect.TypeOf(primitive.DateTime(0)):      {"date"},
+	reflect.TypeOf(primitive.Timestamp{}):      {"timestamp"},
+	reflect.TypeOf(primitive.Binary{}):         {"binData"},
+	reflect.TypeOf(primitive.Regex{}):          {"regex"},
+	reflect.TypeOf(primitive.JavaScript("")):   {"javascript"},
+	reflect.TypeOf(primitive.Symbol("")):       {"symbol"},
+	reflect.TypeOf(primitive.CodeWithScope{}):  {"javascriptWithScope"},
+	reflect.TypeOf(primitive.DBPointer{}):      {"dbPointer"},
+	reflect.TypeOf(primitive.MinKey{}):         {"minKey"},
+	reflect.TypeOf(primitive.MaxKey{}):         {"maxKey"},
+	reflect.TypeOf(primitive.Null{}):           {"null"},
+	reflect.TypeOf(primitive.Undefined{}):      {"undefined"},
+	reflect.TypeOf(primitive.M{}):              {"object", "null"},
+	reflect.TypeOf(bson.Raw(nil)):              {"object", "null"},
+	reflect.TypeOf(bson.RawValue{}):            nil,
+	reflect.TypeOf(bson.RawElement(nil)):       nil,
+	reflect.TypeOf((*interface{})(nil)).Elem(): nil,
+}
+
+// Generate returns the $jsonSchema document that describes the BSON documents the default StructCodec produces for
+// values of the type of val. The val parameter must be a struct, a pointer to a struct, or a reflect.Type of either.
+func Generate(val interface{}) (bson.D, error) {
+	var t reflect.Type
+	switch v := val.(type) {
+	case reflect.Type:
+		t = v
+	case nil:
+		return nil, fmt.Errorf("cannot generate a schema for a nil value")
+	default:
+		t = reflect.TypeOf(val)
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot generate a schema for type %v, it must be a struct or a pointer to a struct", t)
+	}
+
+	g := &generator{
+		parser:   bsoncodec.DefaultStructTagParser,
+		visiting: make(map[reflect.Type]bool),
+	}
+	return g.structSchema(t)
+}
+
+// Validator returns a validator document that enforces the schema returned by Generate for the type of val. The
+// result can be passed to CreateCollectionOptions.SetValidator.
+func Validator(val interface{}) (bson.D, error) {
+	schema, err := Generate(val)
+	if err != nil {
+		return nil, err
+	}
+	return bson.D{{Key: "$jsonSchema", Value: schema}}, nil
+}
+
+type generator struct {
+	parser bsoncodec.StructTagParser
+
+	// visiting holds the struct types that are being described. A struct type that contains itself is only described
+	// as an object when it is reached again, because $jsonSchema does not support references.
+	visiting map[reflect.Type]bool
+}
+
+// schema returns the schema for values of type t. The minSize parameter corresponds to the minsize struct tag option.
+func (g *generator) schema(t reflect.Type, minSize bool) (bson.D, error) {
+	if aliases, ok := fixedTypes[t]; ok {
+		if len(aliases) == 0 {
+			return bson.D{}, nil
+		}
+		return typeSchema(aliases...), nil
+	}
+	if t.Implements(tValueMarshaler) || t.Implements(tMarshaler) {
+		return bson.D{}, nil
+	}
+
+	switch t {
+	case tD, tA:
+		alias := "object"
+		if t == tA {
+			alias = "array"
+		}
+		return typeSchema(alias, "null"), nil
+	case tByteSlice:
+		return typeSchema("binData", "null"), nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return typeSchema("bool"), nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return typeSchema("int"), nil
+	case reflect.Int:
+		return typeSchema("int", "long"), nil
+	case reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		// These types are encoded as an int32 if minsize is set and the value fits in an int32.
+		if minSize {
+			return typeSchema("int", "long"), nil
+		}
+		return typeSchema("long"), nil
+	case reflect.Float32, reflect.Float64:
+		return typeSchema("double"), nil
+	case reflect.String:
+		return typeSchema("string"), nil
+	case reflect.Interface:
+		return bson.D{}, nil
+	case reflect.Ptr:
+		elem, err := g.schema(t.Elem(), minSize)
+		if err != nil {
+			return nil, err
+		}
+		return nullable(elem), nil
+	case reflect.Array, reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return nullableIf(typeSchema("binData"), t.Kind() == reflect.Slice), nil
+		}
+		if t.Elem() == tE {
+			return nullableIf(typeSchema("object"), t.Kind() == reflect.Slice), nil
+		}
+		items, err := g.schema(t.Elem(), false)
+		if err != nil {
+			return nil, err
+		}
+		s := typeSchema("array")
+		if len(items) > 0 {
+			s = append(s, bson.E{Key: "items", Value: items})
+		}
+		return nullableIf(s, t.Kind() == reflect.Slice), nil
+	case reflect.Map:
+		values, err := g.schema(t.Elem(), false)
+		if err != nil {
+			return nil, err
+		}
+		s := typeSchema("object")
+		if len(values) > 0 {
+			s = append(s, bson.E{Key: "additionalProperties", Value: values})
+		}
+		return nullable(s), nil
+	case reflect.Struct:
+		return g.structSchema(t)
+	}
+	return nil, fmt.Errorf("type %v cannot be represented in BSON", t)
+}
+
+// structSchema returns the schema for the struct type t.
+func (g *generator) structSchema(t reflect.Type) (bson.D, error) {
+	if g.visiting[t] {
+		return typeSchema("object"), nil
+	}
+	g.visiting[t] = true
+	defer delete(g.visiting, t)
+
+	s := &structSchema{keys: make(map[string]bool)}
+	if err := g.addFields(s, t, false); err != nil {
+		return nil, err
+	}
+
+	schema := typeSchema("object")
+	if len(s.required) > 0 {
+		schema = append(schema, bson.E{Key: "required", Value: s.required})
+	}
+	if len(s.properties) > 0 {
+		schema = append(schema, bson.E{Key: "properties", Value: s.properties})
+	}
+	if s.additional != nil {
+		schema = append(schema, bson.E{Key: "additionalProperties", Value: s.additional})
+	}
+	return schema, nil
+}
+
+// structSchema holds the parts of the schema of a struct while its fields, including inline fields, are added.
+type structSchema struct {
+	keys       map[string]bool
+	required   bson.A
+	properties bson.D
+	additional interface{}
+}
+
+// addFields adds the fields of the struct type t to s. If optional is true, none of the fields are required, which is
+// the case for the fields of an inline struct pointer that are omitted when the pointer is nil.
+func (g *generator) addFields(s *structSchema, t reflect.Type, optional bool) error {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+		tags, err := g.parser.ParseStructTags(sf)
+		if err != nil {
+			return err
+		}
+		if tags.Skip {
+			continue
+		}
+
+		if tags.Inline {
+			ft := sf.Type
+			switch ft.Kind() {
+			case reflect.Map:
+				if s.additional != nil {
+					return fmt.Errorf("(struct %s) multiple inline maps", t.String())
+				}
+				values, err := g.schema(ft.Elem(), false)
+				if err != nil {
+					return err
+				}
+				s.additional = true
+				if len(values) > 0 {
+					s.additional = values
+				}
+				continue
+			case reflect.Ptr, reflect.Struct:
+				inlineOptional := optional
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+					inlineOptional = true
+				}
+				if ft.Kind() != reflect.Struct {
+					return fmt.Errorf("(struct %s) inline fields must be a struct, a struct pointer, or a map", t.String())
+				}
+				if g.visiting[ft] {
+					return fmt.Errorf("(struct %s) recursive inline struct %s", t.String(), ft.String())
+				}
+				g.visiting[ft] = true
+				err := g.addFields(s, ft, inlineOptional)
+				delete(g.visiting, ft)
+				if err != nil {
+					return err
+				}
+				continue
+			default:
+				return fmt.Errorf("(struct %s) inline fields must be a struct, a struct pointer, or a map", t.String())
+			}
+		}
+
+		if s.keys[tags.Name] {
+			return fmt.Errorf("(struct %s) duplicated key %s", t.String(), tags.Name)
+		}
+		s.keys[tags.Name] = true
+
+		fs, err := g.schema(sf.Type, tags.MinSize)
+		if err != nil {
+			return fmt.Errorf("(struct %s) field %s: %v", t.String(), sf.Name, err)
+		}
+		if !tags.OmitEmpty && !optional {
+			s.required = append(s.required, tags.Name)
+		}
+		s.properties = append(s.properties, bson.E{Key: tags.Name, Value: fs})
+	}
+	return nil
+}
+
+// typeSchema returns a schema that only allows values with one of the BSON type aliases in aliases.
+func typeSchema(aliases ...string) bson.D {
+	if len(aliases) == 1 {
+		return bson.D{{Key: "bsonType", Value: aliases[0]}}
+	}
+	types := make(bson.A, 0, len(aliases))
+	for _, alias := range aliases {
+		types = append(types, alias)
+	}
+	return bson.D{{Key: "bsonType", Value: types}}
+}
+
+// nullable returns s with the null type added to its allowed BSON types. A schema that doesn't restrict the BSON type
+// already allows null.
+func nullable(s bson.D) bson.D {
+	if len(s) == 0 || s[0].Key != "bsonType" {
+		return s
+	}
+
+	var types bson.A
+	switch bt := s[0].Value.(type) {
+	case string:
+		if bt == "null" {
+			return s
+		}
+		types = bson.A{bt, "null"}
+	case bson.A:
+		for _, alias := range bt {
+			if alias == "null" {
+				return s
+			}
+		}
+		types = append(append(bson.A{}, bt...), "null")
+	}
+
+	return append(bson.D{{Key: "bsonType", Value: types}}, s[1:]...)
+}
+
+func nullableIf(s bson.D, cond bool) bson.D {
+	if cond {
+		return nullable(s)
+	}
+	return s
+}
@@ -0,0 +1,147 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsonschema
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testAddress struct {
+	Street string
+	City   string `bson:"city,omitempty"`
+}
+
+type Audit struct {
+	CreatedBy string    `bson:"createdBy"`
+	CreatedAt time.Time `bson:"createdAt"`
+}
+
+type Extra struct {
+	Note string `bson:"note"`
+}
+
+type testOrder struct {
+	ID       primitive.ObjectID `bson:"_id"`
+	Count    int64              `bson:"count,minsize"`
+	Total    float64            `bson:"total"`
+	Tags     []string           `bson:"tags,omitempty"`
+	Address  *testAddress       `bson:"address"`
+	Labels   map[string]int32   `bson:"labels"`
+	Any      interface{}        `bson:"any"`
+	Data     []byte             `bson:"data"`
+	Skipped  string             `bson:"-"`
+	internal string
+	Audit    `bson:",inline"`
+	*Extra   `bson:",inline"`
+}
+
+type testTree struct {
+	Name     string      `bson:"name"`
+	Children []*testTree `bson:"children"`
+}
+
+type testInlineMap struct {
+	Name  string            `bson:"name"`
+	Extra map[string]string `bson:",inline"`
+}
+
+type testDuplicate struct {
+	A string `bson:"a"`
+	B string `bson:"a"`
+}
+
+type RecursiveInline struct {
+	*RecursiveInline `bson:",inline"`
+}
+
+func TestGenerate(t *testing.T) {
+	t.Run("struct", func(t *testing.T) {
+		got, err := Generate(testOrder{})
+		require.NoError(t, err, "Generate error")
+
+		want := bson.D{
+			{"bsonType", "object"},
+			{"required", bson.A{"_id", "count", "total", "address", "labels", "any", "data", "createdBy", "createdAt"}},
+			{"properties", bson.D{
+				{"_id", bson.D{{"bsonType", "objectId"}}},
+				{"count", bson.D{{"bsonType", bson.A{"int", "long"}}}},
+				{"total", bson.D{{"bsonType", "double"}}},
+				{"tags", bson.D{{"bsonType", bson.A{"array", "null"}}, {"items", bson.D{{"bsonType", "string"}}}}},
+				{"address", bson.D{
+					{"bsonType", bson.A{"object", "null"}},
+					{"required", bson.A{"street"}},
+					{"properties", bson.D{
+						{"street", bson.D{{"bsonType", "string"}}},
+						{"city", bson.D{{"bsonType", "string"}}},
+					}},
+				}},
+				{"labels", bson.D{
+					{"bsonType", bson.A{"object", "null"}},
+					{"additionalProperties", bson.D{{"bsonType", "int"}}},
+				}},
+				{"any", bson.D{}},
+				{"data", bson.D{{"bsonType", bson.A{"binData", "null"}}}},
+				{"createdBy", bson.D{{"bsonType", "string"}}},
+				{"createdAt", bson.D{{"bsonType", "date"}}},
+				{"note", bson.D{{"bsonType", "string"}}},
+			}},
+		}
+		require.Equal(t, want, got, "schemas do not match")
+	})
+	t.Run("recursive type", func(t *testing.T) {
+		got, err := Generate(reflect.TypeOf(&testTree{}))
+		require.NoError(t, err, "Generate error")
+
+		want := bson.D{
+			{"bsonType", "object"},
+			{"required", bson.A{"name", "children"}},
+			{"properties", bson.D{
+				{"name", bson.D{{"bsonType", "string"}}},
+				{"children", bson.D{
+					{"bsonType", bson.A{"array", "null"}},
+					{"items", bson.D{{"bsonType", bson.A{"object", "null"}}}},
+				}},
+			}},
+		}
+		require.Equal(t, want, got, "schemas do not match")
+	})
+	t.Run("inline map", func(t *testing.T) {
+		got, err := Validator(&testInlineMap{})
+		require.NoError(t, err, "Validator error")
+
+		want := bson.D{{"$jsonSchema", bson.D{
+			{"bsonType", "object"},
+			{"required", bson.A{"name"}},
+			{"properties", bson.D{{"name", bson.D{{"bsonType", "string"}}}}},
+			{"additionalProperties", bson.D{{"bsonType", "string"}}},
+		}}}
+		require.Equal(t, want, got, "schemas do not match")
+	})
+
+	errorCases := []struct {
+		name string
+		val  interface{}
+	}{
+		{"nil", nil},
+		{"not a struct", map[string]string{}},
+		{"duplicated key", testDuplicate{}},
+		{"recursive inline", RecursiveInline{}},
+		{"unsupported field", struct{ C chan int }{}},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Generate(tc.val)
+			require.Error(t, err, "expected Generate to fail")
+		})
+	}
+}
@@ -0,0 +1,886 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsonschema
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// typeAliases maps the BSON type aliases accepted by the bsonType keyword to the BSON types they match.
+var typeAliases = map[string][]bsontype.Type{
+	"double":              {bsontype.Double},
+	"string":              {bsontype.String},
+	"object":              {bsontype.EmbeddedDocument},
+	"array":               {bsontype.Array},
+	"binData":             {bsontype.Binary},
+	"undefined":           {bsontype.Undefined},
+	"objectId":            {bsontype.ObjectID},
+	"bool":                {bsontype.Boolean},
+	"date":                {bsontype.DateTime},
+	"null":                {bsontype.Null},
+	"regex":               {bsontype.Regex},
+	"dbPointer":           {bsontype.DBPointer},
+	"javascript":          {bsontype.JavaScript},
+	"symbol":              {bsontype.Symbol},
+	"javascriptWithScope": {bsontype.CodeWithScope},
+	"int":                 {bsontype.Int32},
+	"timestamp":           {bsontype.Timestamp},
+	"long":                {bsontype.Int64},
+	"decimal":             {bsontype.Decimal128},
+	"minKey":              {bsontype.MinKey},
+	"maxKey":              {bsontype.MaxKey},
+	"number":              {bsontype.Int32, bsontype.Int64, bsontype.Double, bsontype.Decimal128},
+}
+
+// jsonTypes maps the JSON types accepted by the type keyword to the BSON types they match.
+var jsonTypes = map[string][]bsontype.Type{
+	"object":  {bsontype.EmbeddedDocument},
+	"array":   {bsontype.Array},
+	"number":  {bsontype.Int32, bsontype.Int64, bsontype.Double, bsontype.Decimal128},
+	"boolean": {bsontype.Boolean},
+	"string":  {bsontype.String},
+	"null":    {bsontype.Null},
+}
+
+// Violation describes a value that does not satisfy a keyword of a schema.
+type Violation struct {
+	// Path is the dot separated path of the value, e.g. "orders.3.lineItems.0.qty". Array elements are identified by
+	// their index. The path of the validated document itself is empty.
+	Path string
+
+	// Keyword is the schema keyword that the value does not satisfy, e.g. "bsonType" or "required".
+	Keyword string
+
+	// Message describes the violation.
+	Message string
+}
+
+func (v Violation) String() string {
+	if v.Path == "" {
+		return fmt.Sprintf("%s: %s", v.Keyword, v.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", v.Path, v.Keyword, v.Message)
+}
+
+// ValidationError is returned by Schema.Validate when a document does not satisfy the schema.
+type ValidationError struct {
+	Violations []Violation
+}
+
+// Error implements the error interface.
+func (ve *ValidationError) Error() string {
+	msgs := make([]string, 0, len(ve.Violations))
+	for _, v := range ve.Violations {
+		msgs = append(msgs, v.String())
+	}
+	return "document failed validation: " + strings.Join(msgs, "; ")
+}
+
+// Schema is a compiled $jsonSchema document that validates BSON documents.
+type Schema struct {
+	typeKeyword string
+	types       []bsontype.Type
+	typeNames   []string
+	enum        []bson.RawValue
+
+	minimum, maximum                   *numeric
+	exclusiveMinimum, exclusiveMaximum bool
+	multipleOf                         *numeric
+
+	minLength, maxLength *int64
+	pattern              *regexp.Regexp
+
+	minItems, maxItems *int64
+	uniqueItems        bool
+	items              *Schema
+	itemsList          []*Schema
+	additionalItems    *Schema
+	noAdditionalItems  bool
+
+	minProperties, maxProperties *int64
+	required                     []string
+	properties                   map[string]*Schema
+	patternProperties            []patternSchema
+	additionalProperties         *Schema
+	noAdditionalProperties       bool
+	dependencies                 map[string]dependency
+
+	allOf, anyOf, oneOf []*Schema
+	not                 *Schema
+}
+
+type patternSchema struct {
+	pattern *regexp.Regexp
+	schema  *Schema
+}
+
+// dependency is the value of a key in the dependencies keyword. It is either a list of keys or a schema.
+type dependency struct {
+	keys   []string
+	schema *Schema
+}
+
+// Compile parses a $jsonSchema document. The schema parameter can be a bson.Raw, a []byte, or any value that can be
+// marshalled into a BSON document, like a bson.D returned by Generate. A document that contains only a $jsonSchema
+// key, like the ones returned by Validator, is unwrapped.
+func Compile(schema interface{}) (*Schema, error) {
+	var doc bson.Raw
+	switch s := schema.(type) {
+	case bson.Raw:
+		doc = s
+	case []byte:
+		doc = bson.Raw(s)
+	default:
+		var err error
+		doc, err = bson.Marshal(schema)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+
+	elems, err := doc.Elements()
+	if err != nil {
+		return nil, err
+	}
+	if len(elems) == 1 && elems[0].Key() == "$jsonSchema" {
+		inner, ok := elems[0].Value().DocumentOK()
+		if !ok {
+			return nil, fmt.Errorf("$jsonSchema must be a document, got %v", elems[0].Value().Type)
+		}
+		doc = inner
+	}
+	return compile(doc, "")
+}
+
+// compile parses the schema doc. The path is used in error messages.
+func compile(doc bson.Raw, path string) (*Schema, error) {
+	elems, err := doc.Elements()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Schema{}
+	for _, elem := range elems {
+		key := elem.Key()
+		val := elem.Value()
+		kpath := key
+		if path != "" {
+			kpath = path + "." + key
+		}
+
+		switch key {
+		case "bsonType", "type":
+			if s.typeKeyword != "" {
+				return nil, fmt.Errorf("%s: cannot specify both bsonType and type", kpath)
+			}
+			s.typeKeyword = key
+			table := typeAliases
+			if key == "type" {
+				table = jsonTypes
+			}
+			names, err := stringOrStrings(val, kpath)
+			if err != nil {
+				return nil, err
+			}
+			for _, name := range names {
+				types, ok := table[name]
+				if !ok {
+					return nil, fmt.Errorf("%s: unknown type %q", kpath, name)
+				}
+				s.types = append(s.types, types...)
+			}
+			s.typeNames = names
+		case "enum":
+			arr, ok := val.ArrayOK()
+			if !ok {
+				return nil, fmt.Errorf("%s: must be an array", kpath)
+			}
+			if s.enum, err = arr.Values(); err != nil {
+				return nil, err
+			}
+			if len(s.enum) == 0 {
+				return nil, fmt.Errorf("%s: must not be empty", kpath)
+			}
+		case "minimum", "maximum", "multipleOf":
+			n, ok := toNumeric(val)
+			if !ok {
+				return nil, fmt.Errorf("%s: must be a number", kpath)
+			}
+			switch key {
+			case "minimum":
+				s.minimum = &n
+			case "maximum":
+				s.maximum = &n
+			case "multipleOf":
+				if c, ok := n.compare(numeric{isInt: true}); !ok || c <= 0 {
+					return nil, fmt.Errorf("%s: must be positive", kpath)
+				}
+				s.multipleOf = &n
+			}
+		case "exclusiveMinimum", "exclusiveMaximum":
+			b, ok := val.BooleanOK()
+			if !ok {
+				return nil, fmt.Errorf("%s: must be a boolean", kpath)
+			}
+			if key == "exclusiveMinimum" {
+				s.exclusiveMinimum = b
+			} else {
+				s.exclusiveMaximum = b
+			}
+		case "minLength", "maxLength", "minItems", "maxItems", "minProperties", "maxProperties":
+			n, err := count(val, kpath)
+			if err != nil {
+				return nil, err
+			}
+			switch key {
+			case "minLength":
+				s.minLength = &n
+			case "maxLength":
+				s.maxLength = &n
+			case "minItems":
+				s.minItems = &n
+			case "maxItems":
+				s.maxItems = &n
+			case "minProperties":
+				s.minProperties = &n
+			case "maxProperties":
+				s.maxProperties = &n
+			}
+		case "pattern":
+			if s.pattern, err = pattern(val, kpath); err != nil {
+				return nil, err
+			}
+		case "uniqueItems":
+			b, ok := val.BooleanOK()
+			if !ok {
+				return nil, fmt.Errorf("%s: must be a boolean", kpath)
+			}
+			s.uniqueItems = b
+		case "items":
+			if arr, ok := val.ArrayOK(); ok {
+				if s.itemsList, err = compileList(arr, kpath); err != nil {
+					return nil, err
+				}
+				break
+			}
+			if s.items, err = compileValue(val, kpath); err != nil {
+				return nil, err
+			}
+		case "additionalItems":
+			if s.additionalItems, s.noAdditionalItems, err = compileAdditional(val, kpath); err != nil {
+				return nil, err
+			}
+		case "additionalProperties":
+			if s.additionalProperties, s.noAdditionalProperties, err = compileAdditional(val, kpath); err != nil {
+				return nil, err
+			}
+		case "required":
+			arr, ok := val.ArrayOK()
+			if !ok {
+				return nil, fmt.Errorf("%s: must be an array", kpath)
+			}
+			if s.required, err = stringList(arr, kpath); err != nil {
+				return nil, err
+			}
+			if len(s.required) == 0 {
+				return nil, fmt.Errorf("%s: must not be empty", kpath)
+			}
+		case "properties":
+			props, err := compileMap(val, kpath)
+			if err != nil {
+				return nil, err
+			}
+			s.properties = props
+		case "patternProperties":
+			props, ok := val.DocumentOK()
+			if !ok {
+				return nil, fmt.Errorf("%s: must be a document", kpath)
+			}
+			pelems, err := props.Elements()
+			if err != nil {
+				return nil, err
+			}
+			for _, pelem := range pelems {
+				re, err := regexp.Compile(pelem.Key())
+				if err != nil {
+					return nil, fmt.Errorf("%s: invalid pattern %q: %v", kpath, pelem.Key(), err)
+				}
+				ps, err := compileValue(pelem.Value(), kpath+"."+pelem.Key())
+				if err != nil {
+					return nil, err
+				}
+				s.patternProperties = append(s.patternProperties, patternSchema{pattern: re, schema: ps})
+			}
+		case "dependencies":
+			deps, ok := val.DocumentOK()
+			if !ok {
+				return nil, fmt.Errorf("%s: must be a document", kpath)
+			}
+			delems, err := deps.Elements()
+			if err != nil {
+				return nil, err
+			}
+			s.dependencies = make(map[string]dependency, len(delems))
+			for _, delem := range delems {
+				dpath := kpath + "." + delem.Key()
+				var dep dependency
+				if arr, ok := delem.Value().ArrayOK(); ok {
+					dep.keys, err = stringList(arr, dpath)
+				} else {
+					dep.schema, err = compileValue(delem.Value(), dpath)
+				}
+				if err != nil {
+					return nil, err
+				}
+				s.dependencies[delem.Key()] = dep
+			}
+		case "allOf", "anyOf", "oneOf":
+			arr, ok := val.ArrayOK()
+			if !ok {
+				return nil, fmt.Errorf("%s: must be an array", kpath)
+			}
+			list, err := compileList(arr, kpath)
+			if err != nil {
+				return nil, err
+			}
+			if len(list) == 0 {
+				return nil, fmt.Errorf("%s: must not be empty", kpath)
+			}
+			switch key {
+			case "allOf":
+				s.allOf = list
+			case "anyOf":
+				s.anyOf = list
+			case "oneOf":
+				s.oneOf = list
+			}
+		case "not":
+			if s.not, err = compileValue(val, kpath); err != nil {
+				return nil, err
+			}
+		case "title", "description":
+			if _, ok := val.StringValueOK(); !ok {
+				return nil, fmt.Errorf("%s: must be a string", kpath)
+			}
+		default:
+			return nil, fmt.Errorf("%s: unsupported keyword", kpath)
+		}
+	}
+	return s, nil
+}
+
+func compileValue(val bson.RawValue, path string) (*Schema, error) {
+	doc, ok := val.DocumentOK()
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a document", path)
+	}
+	return compile(doc, path)
+}
+
+func compileList(arr bson.Raw, path string) ([]*Schema, error) {
+	vals, err := arr.Values()
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*Schema, 0, len(vals))
+	for i, val := range vals {
+		s, err := compileValue(val, fmt.Sprintf("%s.%d", path, i))
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, s)
+	}
+	return list, nil
+}
+
+func compileMap(val bson.RawValue, path string) (map[string]*Schema, error) {
+	doc, ok := val.DocumentOK()
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a document", path)
+	}
+	elems, err := doc.Elements()
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]*Schema, len(elems))
+	for _, elem := range elems {
+		s, err := compileValue(elem.Value(), path+"."+elem.Key())
+		if err != nil {
+			return nil, err
+		}
+		m[elem.Key()] = s
+	}
+	return m, nil
+}
+
+// compileAdditional parses the value of additionalItems or additionalProperties, which is either a boolean or a
+// schema. It returns whether additional values are forbidden.
+func compileAdditional(val bson.RawValue, path string) (*Schema, bool, error) {
+	if b, ok := val.BooleanOK(); ok {
+		return nil, !b, nil
+	}
+	s, err := compileValue(val, path)
+	return s, false, err
+}
+
+func stringOrStrings(val bson.RawValue, path string) ([]string, error) {
+	if str, ok := val.StringValueOK(); ok {
+		return []string{str}, nil
+	}
+	arr, ok := val.ArrayOK()
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a string or an array of strings", path)
+	}
+	strs, err := stringList(arr, path)
+	if err != nil {
+		return nil, err
+	}
+	if len(strs) == 0 {
+		return nil, fmt.Errorf("%s: must not be empty", path)
+	}
+	return strs, nil
+}
+
+func stringList(arr bson.Raw, path string) ([]string, error) {
+	vals, err := arr.Values()
+	if err != nil {
+		return nil, err
+	}
+	strs := make([]string, 0, len(vals))
+	for _, val := range vals {
+		str, ok := val.StringValueOK()
+		if !ok {
+			return nil, fmt.Errorf("%s: must be an array of strings", path)
+		}
+		strs = append(strs, str)
+	}
+	return strs, nil
+}
+
+func count(val bson.RawValue, path string) (int64, error) {
+	f, ok := number(val)
+	if !ok || f < 0 || f != math.Trunc(f) {
+		return 0, fmt.Errorf("%s: must be a non-negative integer", path)
+	}
+	return int64(f), nil
+}
+
+func pattern(val bson.RawValue, path string) (*regexp.Regexp, error) {
+	var expr string
+	if str, ok := val.StringValueOK(); ok {
+		expr = str
+	} else if p, opts, ok := val.RegexOK(); ok {
+		expr = p
+		if opts != "" {
+			expr = "(?" + opts + ")" + p
+		}
+	} else {
+		return nil, fmt.Errorf("%s: must be a string or a regular expression", path)
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid pattern %q: %v", path, expr, err)
+	}
+	return re, nil
+}
+
+// number returns the value of val as a float64 if it is a number.
+func number(val bson.RawValue) (float64, bool) {
+	switch val.Type {
+	case bsontype.Int32:
+		return float64(val.Int32()), true
+	case bsontype.Int64:
+		return float64(val.Int64()), true
+	case bsontype.Double:
+		return val.Double(), true
+	case bsontype.Decimal128:
+		return val.Decimal128().Float64(), true
+	}
+	return 0, false
+}
+
+// numeric is a number in a schema or a document. Integers, including Decimal128 values that are integers in the int64
+// range, are kept as an int64 so that they are compared exactly. Other numbers are kept as a float64.
+type numeric struct {
+	i     int64
+	f     float64
+	isInt bool
+}
+
+func toNumeric(val bson.RawValue) (numeric, bool) {
+	switch val.Type {
+	case bsontype.Int32, bsontype.Int64:
+		return numeric{i: integer(val), isInt: true}, true
+	case bsontype.Decimal128:
+		if i, err := val.Decimal128().Int64(); err == nil {
+			return numeric{i: i, isInt: true}, true
+		}
+	}
+	f, ok := number(val)
+	return numeric{f: f}, ok
+}
+
+func (n numeric) String() string {
+	if n.isInt {
+		return strconv.FormatInt(n.i, 10)
+	}
+	return fmt.Sprint(n.f)
+}
+
+// compare returns -1, 0, or 1 if n is less than, equal to, or greater than m. It returns false if either is NaN.
+func (n numeric) compare(m numeric) (int, bool) {
+	switch {
+	case n.isInt && m.isInt:
+		switch {
+		case n.i < m.i:
+			return -1, true
+		case n.i > m.i:
+			return 1, true
+		}
+		return 0, true
+	case n.isInt:
+		c, ok := m.compare(n)
+		return -c, ok
+	case m.isInt:
+		return compareFloatInt(n.f, m.i)
+	}
+
+	switch {
+	case math.IsNaN(n.f) || math.IsNaN(m.f):
+		return 0, false
+	case n.f < m.f:
+		return -1, true
+	case n.f > m.f:
+		return 1, true
+	}
+	return 0, true
+}
+
+// compareFloatInt compares f to i without converting i to a float64, which would round integers above 2^53.
+func compareFloatInt(f float64, i int64) (int, bool) {
+	switch {
+	case math.IsNaN(f):
+		return 0, false
+	case f >= 1<<63:
+		return 1, true
+	case f < -(1 << 63):
+		return -1, true
+	}
+
+	t := math.Trunc(f)
+	switch ti := int64(t); {
+	case ti < i:
+		return -1, true
+	case ti > i:
+		return 1, true
+	case f < t:
+		return -1, true
+	case f > t:
+		return 1, true
+	}
+	return 0, true
+}
+
+// isMultipleOf reports whether n is an integer multiple of m, which must be positive.
+func (n numeric) isMultipleOf(m numeric) bool {
+	if n.isInt && m.isInt {
+		return n.i%m.i == 0
+	}
+	nf, mf := n.f, m.f
+	if n.isInt {
+		nf = float64(n.i)
+	}
+	if m.isInt {
+		mf = float64(m.i)
+	}
+	q := nf / mf
+	return q == math.Trunc(q)
+}
+
+// Validate validates doc against the schema. If doc does not satisfy the schema, a *ValidationError that describes
+// every violation is returned. An error is also returned if doc is not a valid BSON document.
+func (s *Schema) Validate(doc bson.Raw) error {
+	if err := doc.Validate(); err != nil {
+		return err
+	}
+
+	var v validator
+	s.validate(bson.RawValue{Type: bsontype.EmbeddedDocument, Value: doc}, &v)
+	if len(v.violations) == 0 {
+		return nil
+	}
+	return &ValidationError{Violations: v.violations}
+}
+
+// validator holds the state of a validation.
+type validator struct {
+	path       []string
+	violations []Violation
+}
+
+func (v *validator) add(keyword, format string, args ...interface{}) {
+	v.violations = append(v.violations, Violation{
+		Path:    strings.Join(v.path, "."),
+		Keyword: keyword,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// matches reports whether val satisfies s without recording any violations.
+func (v *validator) matches(s *Schema, val bson.RawValue) bool {
+	sub := validator{path: v.path}
+	s.validate(val, &sub)
+	return len(sub.violations) == 0
+}
+
+// validateAt validates val, which is the value for key, against s.
+func (v *validator) validateAt(s *Schema, key string, val bson.RawValue) {
+	v.path = append(v.path, key)
+	s.validate(val, v)
+	v.path = v.path[:len(v.path)-1]
+}
+
+func (s *Schema) validate(val bson.RawValue, v *validator) {
+	if len(s.types) > 0 && !hasType(s.types, val.Type) {
+		names := strings.Join(s.typeNames, ", ")
+		v.add(s.typeKeyword, "expected type %s, got %s", names, typeName(val.Type))
+	}
+	if len(s.enum) > 0 {
+		found := false
+		for _, e := range s.enum {
+			if equal(e, val) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			v.add("enum", "value %v is not one of the allowed values", val)
+		}
+	}
+
+	if n, ok := toNumeric(val); ok {
+		s.validateNumber(n, v)
+	}
+	if str, ok := val.StringValueOK(); ok {
+		s.validateString(str, v)
+	}
+	if arr, ok := val.ArrayOK(); ok {
+		s.validateArray(arr, v)
+	}
+	if doc, ok := val.DocumentOK(); ok {
+		s.validateDocument(doc, v)
+	}
+
+	for _, sub := range s.allOf {
+		sub.validate(val, v)
+	}
+	if len(s.anyOf) > 0 {
+		matched := false
+		for _, sub := range s.anyOf {
+			if v.matches(sub, val) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			v.add("anyOf", "value does not match any of the schemas")
+		}
+	}
+	if len(s.oneOf) > 0 {
+		matched := 0
+		for _, sub := range s.oneOf {
+			if v.matches(sub, val) {
+				matched++
+			}
+		}
+		if matched != 1 {
+			v.add("oneOf", "value matches %d of the schemas instead of exactly one", matched)
+		}
+	}
+	if s.not != nil && v.matches(s.not, val) {
+		v.add("not", "value matches the schema")
+	}
+}
+
+func (s *Schema) validateNumber(n numeric, v *validator) {
+	if s.minimum != nil {
+		c, ok := n.compare(*s.minimum)
+		if s.exclusiveMinimum && ok && c <= 0 {
+			v.add("minimum", "value %v is not greater than %v", n, *s.minimum)
+		} else if !s.exclusiveMinimum && ok && c < 0 {
+			v.add("minimum", "value %v is less than %v", n, *s.minimum)
+		}
+	}
+	if s.maximum != nil {
+		c, ok := n.compare(*s.maximum)
+		if s.exclusiveMaximum && ok && c >= 0 {
+			v.add("maximum", "value %v is not less than %v", n, *s.maximum)
+		} else if !s.exclusiveMaximum && ok && c > 0 {
+			v.add("maximum", "value %v is greater than %v", n, *s.maximum)
+		}
+	}
+	if s.multipleOf != nil && !n.isMultipleOf(*s.multipleOf) {
+		v.add("multipleOf", "value %v is not a multiple of %v", n, *s.multipleOf)
+	}
+}
+
+func (s *Schema) validateString(str string, v *validator) {
+	n := int64(utf8.RuneCountInString(str))
+	if s.minLength != nil && n < *s.minLength {
+		v.add("minLength", "length %d is less than %d", n, *s.minLength)
+	}
+	if s.maxLength != nil && n > *s.maxLength {
+		v.add("maxLength", "length %d is greater than %d", n, *s.maxLength)
+	}
+	if s.pattern != nil && !s.pattern.MatchString(str) {
+		v.add("pattern", "value %q does not match %q", str, s.pattern.String())
+	}
+}
+
+func (s *Schema) validateArray(arr bson.Raw, v *validator) {
+	vals, _ := arr.Values() // The document was validated before it was traversed.
+	n := int64(len(vals))
+	if s.minItems != nil && n < *s.minItems {
+		v.add("minItems", "array has %d items, fewer than %d", n, *s.minItems)
+	}
+	if s.maxItems != nil && n > *s.maxItems {
+		v.add("maxItems", "array has %d items, more than %d", n, *s.maxItems)
+	}
+	if s.uniqueItems {
+	unique:
+		for i := range vals {
+			for j := 0; j < i; j++ {
+				if equal(vals[i], vals[j]) {
+					v.add("uniqueItems", "items %d and %d are equal", j, i)
+					break unique
+				}
+			}
+		}
+	}
+
+	for i, val := range vals {
+		key := fmt.Sprint(i)
+		switch {
+		case s.items != nil:
+			v.validateAt(s.items, key, val)
+		case i < len(s.itemsList):
+			v.validateAt(s.itemsList[i], key, val)
+		case s.itemsList == nil:
+		case s.noAdditionalItems:
+			v.add("additionalItems", "array has %d items, more than the %d allowed", n, len(s.itemsList))
+			return
+		case s.additionalItems != nil:
+			v.validateAt(s.additionalItems, key, val)
+		}
+	}
+}
+
+func (s *Schema) validateDocument(doc bson.Raw, v *validator) {
+	elems, _ := doc.Elements() // The document was validated before it was traversed.
+	n := int64(len(elems))
+	if s.minProperties != nil && n < *s.minProperties {
+		v.add("minProperties", "document has %d fields, fewer than %d", n, *s.minProperties)
+	}
+	if s.maxProperties != nil && n > *s.maxProperties {
+		v.add("maxProperties", "document has %d fields, more than %d", n, *s.maxProperties)
+	}
+	for _, key := range s.required {
+		if _, err := doc.LookupErr(key); err != nil {
+			v.add("required", "missing required field %q", key)
+		}
+	}
+
+	for _, elem := range elems {
+		key := elem.Key()
+		val := elem.Value()
+
+		matched := false
+		if ps, ok := s.properties[key]; ok {
+			matched = true
+			v.validateAt(ps, key, val)
+		}
+		for _, pp := range s.patternProperties {
+			if pp.pattern.MatchString(key) {
+				matched = true
+				v.validateAt(pp.schema, key, val)
+			}
+		}
+		if !matched {
+			if s.noAdditionalProperties {
+				v.add("additionalProperties", "field %q is not allowed", key)
+			} else if s.additionalProperties != nil {
+				v.validateAt(s.additionalProperties, key, val)
+			}
+		}
+
+		if dep, ok := s.dependencies[key]; ok {
+			for _, dk := range dep.keys {
+				if _, err := doc.LookupErr(dk); err != nil {
+					v.add("dependencies", "field %q requires field %q", key, dk)
+				}
+			}
+			if dep.schema != nil {
+				dep.schema.validate(bson.RawValue{Type: bsontype.EmbeddedDocument, Value: doc}, v)
+			}
+		}
+	}
+}
+
+func hasType(types []bsontype.Type, t bsontype.Type) bool {
+	for _, tt := range types {
+		if tt == t {
+			return true
+		}
+	}
+	return false
+}
+
+// typeName returns the BSON type alias of t.
+func typeName(t bsontype.Type) string {
+	for name, types := range typeAliases {
+		if name != "number" && types[0] == t {
+			return name
+		}
+	}
+	return t.String()
+}
+
+// equal reports whether a and b are equal. Numbers of different types are equal if they have the same value.
+func equal(a, b bson.RawValue) bool {
+	if fa, ok := number(a); ok {
+		fb, ok := number(b)
+		if !ok {
+			return false
+		}
+		if a.Type != bsontype.Double && a.Type != bsontype.Decimal128 &&
+			b.Type != bsontype.Double && b.Type != bsontype.Decimal128 {
+			return integer(a) == integer(b)
+		}
+		return fa == fb
+	}
+	return a.Type == b.Type && bytes.Equal(a.Value, b.Value)
+}
+
+// integer returns the value of val, which must be an int32 or an int64, as an int64.
+func integer(val bson.RawValue) int64 {
+	if val.Type == bsontype.Int32 {
+		return int64(val.Int32())
+	}
+	return val.Int64()
+}
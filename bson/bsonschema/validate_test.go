@@ -0,0 +1,199 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsonschema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestSchemaValidate(t *testing.T) {
+	marshal := func(doc bson.D) bson.Raw {
+		b, err := bson.Marshal(doc)
+		require.NoError(t, err, "Marshal error")
+		return b
+	}
+
+	schema, err := Compile(bson.D{{"$jsonSchema", bson.D{
+		{"bsonType", "object"},
+		{"required", bson.A{"sku", "qty"}},
+		{"properties", bson.D{
+			{"sku", bson.D{{"bsonType", "string"}, {"pattern", "^[A-Z]{3}-[0-9]+$"}}},
+			{"qty", bson.D{{"bsonType", "number"}, {"minimum", 1}, {"maximum", 100}, {"multipleOf", 1}}},
+			{"status", bson.D{{"enum", bson.A{"new", "shipped"}}}},
+			{"name", bson.D{{"type", "string"}, {"minLength", 2}, {"maxLength", 4}}},
+			{"tags", bson.D{
+				{"bsonType", "array"},
+				{"maxItems", 2},
+				{"uniqueItems", true},
+				{"items", bson.D{{"bsonType", "string"}}},
+			}},
+			{"lines", bson.D{
+				{"bsonType", "array"},
+				{"items", bson.D{
+					{"bsonType", "object"},
+					{"required", bson.A{"qty"}},
+					{"properties", bson.D{{"qty", bson.D{{"bsonType", "int"}}}}},
+					{"additionalProperties", false},
+				}},
+			}},
+			{"code", bson.D{{"oneOf", bson.A{bson.D{{"bsonType", "int"}}, bson.D{{"bsonType", "number"}}}}}},
+			{"ref", bson.D{{"not", bson.D{{"bsonType", "null"}}}}},
+		}},
+		{"patternProperties", bson.D{{"^x-", bson.D{{"bsonType", "string"}}}}},
+		{"dependencies", bson.D{{"discount", bson.A{"coupon"}}}},
+	}}})
+	require.NoError(t, err, "Compile error")
+
+	valid := marshal(bson.D{
+		{"sku", "ABC-1"},
+		{"qty", int64(3)},
+		{"status", "new"},
+		{"name", "héll"},
+		{"tags", bson.A{"a", "b"}},
+		{"lines", bson.A{bson.D{{"qty", int32(1)}}}},
+		{"code", 1.5},
+		{"x-note", "n"},
+	})
+	require.NoError(t, schema.Validate(valid), "expected document to be valid")
+
+	invalid := marshal(bson.D{
+		{"sku", "abc"},
+		{"qty", 1.5},
+		{"status", "lost"},
+		{"name", "h"},
+		{"tags", bson.A{"a", "b", "a"}},
+		{"lines", bson.A{bson.D{{"qty", int32(1)}}, bson.D{{"qty", "2"}, {"extra", true}}}},
+		{"code", int32(1)},
+		{"ref", nil},
+		{"x-note", 1},
+		{"discount", 5},
+	})
+	err = schema.Validate(invalid)
+	verr, ok := err.(*ValidationError)
+	require.True(t, ok, "expected a *ValidationError, got %T: %v", err, err)
+
+	want := []Violation{
+		{"sku", "pattern", `value "abc" does not match "^[A-Z]{3}-[0-9]+$"`},
+		{"qty", "multipleOf", "value 1.5 is not a multiple of 1"},
+		{"status", "enum", `value "lost" is not one of the allowed values`},
+		{"name", "minLength", "length 1 is less than 2"},
+		{"tags", "maxItems", "array has 3 items, more than 2"},
+		{"tags", "uniqueItems", "items 0 and 2 are equal"},
+		{"lines.1.qty", "bsonType", "expected type int, got string"},
+		{"lines.1", "additionalProperties", `field "extra" is not allowed`},
+		{"code", "oneOf", "value matches 2 of the schemas instead of exactly one"},
+		{"ref", "not", "value matches the schema"},
+		{"x-note", "bsonType", "expected type string, got int"},
+		{"", "dependencies", `field "discount" requires field "coupon"`},
+	}
+	require.Equal(t, want, verr.Violations, "violations do not match")
+
+	err = schema.Validate(marshal(bson.D{{"qty", int32(0)}}))
+	require.EqualError(t, err,
+		`document failed validation: required: missing required field "sku"; qty: minimum: value 0 is less than 1`)
+	require.Error(t, schema.Validate(bson.Raw{0x05}), "expected error for malformed document")
+}
+
+func TestSchemaValidateLargeIntegers(t *testing.T) {
+	// Integers above 2^53 cannot be represented exactly as a float64, so they must be compared as integers.
+	schema, err := Compile(bson.D{{"$jsonSchema", bson.D{{"properties", bson.D{
+		{"max", bson.D{{"maximum", int64(1 << 53)}}},
+		{"mult", bson.D{{"multipleOf", int64(3)}}},
+		{"frac", bson.D{{"maximum", 1.5}}},
+	}}}}})
+	require.NoError(t, err, "Compile error")
+
+	dec, err := primitive.ParseDecimal128("9007199254740993")
+	require.NoError(t, err, "ParseDecimal128 error")
+	testCases := []struct {
+		name  string
+		doc   bson.D
+		valid bool
+	}{
+		{"maximum equal", bson.D{{"max", int64(1 << 53)}}, true},
+		{"maximum exceeded by one", bson.D{{"max", int64(1<<53 + 1)}}, false},
+		{"maximum exceeded by decimal", bson.D{{"max", dec}}, false},
+		{"multipleOf", bson.D{{"mult", int64(1<<62 + 2)}}, true},
+		{"not multipleOf", bson.D{{"mult", int64(1<<62 + 1)}}, false},
+		{"double maximum", bson.D{{"frac", int32(1)}}, true},
+		{"double maximum exceeded", bson.D{{"frac", int32(2)}}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := bson.Marshal(tc.doc)
+			require.NoError(t, err, "Marshal error")
+			err = schema.Validate(doc)
+			if tc.valid {
+				require.NoError(t, err, "expected document to be valid")
+			} else {
+				require.Error(t, err, "expected document to be invalid")
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		schema bson.D
+		err    string
+	}{
+		{"unknown keyword", bson.D{{"$ref", "#"}}, "$ref: unsupported keyword"},
+		{"unknown type", bson.D{{"bsonType", "integer"}}, `bsonType: unknown type "integer"`},
+		{"both type keywords", bson.D{{"bsonType", "int"}, {"type", "number"}}, "type: cannot specify both bsonType and type"},
+		{"invalid pattern", bson.D{{"properties", bson.D{{"a", bson.D{{"pattern", "("}}}}}}, `properties.a.pattern: invalid pattern "(": error parsing regexp: missing closing ): ` + "`(`"},
+		{"negative count", bson.D{{"minItems", -1}}, "minItems: must be a non-negative integer"},
+		{"empty required", bson.D{{"required", bson.A{}}}, "required: must not be empty"},
+		{"invalid items", bson.D{{"items", 1}}, "items: must be a document"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Compile(tc.schema)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestGenerateValidateRoundTrip(t *testing.T) {
+	validator, err := Validator(testOrder{})
+	require.NoError(t, err, "Validator error")
+	schema, err := Compile(validator)
+	require.NoError(t, err, "Compile error")
+
+	orders := []testOrder{
+		{},
+		{
+			ID:      primitive.NewObjectID(),
+			Count:   2,
+			Total:   10.5,
+			Tags:    []string{"a"},
+			Address: &testAddress{Street: "Main", City: "Springfield"},
+			Labels:  map[string]int32{"x": 1},
+			Any:     bson.D{{"k", "v"}},
+			Data:    []byte{1, 2},
+			Audit:   Audit{CreatedBy: "me", CreatedAt: time.Now()},
+			Extra:   &Extra{Note: "n"},
+		},
+	}
+	for _, order := range orders {
+		doc, err := bson.Marshal(order)
+		require.NoError(t, err, "Marshal error")
+		require.NoError(t, schema.Validate(doc), "expected %v to be valid", bson.Raw(doc))
+	}
+
+	doc, err := bson.Marshal(bson.D{{"_id", "not an id"}})
+	require.NoError(t, err, "Marshal error")
+	verr, ok := schema.Validate(doc).(*ValidationError)
+	require.True(t, ok, "expected a *ValidationError")
+	require.Equal(t, Violation{"", "required", `missing required field "count"`}, verr.Violations[0])
+	require.Equal(t, Violation{"_id", "bsonType", "expected type objectId, got string"}, verr.Violations[len(verr.Violations)-1])
+}
@@ -111,6 +111,14 @@ func (d *Decoder) SetRegistry(r *bsoncodec.Registry) error {
 	return nil
 }
 
+// SetStrict specifies if the decoder should decode structs strictly. When strict decoding is enabled, a document that
+// contains a key that does not match a struct field or that contains the same key more than once will return an error
+// that reports the full path of the key.
+func (d *Decoder) SetStrict(strict bool) error {
+	d.dc.Strict = strict
+	return nil
+}
+
 // SetContext replaces the current registry of the decoder with dc.
 func (d *Decoder) SetContext(dc bsoncodec.DecodeContext) error {
 	d.dc = dc
@@ -235,6 +235,56 @@ func TestDecoderv2(t *testing.T) {
 			t.Errorf("Decoder should use the Registry provided. got %v; want %v", dec.dc, dc2)
 		}
 	})
+	t.Run("SetStrict", func(t *testing.T) {
+		type inner struct {
+			B int
+		}
+		type outer struct {
+			A     int
+			Inner inner
+			Extra map[string]interface{} `bson:",inline"`
+		}
+		testCases := []struct {
+			name string
+			doc  D
+			keys []string
+			err  error
+		}{
+			{"valid", D{{"a", 1}, {"inner", D{{"b", 2}}}, {"c", 3}}, nil, nil},
+			{"unknown nested key", D{{"inner", D{{"b", 2}, {"c", 3}}}}, []string{"inner", "c"}, bsoncodec.ErrUnknownField},
+			{"duplicate key", D{{"a", 1}, {"A", 2}}, []string{"A"}, bsoncodec.ErrDuplicateField},
+			{"duplicate inline key", D{{"c", 1}, {"c", 2}}, []string{"c"}, bsoncodec.ErrDuplicateField},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				dec, err := NewDecoder(bsonrw.NewBSONDocumentReader(docToBytes(tc.doc)))
+				noerr(t, err)
+				err = dec.SetStrict(true)
+				noerr(t, err)
+
+				var got outer
+				err = dec.Decode(&got)
+				if tc.err == nil {
+					noerr(t, err)
+					return
+				}
+				de, ok := err.(*bsoncodec.DecodeError)
+				if !ok {
+					t.Fatalf("expected error of type %T, got %v", de, err)
+				}
+				if de.Unwrap() != tc.err {
+					t.Errorf("wrapped error mismatch; expected %v, got %v", tc.err, de.Unwrap())
+				}
+				if !cmp.Equal(de.Keys(), tc.keys) {
+					t.Errorf("keys mismatch; expected %v, got %v", tc.keys, de.Keys())
+				}
+			})
+		}
+
+		var got struct{ A int }
+		err := Unmarshal(docToBytes(D{{"a", 1}, {"b", 2}}), &got)
+		noerr(t, err)
+	})
 	t.Run("DecodeToNil", func(t *testing.T) {
 		data := docToBytes(D{{"item", "canvas"}, {"qty", 4}})
 		vr := bsonrw.NewBSONDocumentReader(data)
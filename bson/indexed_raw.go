@@ -0,0 +1,83 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// IndexedRaw is a read-only view of a Raw document that indexes the offsets of its elements so that repeated lookups
+// do not rescan the document. It is useful when many values are read from the same large document, like
+// Cursor.Current. This type is a wrapper around a bsoncore.IndexedDocument; see that type for details about how and
+// when the index is built. IndexedRaw is not safe for concurrent use.
+type IndexedRaw struct {
+	doc *bsoncore.IndexedDocument
+}
+
+// NewIndexedRaw returns an IndexedRaw for r. The document is not read until the first lookup. The values returned
+// from the view reference r, so r must not be modified while they are in use.
+func NewIndexedRaw(r Raw) *IndexedRaw {
+	return &IndexedRaw{doc: bsoncore.NewIndexedDocument(bsoncore.Document(r))}
+}
+
+// Raw returns the underlying document.
+func (ir *IndexedRaw) Raw() Raw { return Raw(ir.doc.Document()) }
+
+// Len returns the number of elements in the document.
+func (ir *IndexedRaw) Len() (int, error) { return ir.doc.Len() }
+
+// Index retrieves the element at the given index. This method will panic if the document is invalid or if the index
+// is out of bounds.
+func (ir *IndexedRaw) Index(index uint) RawElement { return RawElement(ir.doc.Index(index)) }
+
+// IndexErr retrieves the element at the given index.
+func (ir *IndexedRaw) IndexErr(index uint) (RawElement, error) {
+	elem, err := ir.doc.IndexErr(index)
+	return RawElement(elem), err
+}
+
+// Lookup searches the document, potentially recursively, for the given key. It behaves like Raw.Lookup, except that
+// keys that descend into an array are interpreted as the position of an element in the array.
+func (ir *IndexedRaw) Lookup(key ...string) RawValue {
+	return convertFromCoreValue(ir.doc.Lookup(key...))
+}
+
+// LookupErr searches the document and potentially subdocuments or arrays for the provided key. Each key provided to
+// this method represents a layer of depth.
+func (ir *IndexedRaw) LookupErr(key ...string) (RawValue, error) {
+	val, err := ir.doc.LookupErr(key...)
+	return convertFromCoreValue(val), err
+}
+
+// LookupIndexed returns the view of the embedded document or array at the given key path. Lookups on the returned
+// view share the index of ir.
+func (ir *IndexedRaw) LookupIndexed(key ...string) (*IndexedRaw, error) {
+	doc, err := ir.doc.LookupIndexed(key...)
+	if err != nil {
+		return nil, err
+	}
+	return &IndexedRaw{doc: doc}, nil
+}
+
+// Find calls fn with each value that matches path, in document order, until fn returns false. The path is a dot
+// separated list of keys in which "*" matches every element of a document or array, like "items.*.sku". See
+// bsoncore.IndexedDocument.Find for details.
+func (ir *IndexedRaw) Find(path string, fn func(RawValue) bool) error {
+	return ir.doc.Find(path, func(v bsoncore.Value) bool {
+		return fn(convertFromCoreValue(v))
+	})
+}
+
+// FindAll returns all of the values that match path. See Find for the path syntax.
+func (ir *IndexedRaw) FindAll(path string) ([]RawValue, error) {
+	vals, err := ir.doc.FindAll(path)
+	rvals := make([]RawValue, 0, len(vals))
+	for _, val := range vals {
+		rvals = append(rvals, convertFromCoreValue(val))
+	}
+	return rvals, err
+}
@@ -0,0 +1,53 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+func TestIndexedRaw(t *testing.T) {
+	doc, err := Marshal(D{
+		{"orderId", int32(7)},
+		{"items", A{D{{"sku", "a"}, {"qty", int32(1)}}, D{{"sku", "b"}, {"qty", int32(2)}}}},
+	})
+	require.NoError(t, err, "Marshal error")
+
+	ir := NewIndexedRaw(doc)
+	require.Equal(t, Raw(doc), ir.Raw(), "Raw mismatch")
+
+	n, err := ir.Len()
+	require.NoError(t, err, "Len error")
+	require.Equal(t, 2, n, "Len mismatch")
+	require.Equal(t, Raw(doc).Index(1), ir.Index(1), "Index mismatch")
+
+	require.Equal(t, Raw(doc).Lookup("orderId"), ir.Lookup("orderId"), "Lookup mismatch")
+	require.Equal(t, Raw(doc).Lookup("items", "1", "qty"), ir.Lookup("items", "1", "qty"), "Lookup mismatch")
+	_, err = ir.LookupErr("missing")
+	require.Equal(t, bsoncore.ErrElementNotFound, err, "LookupErr error mismatch")
+
+	skus, err := ir.FindAll("items.*.sku")
+	require.NoError(t, err, "FindAll error")
+	require.Equal(t, 2, len(skus), "expected 2 values, got %v", skus)
+	require.Equal(t, "a", skus[0].StringValue(), "first sku mismatch")
+	require.Equal(t, "b", skus[1].StringValue(), "second sku mismatch")
+
+	var total int32
+	err = ir.Find("items.*.qty", func(v RawValue) bool {
+		total += v.Int32()
+		return true
+	})
+	require.NoError(t, err, "Find error")
+	require.Equal(t, int32(3), total, "qty total mismatch")
+
+	item, err := ir.LookupIndexed("items", "0")
+	require.NoError(t, err, "LookupIndexed error")
+	require.Equal(t, "a", item.Lookup("sku").StringValue(), "nested Lookup mismatch")
+}
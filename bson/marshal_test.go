@@ -8,6 +8,8 @@ package bson
 
 import (
 	"bytes"
+	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -207,3 +209,44 @@ func TestMarshal_roundtripFromDoc(t *testing.T) {
 		t.Errorf("Documents to not match. got %v; want %v", after, before)
 	}
 }
+
+func TestMarshalErrorPath(t *testing.T) {
+	type lineItem struct {
+		Qty interface{} `bson:"qty"`
+	}
+	type order struct {
+		LineItems []lineItem `bson:"lineItems"`
+	}
+	type customer struct {
+		Orders []order `bson:"orders"`
+	}
+
+	val := customer{Orders: []order{{}, {}, {}, {LineItems: []lineItem{{Qty: make(chan int)}}}}}
+	_, err := Marshal(val)
+	ee, ok := err.(*bsoncodec.EncodeError)
+	require.True(t, ok, "expected error of type %T, got %v", &bsoncodec.EncodeError{}, err)
+	require.Equal(t, "orders.3.lineItems.0.qty", ee.Path(), "path mismatch")
+	require.Equal(t, reflect.TypeOf(make(chan int)), ee.GoType(), "Go type mismatch")
+	require.Equal(t, bsoncodec.ErrNoEncoder{Type: ee.GoType()}, ee.Unwrap(), "wrapped error mismatch")
+
+	var noEncoder bsoncodec.ErrNoEncoder
+	require.True(t, errors.As(err, &noEncoder), "expected %v to wrap a %T", err, bsoncodec.ErrNoEncoder{})
+}
+
+func TestMarshalHookErrorPath(t *testing.T) {
+	type inner struct {
+		M testMarshaler `bson:"m"`
+	}
+	type outer struct {
+		Inner []inner `bson:"inner"`
+	}
+
+	hookErr := errors.New("MarshalBSON error")
+	_, err := Marshal(outer{Inner: []inner{{M: testMarshaler{err: hookErr}}}})
+	require.True(t, errors.Is(err, hookErr), "expected %v to wrap %v", err, hookErr)
+
+	var ee *bsoncodec.EncodeError
+	require.True(t, errors.As(err, &ee), "expected error of type %T, got %v", &bsoncodec.EncodeError{}, err)
+	require.Equal(t, "inner.0.m", ee.Path(), "path mismatch")
+	require.Equal(t, reflect.TypeOf(testMarshaler{}), ee.GoType(), "Go type mismatch")
+}
@@ -951,7 +951,7 @@ func TestUnmarshalSetterErrors(t *testing.T) {
 
 	// It's not possible to generate the actual expected error here because it's an *UnmarshalError, which is defined
 	// in bsoncodec and only contains unexported fields.
-	expectedErr := errors.New("error decoding key def: BOOM")
+	expectedErr := errors.New("error decoding key def (BSON string into Go *mgocompat.setterType): BOOM")
 	assert.Equal(t, expectedErr.Error(), err.Error(), "expected UnmarshalWithRegistry error %v, got %v", expectedErr, err)
 
 	assert.NotNil(t, m["abc"], "expected value not to be nil")
@@ -1032,7 +1032,10 @@ func TestGetterErrors(t *testing.T) {
 	obj1 := &docWithGetterField{}
 	obj1.Field = &typeWithGetter{sampleItems[0].obj, e}
 	data, err := bson.MarshalWithRegistry(Registry, obj1)
-	assert.Equal(t, e, err, "expected error: %v, got: %v", e, err)
+	encodeErr, ok := err.(*bsoncodec.EncodeError)
+	assert.True(t, ok, "expected error of type %T, got %T", &bsoncodec.EncodeError{}, err)
+	assert.Equal(t, "_", encodeErr.Path(), "expected error path _, got %v", encodeErr.Path())
+	assert.Equal(t, e, encodeErr.Unwrap(), "expected error: %v, got: %v", e, encodeErr.Unwrap())
 	assert.Nil(t, data, "expected nil data, got: %v", data)
 
 	obj2 := &typeWithGetter{sampleItems[0].obj, e}
@@ -10,8 +10,10 @@
 package primitive
 
 import (
+	"database/sql/driver"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"regexp"
 	"strconv"
@@ -374,3 +376,543 @@ func bigIntAbsValue(b *big.Int) *big.Int {
 	}
 	return new(big.Int).Abs(b)
 }
+
+// These errors are returned when a Decimal128 cannot be converted to a value of another type.
+var (
+	ErrConvertNaN      = errors.New("cannot convert a NaN decimal128 value")
+	ErrConvertRange    = errors.New("decimal128 value is out of range for the target type")
+	ErrConvertFraction = errors.New("decimal128 value has a fractional part")
+)
+
+// decimal128Digits is the maximum number of significant digits in a Decimal128 value.
+const decimal128Digits = 34
+
+// RoundingMode determines how a Decimal128 value is rounded when it cannot be represented exactly.
+type RoundingMode uint8
+
+// These constants are the supported rounding modes.
+const (
+	// RoundHalfEven rounds to the nearest value and ties to the value with an even last digit. This is the default
+	// rounding mode of IEEE 754-2008 and the one used by the arithmetic methods of Decimal128.
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value and ties away from zero.
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest value and ties toward zero.
+	RoundHalfDown
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundDown rounds toward zero.
+	RoundDown
+	// RoundCeiling rounds toward positive infinity.
+	RoundCeiling
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+)
+
+// NewDecimal128FromInt64 creates a Decimal128 with the value of i.
+func NewDecimal128FromInt64(i int64) Decimal128 {
+	coef := big.NewInt(i)
+	return roundDecimal128(i < 0, coef.Abs(coef), 0, RoundHalfEven)
+}
+
+// NewDecimal128FromFloat64 creates a Decimal128 from the shortest decimal representation of f that converts back to
+// f, so 0.1 is converted to 0.1 rather than to the exact value of the binary floating point number.
+func NewDecimal128FromFloat64(f float64) Decimal128 {
+	switch {
+	case math.IsNaN(f):
+		return dNaN
+	case math.IsInf(f, 1):
+		return dPosInf
+	case math.IsInf(f, -1):
+		return dNegInf
+	}
+	return parseRoundedDecimal128(strconv.FormatFloat(f, 'e', -1, 64))
+}
+
+// NewDecimal128FromBigFloat creates a Decimal128 from the shortest decimal representation of f that converts back to
+// f at its precision. The value is rounded to 34 significant digits using RoundHalfEven if needed.
+func NewDecimal128FromBigFloat(f *big.Float) Decimal128 {
+	if f.IsInf() {
+		if f.Signbit() {
+			return dNegInf
+		}
+		return dPosInf
+	}
+	return parseRoundedDecimal128(f.Text('e', -1))
+}
+
+// parseRoundedDecimal128 parses a number in the format produced by strconv.FormatFloat with the 'e' format and rounds
+// it to a Decimal128.
+func parseRoundedDecimal128(s string) Decimal128 {
+	neg := s[0] == '-'
+	if neg || s[0] == '+' {
+		s = s[1:]
+	}
+
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp, _ = strconv.Atoi(s[i+1:])
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		exp -= len(s) - i - 1
+		s = s[:i] + s[i+1:]
+	}
+
+	coef, _ := new(big.Int).SetString(s, 10)
+	return roundDecimal128(neg, coef, exp, RoundHalfEven)
+}
+
+// Float64 returns the float64 value nearest to d. Values that are too large for a float64 are converted to an
+// infinity.
+func (d Decimal128) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// Int64 returns the value of d as an int64. It returns ErrConvertFraction if d is not an integer, ErrConvertRange if
+// d is an infinity or too large for an int64, and ErrConvertNaN if d is NaN.
+func (d Decimal128) Int64() (int64, error) {
+	if d.IsNaN() {
+		return 0, ErrConvertNaN
+	}
+	if d.IsInf() != 0 {
+		return 0, ErrConvertRange
+	}
+
+	neg, coef, exp := d.unpack()
+	if coef.Sign() == 0 {
+		return 0, nil
+	}
+	if exp < 0 {
+		var r big.Int
+		coef.QuoRem(coef, pow10(-exp), &r)
+		if r.Sign() != 0 {
+			return 0, ErrConvertFraction
+		}
+	} else {
+		// Any non-zero value with more than 19 digits is too large for an int64.
+		if exp > 19 {
+			return 0, ErrConvertRange
+		}
+		coef.Mul(coef, pow10(exp))
+	}
+
+	if neg {
+		coef.Neg(coef)
+	}
+	if !coef.IsInt64() {
+		return 0, ErrConvertRange
+	}
+	return coef.Int64(), nil
+}
+
+// BigFloat returns the value of d as a *big.Float with a precision of 128 bits, which is enough for the 34
+// significant digits of a Decimal128 to be converted back without loss. It returns ErrConvertNaN if d is NaN.
+func (d Decimal128) BigFloat() (*big.Float, error) {
+	switch {
+	case d.IsNaN():
+		return nil, ErrConvertNaN
+	case d.IsInf() != 0:
+		return new(big.Float).SetInf(d.IsInf() < 0), nil
+	}
+
+	f, _, err := big.ParseFloat(d.String(), 10, 128, big.ToNearestEven)
+	return f, err
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. The text is the same as the result of String.
+func (d Decimal128) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The text is parsed with ParseDecimal128.
+func (d *Decimal128) UnmarshalText(text []byte) error {
+	parsed, err := ParseDecimal128(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value implements the driver.Valuer interface. The value is the string representation of d, which databases can
+// convert to their decimal types without loss.
+func (d Decimal128) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements the sql.Scanner interface. The source can be a string or []byte, which is parsed with
+// ParseDecimal128, an int64, or a float64.
+func (d *Decimal128) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	case int64:
+		*d = NewDecimal128FromInt64(v)
+	case float64:
+		*d = NewDecimal128FromFloat64(v)
+	case nil:
+		return errors.New("cannot scan a NULL value into a Decimal128")
+	default:
+		return fmt.Errorf("cannot scan a value of type %T into a Decimal128", src)
+	}
+	return nil
+}
+
+// Neg returns d with the opposite sign.
+func (d Decimal128) Neg() Decimal128 {
+	return Decimal128{h: d.h ^ 1<<63, l: d.l}
+}
+
+// Abs returns the absolute value of d.
+func (d Decimal128) Abs() Decimal128 {
+	return Decimal128{h: d.h &^ (1 << 63), l: d.l}
+}
+
+// Cmp compares d and y and returns -1 if d < y, 0 if d == y, and +1 if d > y. Values that are numerically equal, such
+// as 1.0 and 1.00 or 0 and -0, compare as equal. Because NaN is not ordered, NaN values compare as equal to each other
+// and less than all other values.
+func (d Decimal128) Cmp(y Decimal128) int {
+	switch {
+	case d.IsNaN() && y.IsNaN():
+		return 0
+	case d.IsNaN():
+		return -1
+	case y.IsNaN():
+		return 1
+	case d.IsInf() != 0 || y.IsInf() != 0:
+		return compareInts(d.IsInf(), y.IsInf())
+	}
+
+	xneg, x, xexp := d.unpack()
+	yneg, y2, yexp := y.unpack()
+	xs, ys := x.Sign(), y2.Sign()
+	if xneg {
+		xs = -xs
+	}
+	if yneg {
+		ys = -ys
+	}
+	if xs != ys || xs == 0 {
+		return compareInts(xs, ys)
+	}
+
+	alignExponents(x, xexp, y2, yexp)
+	if xneg {
+		return y2.Cmp(x)
+	}
+	return x.Cmp(y2)
+}
+
+// Add returns the sum d+y rounded to 34 significant digits using RoundHalfEven.
+func (d Decimal128) Add(y Decimal128) Decimal128 {
+	switch {
+	case d.IsNaN() || y.IsNaN():
+		return dNaN
+	case d.IsInf() != 0 && y.IsInf() != 0 && d.IsInf() != y.IsInf():
+		return dNaN
+	case d.IsInf() != 0:
+		return d
+	case y.IsInf() != 0:
+		return y
+	}
+
+	xneg, x, xexp := d.unpack()
+	yneg, y2, yexp := y.unpack()
+	exp := alignExponents(x, xexp, y2, yexp)
+	if xneg {
+		x.Neg(x)
+	}
+	if yneg {
+		y2.Neg(y2)
+	}
+	sum := x.Add(x, y2)
+
+	// The sum of values with opposite signs that is exactly zero is positive.
+	neg := sum.Sign() < 0 || sum.Sign() == 0 && xneg && yneg
+	return roundDecimal128(neg, sum.Abs(sum), exp, RoundHalfEven)
+}
+
+// Sub returns the difference d-y rounded to 34 significant digits using RoundHalfEven.
+func (d Decimal128) Sub(y Decimal128) Decimal128 {
+	return d.Add(y.Neg())
+}
+
+// Mul returns the product d*y rounded to 34 significant digits using RoundHalfEven.
+func (d Decimal128) Mul(y Decimal128) Decimal128 {
+	neg := d.signbit() != y.signbit()
+	switch {
+	case d.IsNaN() || y.IsNaN():
+		return dNaN
+	case d.IsInf() != 0 || y.IsInf() != 0:
+		if d.isZero() || y.isZero() {
+			return dNaN
+		}
+		return infinity(neg)
+	}
+
+	_, x, xexp := d.unpack()
+	_, y2, yexp := y.unpack()
+	return roundDecimal128(neg, x.Mul(x, y2), xexp+yexp, RoundHalfEven)
+}
+
+// Quo returns the quotient d/y rounded to 34 significant digits using RoundHalfEven. Dividing a non-zero value by
+// zero returns an infinity and dividing zero by zero returns NaN.
+func (d Decimal128) Quo(y Decimal128) Decimal128 {
+	neg := d.signbit() != y.signbit()
+	switch {
+	case d.IsNaN() || y.IsNaN():
+		return dNaN
+	case d.IsInf() != 0 && y.IsInf() != 0:
+		return dNaN
+	case d.IsInf() != 0:
+		return infinity(neg)
+	case y.IsInf() != 0:
+		return roundDecimal128(neg, new(big.Int), MinDecimal128Exp, RoundHalfEven)
+	case y.isZero():
+		if d.isZero() {
+			return dNaN
+		}
+		return infinity(neg)
+	}
+
+	_, x, xexp := d.unpack()
+	_, y2, yexp := y.unpack()
+	idealExp := xexp - yexp
+
+	// Scale the dividend so that the quotient has more digits than a Decimal128 can hold. If the division is not exact,
+	// a final 1 digit is appended to the quotient so that it is rounded correctly.
+	shift := decimal128Digits + 1 + numDigits(y2) - numDigits(x)
+	if shift < 0 || x.Sign() == 0 {
+		shift = 0
+	}
+	x.Mul(x, pow10(shift))
+	exp := idealExp - shift
+
+	var r big.Int
+	q, _ := x.QuoRem(x, y2, &r)
+	if r.Sign() != 0 {
+		q.Mul(q, ten)
+		q.Add(q, big.NewInt(1))
+		exp--
+	} else {
+		// The result of an exact division has the exponent closest to the ideal exponent.
+		for exp < idealExp {
+			var digit big.Int
+			q2, _ := new(big.Int).QuoRem(q, ten, &digit)
+			if digit.Sign() != 0 {
+				break
+			}
+			q = q2
+			exp++
+		}
+	}
+	return roundDecimal128(neg, q, exp, RoundHalfEven)
+}
+
+// Round returns d rounded to at most scale digits after the decimal point using the rounding mode. A negative scale
+// rounds to a multiple of a power of ten, such as to the nearest hundred for a scale of -2. Values that already have at
+// most scale digits after the decimal point, NaN, and infinities are returned unchanged.
+func (d Decimal128) Round(scale int, mode RoundingMode) Decimal128 {
+	if d.IsNaN() || d.IsInf() != 0 {
+		return d
+	}
+
+	neg, coef, exp := d.unpack()
+	if exp >= -scale {
+		return d
+	}
+	coef = quoRound(coef, pow10(-scale-exp), neg, mode)
+	return roundDecimal128(neg, coef, -scale, mode)
+}
+
+// Quantize returns d rounded using the rounding mode so that it has the same exponent as q, as specified by IEEE
+// 754-2008. For example, quantizing 1.2345 with 0.01 returns 1.23 and quantizing 2 with 0.01 returns 2.00. The result
+// is NaN if it would have more than 34 significant digits, or if only one of d and q is an infinity.
+func (d Decimal128) Quantize(q Decimal128, mode RoundingMode) Decimal128 {
+	switch {
+	case d.IsNaN() || q.IsNaN():
+		return dNaN
+	case d.IsInf() != 0 && q.IsInf() != 0:
+		return d
+	case d.IsInf() != 0 || q.IsInf() != 0:
+		return dNaN
+	}
+
+	neg, coef, exp := d.unpack()
+	_, _, qexp := q.unpack()
+	if exp < qexp {
+		coef = quoRound(coef, pow10(qexp-exp), neg, mode)
+	} else {
+		coef.Mul(coef, pow10(exp-qexp))
+	}
+	if numDigits(coef) > decimal128Digits {
+		return dNaN
+	}
+	return roundDecimal128(neg, coef, qexp, mode)
+}
+
+// unpack returns the sign, the non-negative coefficient, and the exponent of a finite value.
+func (d Decimal128) unpack() (neg bool, coef *big.Int, exp int) {
+	high, low := d.h, d.l
+	neg = high>>63&1 == 1
+
+	if high>>61&3 == 3 {
+		// Spec says all of these values are out of range, so they are treated as zero.
+		exp = int(high >> 47 & (1<<14 - 1))
+		high, low = 0, 0
+	} else {
+		exp = int(high >> 49 & (1<<14 - 1))
+		high &= 1<<49 - 1
+	}
+
+	coef = new(big.Int).Lsh(new(big.Int).SetUint64(high), 64)
+	coef.Or(coef, new(big.Int).SetUint64(low))
+	return neg, coef, exp + MinDecimal128Exp
+}
+
+func (d Decimal128) signbit() bool {
+	return d.h>>63&1 == 1
+}
+
+func (d Decimal128) isZero() bool {
+	if d.IsNaN() || d.IsInf() != 0 {
+		return false
+	}
+	_, coef, _ := d.unpack()
+	return coef.Sign() == 0
+}
+
+func infinity(neg bool) Decimal128 {
+	if neg {
+		return dNegInf
+	}
+	return dPosInf
+}
+
+// roundDecimal128 rounds the value with the sign, non-negative coefficient, and exponent to 34 significant digits and
+// the range of exponents of a Decimal128 using the rounding mode. Values that are too large are rounded to an
+// infinity or to the largest finite value, depending on the rounding mode, and values that are too small are rounded
+// to subnormal values or zero.
+func roundDecimal128(neg bool, coef *big.Int, exp int, mode RoundingMode) Decimal128 {
+	// The coefficient is rounded once to the target exponent, which is the larger of the exponent that leaves 34 digits
+	// and the smallest exponent. Rounding to 34 digits first and then to a subnormal value could round twice.
+	target := exp
+	if n := numDigits(coef) - decimal128Digits; n > 0 {
+		target = exp + n
+	}
+	if target < MinDecimal128Exp {
+		target = MinDecimal128Exp
+	}
+	if target > exp {
+		coef = quoRound(coef, pow10(target-exp), neg, mode)
+		exp = target
+		// Rounding up can add a digit, as with 9999...9.
+		if numDigits(coef) > decimal128Digits {
+			coef.Quo(coef, ten)
+			exp++
+		}
+	}
+	if exp > MaxDecimal128Exp {
+		if coef.Sign() == 0 {
+			exp = MaxDecimal128Exp
+		}
+		// Add trailing zeros to the coefficient if there is room for them.
+		for exp > MaxDecimal128Exp && numDigits(coef) < decimal128Digits {
+			coef.Mul(coef, ten)
+			exp--
+		}
+		if exp > MaxDecimal128Exp {
+			if mode == RoundDown || mode == RoundCeiling && neg || mode == RoundFloor && !neg {
+				coef, exp = new(big.Int).Set(maxS), MaxDecimal128Exp
+			} else {
+				return infinity(neg)
+			}
+		}
+	}
+
+	var h, l uint64
+	if words := new(big.Int).Rsh(coef, 64); words.Sign() != 0 {
+		h = words.Uint64()
+	}
+	l = new(big.Int).And(coef, new(big.Int).SetUint64(math.MaxUint64)).Uint64()
+
+	h |= uint64(exp-MinDecimal128Exp) & uint64(1<<14-1) << 49
+	if neg {
+		h |= 1 << 63
+	}
+	return Decimal128{h: h, l: l}
+}
+
+// quoRound returns num/den rounded to an integer using the rounding mode. The sign of the value being rounded is neg
+// and num and den are non-negative.
+func quoRound(num, den *big.Int, neg bool, mode RoundingMode) *big.Int {
+	var r big.Int
+	q, _ := new(big.Int).QuoRem(num, den, &r)
+	if r.Sign() == 0 {
+		return q
+	}
+
+	half := r.Lsh(&r, 1).Cmp(den) // compares the remainder with half of the divisor
+	var up bool
+	switch mode {
+	case RoundHalfEven:
+		up = half > 0 || half == 0 && q.Bit(0) == 1
+	case RoundHalfUp:
+		up = half >= 0
+	case RoundHalfDown:
+		up = half > 0
+	case RoundUp:
+		up = true
+	case RoundCeiling:
+		up = !neg
+	case RoundFloor:
+		up = neg
+	}
+	if up {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
+
+// alignExponents scales the coefficients x and y so that both have the smaller of their exponents and returns it.
+func alignExponents(x *big.Int, xexp int, y *big.Int, yexp int) int {
+	switch {
+	case xexp > yexp:
+		x.Mul(x, pow10(xexp-yexp))
+		return yexp
+	case yexp > xexp:
+		y.Mul(y, pow10(yexp-xexp))
+	}
+	return xexp
+}
+
+// numDigits returns the number of decimal digits in the non-negative integer x, which is 0 for zero.
+func numDigits(x *big.Int) int {
+	if x.Sign() == 0 {
+		return 0
+	}
+	// The estimate from the bit length is exact or one too large.
+	n := int(float64(x.BitLen())*math.Log10(2)) + 1
+	if x.Cmp(pow10(n-1)) < 0 {
+		n--
+	}
+	return n
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(ten, big.NewInt(int64(n)), nil)
+}
+
+func compareInts(x, y int) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
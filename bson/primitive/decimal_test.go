@@ -1,9 +1,15 @@
 package primitive
 
 import (
-	"github.com/stretchr/testify/require"
+	"database/sql"
+	"database/sql/driver"
+	"math"
 	"math/big"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 type bigIntTestCase struct {
@@ -144,3 +150,280 @@ func TestParseDecimal128(t *testing.T) {
 		}
 	}
 }
+
+func mustParseDecimal128(t *testing.T, s string) Decimal128 {
+	d, err := ParseDecimal128(s)
+	require.NoError(t, err, "case %s", s)
+	return d
+}
+
+func TestDecimal128_Arithmetic(t *testing.T) {
+	cases := []struct {
+		op       string
+		x, y     string
+		expected string
+	}{
+		{"add", "1.10", "2.205", "3.305"},
+		{"add", "1E+2", "1", "101"},
+		{"add", "-5", "5", "0"},
+		{"add", "-0", "-0", "-0"},
+		{"add", "9999999999999999999999999999999999", "1", "1.000000000000000000000000000000000E+34"},
+		{"add", "1", "1E-40", "1.000000000000000000000000000000000"},
+		{"add", "Infinity", "-Infinity", "NaN"},
+		{"add", "Infinity", "1", "Infinity"},
+		{"add", "NaN", "1", "NaN"},
+		{"sub", "0.3", "0.1", "0.2"},
+		{"sub", "1", "1.00", "0.00"},
+		{"sub", "-Infinity", "-Infinity", "NaN"},
+		{"mul", "1.5", "2.50", "3.750"},
+		{"mul", "-2", "0", "-0"},
+		{"mul", "9E+6144", "10", "Infinity"},
+		{"mul", "1E-6176", "0.1", "0E-6176"},
+		{"mul", "Infinity", "0", "NaN"},
+		{"mul", "-Infinity", "2", "-Infinity"},
+		{"quo", "1", "3", "0.3333333333333333333333333333333333"},
+		{"quo", "2", "3", "0.6666666666666666666666666666666667"},
+		{"quo", "1", "8", "0.125"},
+		{"quo", "100", "4", "25"},
+		{"quo", "1.00", "4", "0.25"},
+		{"quo", "12", "12", "1"},
+		{"quo", "2.400", "2", "1.200"},
+		{"quo", "0", "5", "0"},
+		{"quo", "1", "0", "Infinity"},
+		{"quo", "-1", "0", "-Infinity"},
+		{"quo", "0", "0", "NaN"},
+		{"quo", "1", "Infinity", "0E-6176"},
+		{"quo", "Infinity", "Infinity", "NaN"},
+	}
+	for _, c := range cases {
+		x, y := mustParseDecimal128(t, c.x), mustParseDecimal128(t, c.y)
+		var got Decimal128
+		switch c.op {
+		case "add":
+			got = x.Add(y)
+		case "sub":
+			got = x.Sub(y)
+		case "mul":
+			got = x.Mul(y)
+		case "quo":
+			got = x.Quo(y)
+		}
+		require.Equal(t, c.expected, got.String(), "case %s %s %s", c.x, c.op, c.y)
+	}
+}
+
+func TestDecimal128_Cmp(t *testing.T) {
+	cases := []struct {
+		x, y     string
+		expected int
+	}{
+		{"1.0", "1.00", 0},
+		{"0", "-0", 0},
+		{"1", "2", -1},
+		{"-1", "-2", 1},
+		{"-1", "0", -1},
+		{"1E+3", "999.9", 1},
+		{"1E-6176", "0", 1},
+		{"Infinity", "9E+6111", 1},
+		{"-Infinity", "-Infinity", 0},
+		{"NaN", "-Infinity", -1},
+		{"NaN", "NaN", 0},
+	}
+	for _, c := range cases {
+		x, y := mustParseDecimal128(t, c.x), mustParseDecimal128(t, c.y)
+		require.Equal(t, c.expected, x.Cmp(y), "case %s cmp %s", c.x, c.y)
+		require.Equal(t, -c.expected, y.Cmp(x), "case %s cmp %s", c.y, c.x)
+	}
+
+	d := mustParseDecimal128(t, "-1.5")
+	require.Equal(t, "1.5", d.Neg().String())
+	require.Equal(t, "1.5", d.Abs().String())
+	require.Equal(t, "-1.5", d.Abs().Neg().String())
+}
+
+func TestDecimal128_Round(t *testing.T) {
+	modes := []RoundingMode{RoundHalfEven, RoundHalfUp, RoundHalfDown, RoundUp, RoundDown, RoundCeiling, RoundFloor}
+	cases := []struct {
+		value    string
+		expected []string // the result for each of the modes
+	}{
+		{"2.5", []string{"2", "3", "2", "3", "2", "3", "2"}},
+		{"3.5", []string{"4", "4", "3", "4", "3", "4", "3"}},
+		{"-2.5", []string{"-2", "-3", "-2", "-3", "-2", "-2", "-3"}},
+		{"2.51", []string{"3", "3", "3", "3", "2", "3", "2"}},
+		{"-2.49", []string{"-2", "-2", "-2", "-3", "-2", "-2", "-3"}},
+		{"-0.4", []string{"-0", "-0", "-0", "-1", "-0", "-0", "-1"}},
+	}
+	for _, c := range cases {
+		d := mustParseDecimal128(t, c.value)
+		for i, mode := range modes {
+			require.Equal(t, c.expected[i], d.Round(0, mode).String(), "case %s mode %d", c.value, mode)
+		}
+	}
+
+	require.Equal(t, "1.23", mustParseDecimal128(t, "1.2345").Round(2, RoundHalfEven).String())
+	require.Equal(t, "1.2", mustParseDecimal128(t, "1.2").Round(2, RoundHalfEven).String())
+	require.Equal(t, "1.2E+3", mustParseDecimal128(t, "1234").Round(-2, RoundHalfEven).String())
+	require.Equal(t, "Infinity", dPosInf.Round(2, RoundHalfEven).String())
+}
+
+func TestDecimal128_Quantize(t *testing.T) {
+	cases := []struct {
+		value, exp string
+		mode       RoundingMode
+		expected   string
+	}{
+		{"1.2345", "0.01", RoundHalfEven, "1.23"},
+		{"1.235", "0.01", RoundHalfEven, "1.24"},
+		{"1.225", "0.01", RoundHalfEven, "1.22"},
+		{"1.225", "0.01", RoundHalfUp, "1.23"},
+		{"-1.229", "0.01", RoundDown, "-1.22"},
+		{"2", "0.01", RoundHalfEven, "2.00"},
+		{"-0", "0.001", RoundHalfEven, "-0.000"},
+		{"1E+3", "1", RoundHalfEven, "1000"},
+		{"1", "1E-34", RoundHalfEven, "NaN"},
+		{"Infinity", "1", RoundHalfEven, "NaN"},
+		{"Infinity", "-Infinity", RoundHalfEven, "Infinity"},
+	}
+	for _, c := range cases {
+		d, q := mustParseDecimal128(t, c.value), mustParseDecimal128(t, c.exp)
+		require.Equal(t, c.expected, d.Quantize(q, c.mode).String(), "case %s quantize %s", c.value, c.exp)
+	}
+}
+
+func TestDecimal128_Overflow(t *testing.T) {
+	max := mustParseDecimal128(t, "9.999999999999999999999999999999999E+6144")
+	one := mustParseDecimal128(t, "1E+6111")
+
+	require.Equal(t, "Infinity", max.Add(one).String())
+	require.Equal(t, "-Infinity", max.Neg().Sub(one).String())
+
+	// Modes that round toward zero return the largest finite value instead of an infinity.
+	coef, _ := new(big.Int).SetString("99999999999999999999999999999999990", 10)
+	require.Equal(t, max, roundDecimal128(false, coef, MaxDecimal128Exp, RoundDown))
+	require.Equal(t, max, roundDecimal128(false, coef, MaxDecimal128Exp, RoundFloor))
+	require.Equal(t, dNegInf, roundDecimal128(true, coef, MaxDecimal128Exp, RoundFloor))
+}
+
+func TestDecimal128_SubnormalRounding(t *testing.T) {
+	// The coefficient has 36 digits and must be rounded by 3 digits to reach the smallest exponent. Rounding to 34
+	// digits first would round 496 to 5 and then round the tie up, but the value is rounded down.
+	coef, _ := new(big.Int).SetString("1"+strings.Repeat("0", 31)+"1496", 10)
+	expectedCoef, _ := new(big.Int).SetString("1"+strings.Repeat("0", 31)+"1", 10)
+	expected, ok := ParseDecimal128FromBigInt(expectedCoef, MinDecimal128Exp)
+	require.True(t, ok)
+
+	for _, mode := range []RoundingMode{RoundHalfEven, RoundHalfUp} {
+		require.Equal(t, expected, roundDecimal128(false, coef, MinDecimal128Exp-3, mode), "mode %d", mode)
+	}
+}
+
+func TestDecimal128_Conversions(t *testing.T) {
+	t.Run("int64", func(t *testing.T) {
+		for _, i := range []int64{0, 1, -1, 1234567890, math.MaxInt64, math.MinInt64} {
+			d := NewDecimal128FromInt64(i)
+			require.Equal(t, strconv.FormatInt(i, 10), d.String())
+			got, err := d.Int64()
+			require.NoError(t, err)
+			require.Equal(t, i, got)
+		}
+
+		cases := []struct {
+			s        string
+			expected int64
+			err      error
+		}{
+			{"1.00", 1, nil},
+			{"-12E+3", -12000, nil},
+			{"0E+6000", 0, nil},
+			{"1.5", 0, ErrConvertFraction},
+			{"9223372036854775808", 0, ErrConvertRange},
+			{"1E+20", 0, ErrConvertRange},
+			{"Infinity", 0, ErrConvertRange},
+			{"NaN", 0, ErrConvertNaN},
+		}
+		for _, c := range cases {
+			got, err := mustParseDecimal128(t, c.s).Int64()
+			require.Equal(t, c.err, err, "case %s", c.s)
+			require.Equal(t, c.expected, got, "case %s", c.s)
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		cases := []struct {
+			f float64
+			s string
+		}{
+			{0.1, "0.1"},
+			{-2.5, "-2.5"},
+			{1e300, "1.000000000000000E+300"},
+			{0, "0"},
+			{math.Inf(1), "Infinity"},
+			{math.Inf(-1), "-Infinity"},
+		}
+		for _, c := range cases {
+			d := NewDecimal128FromFloat64(c.f)
+			require.Equal(t, 0, d.Cmp(mustParseDecimal128(t, c.s)), "case %v, got %v", c.f, d)
+			require.Equal(t, c.f, d.Float64(), "case %v", c.f)
+		}
+		require.True(t, NewDecimal128FromFloat64(math.NaN()).IsNaN())
+		require.True(t, math.IsNaN(dNaN.Float64()))
+		require.True(t, math.IsInf(mustParseDecimal128(t, "1E+400").Float64(), 1))
+	})
+	t.Run("big.Float", func(t *testing.T) {
+		d := mustParseDecimal128(t, "1234567890.123456789012345678901234")
+		f, err := d.BigFloat()
+		require.NoError(t, err)
+		require.Equal(t, d, NewDecimal128FromBigFloat(f))
+
+		f, err = dNegInf.BigFloat()
+		require.NoError(t, err)
+		require.True(t, f.IsInf() && f.Signbit())
+		require.Equal(t, dNegInf, NewDecimal128FromBigFloat(f))
+
+		_, err = dNaN.BigFloat()
+		require.Equal(t, ErrConvertNaN, err)
+
+		// Values with more digits than a Decimal128 can hold are rounded.
+		third := new(big.Float).SetPrec(200).Quo(big.NewFloat(1), big.NewFloat(3))
+		require.Equal(t, "0.3333333333333333333333333333333333", NewDecimal128FromBigFloat(third).String())
+	})
+	t.Run("text", func(t *testing.T) {
+		d := mustParseDecimal128(t, "-12.50")
+		text, err := d.MarshalText()
+		require.NoError(t, err)
+		require.Equal(t, "-12.50", string(text))
+
+		var got Decimal128
+		require.NoError(t, got.UnmarshalText(text))
+		require.Equal(t, d, got)
+		require.Error(t, got.UnmarshalText([]byte("abc")))
+	})
+	t.Run("sql", func(t *testing.T) {
+		var _ driver.Valuer = Decimal128{}
+		var _ sql.Scanner = &Decimal128{}
+
+		d := mustParseDecimal128(t, "19.99")
+		val, err := d.Value()
+		require.NoError(t, err)
+		require.Equal(t, "19.99", val)
+
+		cases := []struct {
+			src      interface{}
+			expected string
+		}{
+			{"19.99", "19.99"},
+			{[]byte("-0.5"), "-0.5"},
+			{int64(42), "42"},
+			{float64(1.25), "1.25"},
+		}
+		for _, c := range cases {
+			var got Decimal128
+			require.NoError(t, got.Scan(c.src), "case %v", c.src)
+			require.Equal(t, c.expected, got.String(), "case %v", c.src)
+		}
+
+		var got Decimal128
+		require.Error(t, got.Scan(nil))
+		require.Error(t, got.Scan(true))
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// RawEditor modifies Raw documents without decoding them into a D and encoding them again. Edits are recorded with
+// the Set, Unset, Append, and Rename methods and applied with Apply, which copies the document in a single pass. This
+// type is a wrapper around a bsoncore.DocumentEditor; see that type for details about how the edits are applied.
+//
+// Keys that descend into an array must be the decimal position of an element in the array. Edits that modify the same
+// key, or a key within a value modified by another edit, conflict and cause Apply to return a
+// bsoncore.EditConflictError.
+type RawEditor struct {
+	de *bsoncore.DocumentEditor
+}
+
+// NewRawEditor returns a RawEditor without any edits.
+func NewRawEditor() *RawEditor {
+	return &RawEditor{de: bsoncore.NewDocumentEditor()}
+}
+
+// Set sets the value at the given key path to val. If the key does not exist, it is added to the end of its document
+// and any missing documents along the path are created.
+func (re *RawEditor) Set(val RawValue, key ...string) *RawEditor {
+	re.de.Set(convertToCoreValue(val), key...)
+	return re
+}
+
+// Unset removes the element at the given key path. Unsetting a key that does not exist has no effect.
+func (re *RawEditor) Unset(key ...string) *RawEditor {
+	re.de.Unset(key...)
+	return re
+}
+
+// Append appends val to the array at the given key path. If the key does not exist, an array containing val is
+// created.
+func (re *RawEditor) Append(val RawValue, key ...string) *RawEditor {
+	re.de.Append(convertToCoreValue(val), key...)
+	return re
+}
+
+// Rename changes the key of the element at the given key path to newKey. If the document already contains newKey,
+// that element is removed. Renaming a key that does not exist has no effect.
+func (re *RawEditor) Rename(newKey string, key ...string) *RawEditor {
+	re.de.Rename(newKey, key...)
+	return re
+}
+
+// Apply returns a copy of r with the edits applied. The document r is not modified.
+func (re *RawEditor) Apply(r Raw) (Raw, error) {
+	doc, err := re.de.Apply(make([]byte, 0, len(r)), bsoncore.Document(r))
+	if err != nil {
+		return nil, err
+	}
+	return Raw(doc), nil
+}
@@ -0,0 +1,60 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+func TestRawEditor(t *testing.T) {
+	rawValue := func(val interface{}) RawValue {
+		typ, data, err := MarshalValue(val)
+		if err != nil {
+			panic(err)
+		}
+		return RawValue{Type: typ, Value: data}
+	}
+	marshal := func(doc D) Raw {
+		b, err := Marshal(doc)
+		require.NoError(t, err, "Marshal error")
+		return b
+	}
+
+	doc := marshal(D{
+		{"_id", int32(1)},
+		{"status", "new"},
+		{"customer", D{{"name", "a"}, {"email", "a@example.com"}}},
+		{"items", A{D{{"sku", "x"}}}},
+	})
+	original := append(Raw(nil), doc...)
+
+	editor := NewRawEditor().
+		Set(rawValue("shipped"), "status").
+		Set(rawValue("b"), "customer", "name").
+		Unset("customer", "email").
+		Append(rawValue(D{{"sku", "y"}}), "items").
+		Rename("orderId", "_id").
+		Set(rawValue(true), "audit", "edited")
+	got, err := editor.Apply(doc)
+	require.NoError(t, err, "Apply error")
+
+	want := marshal(D{
+		{"orderId", int32(1)},
+		{"status", "shipped"},
+		{"customer", D{{"name", "b"}}},
+		{"items", A{D{{"sku", "x"}}, D{{"sku", "y"}}}},
+		{"audit", D{{"edited", true}}},
+	})
+	require.Equal(t, want, got, "expected %v, got %v", want, got)
+	require.Equal(t, original, doc, "expected the original document to be unchanged")
+
+	_, err = NewRawEditor().Unset("status").Set(rawValue(1), "status").Apply(doc)
+	require.Equal(t, bsoncore.EditConflictError{Key: []string{"status"}}, err, "expected conflict error")
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"go.mongodb.org/mongo-driver/bson/bsoncodec"
 	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
 )
 
 func TestUnmarshal(t *testing.T) {
@@ -106,3 +107,39 @@ func TestUnmarshalExtJSONWithContext(t *testing.T) {
 		}
 	})
 }
+
+func TestUnmarshalErrorPath(t *testing.T) {
+	type lineItem struct {
+		Qty int `bson:"qty"`
+	}
+	type order struct {
+		LineItems []lineItem `bson:"lineItems"`
+	}
+	type customer struct {
+		Orders []order `bson:"orders"`
+	}
+
+	orders := A{D{}, D{}, D{}, D{{"lineItems", A{D{{"qty", "three"}}}}}}
+	data, err := Marshal(D{{"orders", orders}})
+	noerr(t, err)
+
+	var got customer
+	err = Unmarshal(data, &got)
+	de, ok := err.(*bsoncodec.DecodeError)
+	if !ok {
+		t.Fatalf("expected error of type %T, got %v", &bsoncodec.DecodeError{}, err)
+	}
+	if path := de.Path(); path != "orders.3.lineItems.0.qty" {
+		t.Errorf("expected path %q, got %q", "orders.3.lineItems.0.qty", path)
+	}
+	if bt := de.BSONType(); bt != bsontype.String {
+		t.Errorf("expected BSON type %v, got %v", bsontype.String, bt)
+	}
+	if gt := de.GoType(); gt != reflect.TypeOf(0) {
+		t.Errorf("expected Go type %v, got %v", reflect.TypeOf(0), gt)
+	}
+	want := "error decoding key orders.3.lineItems.0.qty (BSON string into Go int): " + de.Unwrap().Error()
+	if de.Error() != want {
+		t.Errorf("expected error %q, got %q", want, de.Error())
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncodecgen"
+)
+
+func main() {
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "bsoncodecgen is used to generate BSON codecs for struct types.")
+		fmt.Fprintln(fs.Output(), "usage: bsoncodecgen -type <type names> -output <generated file name> [package directory]")
+		fs.PrintDefaults()
+	}
+	var types, output, register string
+	var dryrun bool
+	fs.StringVar(&types, "type", "", "comma-separated list of struct type names; must be set.")
+	fs.StringVar(&output, "output", "", "name of the generated file, relative to the package directory; defaults to <first type>_bsoncodec.go.")
+	fs.StringVar(&register, "register", "RegisterBSONCodecs", "name of the generated registration function.")
+	fs.BoolVar(&dryrun, "dryrun", false, "prints the output to stdout instead of writing to a file.")
+	err := fs.Parse(os.Args[1:])
+	if err == flag.ErrHelp {
+		fs.Usage()
+		os.Exit(0)
+	}
+	if err != nil {
+		log.Fatalf("Could not parse flags: %v", err)
+	}
+	if types == "" {
+		log.Println("No types specified.")
+		fs.Usage()
+		os.Exit(1)
+	}
+	dir := "."
+	if args := fs.Args(); len(args) > 0 {
+		dir = args[0]
+	}
+	names := strings.Split(types, ",")
+	if output == "" {
+		output = strings.ToLower(names[0]) + "_bsoncodec.go"
+	}
+	if !filepath.IsAbs(output) {
+		output = filepath.Join(dir, output)
+	}
+
+	pkg, err := bsoncodecgen.ParseDir(dir)
+	if err != nil {
+		log.Fatalf("Could not parse package in '%s': %v", dir, err)
+	}
+	structs := make([]*bsoncodecgen.Struct, 0, len(names))
+	for _, name := range names {
+		s, err := pkg.Struct(strings.TrimSpace(name))
+		if err != nil {
+			log.Fatalf("Could not describe type '%s': %v", name, err)
+		}
+		structs = append(structs, s)
+	}
+
+	var b bytes.Buffer
+	err = pkg.Generate(&b, register, structs...)
+	if err != nil {
+		log.Fatalf("Could not generate codecs: %v", err)
+	}
+	if dryrun {
+		os.Stdout.Write(b.Bytes())
+		os.Exit(0)
+	}
+
+	err = ioutil.WriteFile(output, b.Bytes(), 0644)
+	if err != nil {
+		log.Fatalf("Could not write to %s: %v", output, err)
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"context"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/address"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/description"
 )
 
 // CommandStartedEvent represents an event generated when a command is sent to a server.
@@ -62,6 +65,7 @@ const (
 	ConnectionClosed   = "ConnectionClosed"
 	PoolCreated        = "ConnectionPoolCreated"
 	ConnectionCreated  = "ConnectionCreated"
+	GetStarted         = "ConnectionCheckOutStarted"
 	GetFailed          = "ConnectionCheckOutFailed"
 	GetSucceeded       = "ConnectionCheckedOut"
 	ConnectionReturned = "ConnectionCheckedIn"
@@ -83,9 +87,89 @@ type PoolEvent struct {
 	ConnectionID uint64              `json:"connectionId"`
 	PoolOptions  *MonitorPoolOptions `json:"options"`
 	Reason       string              `json:"reason"`
+	// ServiceID is only set if the Type is PoolCleared and the server is deployed behind a load balancer. This field
+	// can be used to distinguish between individual servers in a load balanced deployment.
+	ServiceID *primitive.ObjectID `json:"serviceId"`
 }
 
 // PoolMonitor is a function that allows the user to gain access to events occurring in the pool
 type PoolMonitor struct {
 	Event func(*PoolEvent)
 }
+
+// ServerDescriptionChangedEvent represents a server description change.
+type ServerDescriptionChangedEvent struct {
+	Address             address.Address
+	TopologyID          primitive.ObjectID // A unique identifier for the topology this server is a part of
+	PreviousDescription description.Server
+	NewDescription      description.Server
+}
+
+// ServerOpeningEvent is an event generated when the server is initialized.
+type ServerOpeningEvent struct {
+	Address    address.Address
+	TopologyID primitive.ObjectID // A unique identifier for the topology this server is a part of
+}
+
+// ServerClosedEvent is an event generated when the server is closed.
+type ServerClosedEvent struct {
+	Address    address.Address
+	TopologyID primitive.ObjectID // A unique identifier for the topology this server is a part of
+}
+
+// TopologyDescriptionChangedEvent represents a topology description change.
+type TopologyDescriptionChangedEvent struct {
+	TopologyID          primitive.ObjectID // A unique identifier for the topology
+	PreviousDescription description.Topology
+	NewDescription      description.Topology
+}
+
+// TopologyOpeningEvent is an event generated when the topology is initialized.
+type TopologyOpeningEvent struct {
+	TopologyID primitive.ObjectID // A unique identifier for the topology
+}
+
+// TopologyClosedEvent is an event generated when the topology is closed.
+type TopologyClosedEvent struct {
+	TopologyID primitive.ObjectID // A unique identifier for the topology
+}
+
+// ServerHeartbeatStartedEvent is an event generated when the heartbeat is started.
+type ServerHeartbeatStartedEvent struct {
+	ConnectionID string // The address this heartbeat was sent to with a unique identifier
+	Awaited      bool   // If this heartbeat was awaitable
+}
+
+// ServerHeartbeatSucceededEvent is an event generated when the heartbeat succeeds.
+type ServerHeartbeatSucceededEvent struct {
+	DurationNanos int64
+	Reply         description.Server
+	ConnectionID  string // The address this heartbeat was sent to with a unique identifier
+	Awaited       bool   // If this heartbeat was awaitable
+}
+
+// ServerHeartbeatFailedEvent is an event generated when the heartbeat fails.
+type ServerHeartbeatFailedEvent struct {
+	DurationNanos int64
+	Failure       error
+	ConnectionID  string // The address this heartbeat was sent to with a unique identifier
+	Awaited       bool   // If this heartbeat was awaitable
+}
+
+// ServerMonitor represents a monitor that is triggered for different server and topology events. The client monitors
+// the MongoDB deployment it is connected to, and this monitor reports changes in the client's view of that
+// deployment. The topology represents the deployment as a whole, and heartbeats are sent to each individual server to
+// check its current status. Any of the functions can be nil.
+type ServerMonitor struct {
+	ServerDescriptionChanged func(*ServerDescriptionChangedEvent)
+	ServerOpening            func(*ServerOpeningEvent)
+	ServerClosed             func(*ServerClosedEvent)
+	// TopologyDescriptionChanged is called while the topology is locked, so the callback should not run any operation
+	// that requires server selection on the same client.
+	TopologyDescriptionChanged func(*TopologyDescriptionChangedEvent)
+	TopologyOpening            func(*TopologyOpeningEvent)
+	TopologyClosed             func(*TopologyClosedEvent)
+	ServerHeartbeatStarted     func(*ServerHeartbeatStartedEvent)
+	ServerHeartbeatSucceeded   func(*ServerHeartbeatSucceededEvent)
+	ServerHeartbeatFailed      func(*ServerHeartbeatFailedEvent)
+}
@@ -0,0 +1,123 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package logger
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Level is the severity of a log message. Messages are only logged if their level is less than or equal to the level
+// enabled for their component.
+type Level int
+
+// These constants are the supported log levels.
+const (
+	// LevelOff disables logging for a component.
+	LevelOff Level = iota
+	// LevelInfo enables logging of informational messages.
+	LevelInfo
+	// LevelDebug enables logging of debug messages, which includes all informational messages.
+	LevelDebug
+)
+
+// ParseLevel parses a level name as used in the MONGODB_LOG_* environment variables. The syslog severity names are
+// accepted and mapped to the closest supported level. Unknown names are mapped to LevelOff.
+func ParseLevel(str string) Level {
+	switch strings.ToLower(strings.TrimSpace(str)) {
+	case "emergency", "alert", "critical", "error", "warn", "warning", "notice", "info", "informational":
+		return LevelInfo
+	case "debug", "trace":
+		return LevelDebug
+	default:
+		return LevelOff
+	}
+}
+
+// Component is a part of the driver that produces log messages.
+type Component int
+
+// These constants are the driver components that can be logged.
+const (
+	// ComponentAll enables logging for all components.
+	ComponentAll Component = iota
+	// ComponentCommand logs command started, succeeded, and failed messages.
+	ComponentCommand
+	// ComponentTopology logs SDAM messages, including topology changes and server heartbeats.
+	ComponentTopology
+	// ComponentServerSelection logs server selection decisions.
+	ComponentServerSelection
+	// ComponentConnection logs connection pool lifecycle messages.
+	ComponentConnection
+)
+
+// String implements the fmt.Stringer interface.
+func (c Component) String() string {
+	switch c {
+	case ComponentAll:
+		return "all"
+	case ComponentCommand:
+		return "command"
+	case ComponentTopology:
+		return "topology"
+	case ComponentServerSelection:
+		return "serverSelection"
+	case ComponentConnection:
+		return "connection"
+	default:
+		return "unknown"
+	}
+}
+
+// The names of the environment variables used to configure logging.
+const (
+	EnvAll               = "MONGODB_LOG_ALL"
+	EnvCommand           = "MONGODB_LOG_COMMAND"
+	EnvTopology          = "MONGODB_LOG_TOPOLOGY"
+	EnvServerSelection   = "MONGODB_LOG_SERVER_SELECTION"
+	EnvConnection        = "MONGODB_LOG_CONNECTION"
+	EnvMaxDocumentLength = "MONGODB_LOG_MAX_DOCUMENT_LENGTH"
+	EnvPath              = "MONGODB_LOG_PATH"
+)
+
+var componentEnvVars = map[Component]string{
+	ComponentCommand:         EnvCommand,
+	ComponentTopology:        EnvTopology,
+	ComponentServerSelection: EnvServerSelection,
+	ComponentConnection:      EnvConnection,
+}
+
+// levelsFromEnv returns the component levels configured in the environment. If MONGODB_LOG_ALL is set, it applies to
+// every component and the per-component variables are ignored.
+func levelsFromEnv() map[Component]Level {
+	levels := make(map[Component]Level)
+	if all := os.Getenv(EnvAll); all != "" {
+		level := ParseLevel(all)
+		for component := range componentEnvVars {
+			levels[component] = level
+		}
+		return levels
+	}
+
+	for component, env := range componentEnvVars {
+		if str := os.Getenv(env); str != "" {
+			levels[component] = ParseLevel(str)
+		}
+	}
+	return levels
+}
+
+// maxDocumentLengthFromEnv returns the maximum document length configured in the environment, or 0 if it is unset or
+// invalid.
+func maxDocumentLengthFromEnv() uint {
+	n, err := strconv.ParseUint(os.Getenv(EnvMaxDocumentLength), 10, 32)
+	if err != nil {
+		return 0
+	}
+	return uint(n)
+}
@@ -0,0 +1,74 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// IOSink is a LogSink that writes each message to an io.Writer as a single line of JSON.
+type IOSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+var _ LogSink = (*IOSink)(nil)
+
+// NewIOSink creates an IOSink that writes to w.
+func NewIOSink(w io.Writer) *IOSink {
+	return &IOSink{w: w}
+}
+
+// Info implements the LogSink interface.
+func (s *IOSink) Info(_ int, message string, keysAndValues ...interface{}) {
+	s.write(message, keysAndValues)
+}
+
+// Error implements the LogSink interface.
+func (s *IOSink) Error(err error, message string, keysAndValues ...interface{}) {
+	s.write(message, append(keysAndValues, "error", err))
+}
+
+func (s *IOSink) write(message string, keysAndValues []interface{}) {
+	var buf bytes.Buffer
+	buf.WriteString(`{"t":`)
+	writeJSONValue(&buf, time.Now().UTC().Format(time.RFC3339Nano))
+	buf.WriteString(`,"message":`)
+	writeJSONValue(&buf, message)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		buf.WriteByte(',')
+		writeJSONValue(&buf, fmt.Sprint(keysAndValues[i]))
+		buf.WriteByte(':')
+		if i+1 < len(keysAndValues) {
+			writeJSONValue(&buf, keysAndValues[i+1])
+		} else {
+			buf.WriteString("null")
+		}
+	}
+	buf.WriteString("}\n")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, _ = s.w.Write(buf.Bytes())
+}
+
+// writeJSONValue writes val to buf as JSON. Errors and values that cannot be marshaled are written as strings.
+func writeJSONValue(buf *bytes.Buffer, val interface{}) {
+	if err, ok := val.(error); ok {
+		val = err.Error()
+	}
+	b, err := json.Marshal(val)
+	if err != nil {
+		b, _ = json.Marshal(fmt.Sprint(val))
+	}
+	buf.Write(b)
+}
@@ -0,0 +1,153 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package logger implements structured logging for the driver. Log messages are written to a LogSink, and each driver
+// component can be logged at a different level.
+package logger // import "go.mongodb.org/mongo-driver/internal/logger"
+
+import (
+	"io"
+	"os"
+)
+
+// DefaultMaxDocumentLength is the default maximum length of a stringified document in a log message.
+const DefaultMaxDocumentLength uint = 1000
+
+// TruncationSuffix is appended to documents that are truncated in log messages.
+const TruncationSuffix = "..."
+
+// LogSink is the interface that a log sink must implement. It is compatible with the github.com/go-logr/logr
+// LogSink interface, so logr-based loggers can be used directly.
+type LogSink interface {
+	// Info logs a non-error message with the given key/value pairs. The level is the verbosity of the message, where
+	// higher values are more verbose.
+	Info(level int, message string, keysAndValues ...interface{})
+	// Error logs an error with the given message and key/value pairs.
+	Error(err error, message string, keysAndValues ...interface{})
+}
+
+// Logger writes structured log messages to a LogSink. A nil *Logger is valid and logs nothing.
+type Logger struct {
+	ComponentLevels   map[Component]Level
+	Sink              LogSink
+	MaxDocumentLength uint
+
+	closer io.Closer // closes the default sink's output, if the driver opened it
+}
+
+// New creates a Logger from the given options and the MONGODB_LOG_* environment variables. Options that are set take
+// precedence over the environment. If sink is nil, messages are written to the file named by MONGODB_LOG_PATH, or to
+// standard error if it is unset. If maxDocumentLength is 0, the environment or DefaultMaxDocumentLength is used.
+// New returns nil if logging is disabled for every component.
+func New(sink LogSink, maxDocumentLength uint, componentLevels map[Component]Level) *Logger {
+	levels := levelsFromEnv()
+	if level, ok := componentLevels[ComponentAll]; ok {
+		for component := range componentEnvVars {
+			levels[component] = level
+		}
+	}
+	for component, level := range componentLevels {
+		if component != ComponentAll {
+			levels[component] = level
+		}
+	}
+
+	enabled := false
+	for _, level := range levels {
+		if level > LevelOff {
+			enabled = true
+			break
+		}
+	}
+	if !enabled {
+		return nil
+	}
+
+	if maxDocumentLength == 0 {
+		maxDocumentLength = maxDocumentLengthFromEnv()
+	}
+	if maxDocumentLength == 0 {
+		maxDocumentLength = DefaultMaxDocumentLength
+	}
+
+	l := &Logger{
+		ComponentLevels:   levels,
+		Sink:              sink,
+		MaxDocumentLength: maxDocumentLength,
+	}
+	if l.Sink == nil {
+		w, closer := outputFromEnv()
+		l.Sink = NewIOSink(w)
+		l.closer = closer
+	}
+	return l
+}
+
+// outputFromEnv returns the writer named by MONGODB_LOG_PATH. The returned io.Closer is non-nil if the writer is a
+// file that was opened by this function.
+func outputFromEnv() (io.Writer, io.Closer) {
+	switch path := os.Getenv(EnvPath); path {
+	case "", "stderr":
+		return os.Stderr, nil
+	case "stdout":
+		return os.Stdout, nil
+	default:
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
+		if err != nil {
+			return os.Stderr, nil
+		}
+		return f, f
+	}
+}
+
+// LevelComponentEnabled returns true if messages of the given level should be logged for the given component.
+func (l *Logger) LevelComponentEnabled(level Level, component Component) bool {
+	if l == nil || level == LevelOff {
+		return false
+	}
+	return l.ComponentLevels[component] >= level
+}
+
+// Print logs a message for the given component if the level is enabled. The component name is appended to the
+// key/value pairs.
+func (l *Logger) Print(level Level, component Component, message string, keysAndValues ...interface{}) {
+	if !l.LevelComponentEnabled(level, component) {
+		return
+	}
+	l.Sink.Info(int(level), message, append(keysAndValues, "component", component.String())...)
+}
+
+// Error logs an error for the given component if the level is enabled. The component name is appended to the
+// key/value pairs.
+func (l *Logger) Error(level Level, component Component, err error, message string, keysAndValues ...interface{}) {
+	if !l.LevelComponentEnabled(level, component) {
+		return
+	}
+	l.Sink.Error(err, message, append(keysAndValues, "component", component.String())...)
+}
+
+// Truncate shortens str to the logger's maximum document length and appends TruncationSuffix if it was shortened.
+// Multi-byte characters are never split.
+func (l *Logger) Truncate(str string) string {
+	if l == nil || uint(len(str)) <= l.MaxDocumentLength {
+		return str
+	}
+
+	end := int(l.MaxDocumentLength)
+	// Back up to the start of a UTF-8 sequence so the output remains valid.
+	for end > 0 && str[end]&0xC0 == 0x80 {
+		end--
+	}
+	return str[:end] + TruncationSuffix
+}
+
+// Close releases any resources held by the logger's default sink.
+func (l *Logger) Close() error {
+	if l == nil || l.closer == nil {
+		return nil
+	}
+	return l.closer.Close()
+}
@@ -0,0 +1,144 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+type testSink struct {
+	messages []string
+	kvs      [][]interface{}
+}
+
+func (s *testSink) Info(_ int, message string, keysAndValues ...interface{}) {
+	s.messages = append(s.messages, message)
+	s.kvs = append(s.kvs, keysAndValues)
+}
+
+func (s *testSink) Error(_ error, message string, keysAndValues ...interface{}) {
+	s.Info(0, message, keysAndValues...)
+}
+
+func clearEnv(t *testing.T) {
+	t.Helper()
+	for _, env := range []string{EnvAll, EnvCommand, EnvTopology, EnvServerSelection, EnvConnection,
+		EnvMaxDocumentLength, EnvPath} {
+		assert.Nil(t, os.Unsetenv(env), "Unsetenv error for %s", env)
+	}
+}
+
+func TestLogger(t *testing.T) {
+	t.Run("ParseLevel", func(t *testing.T) {
+		testCases := []struct {
+			str   string
+			level Level
+		}{
+			{"off", LevelOff},
+			{"", LevelOff},
+			{"bogus", LevelOff},
+			{"error", LevelInfo},
+			{"INFO", LevelInfo},
+			{" notice ", LevelInfo},
+			{"debug", LevelDebug},
+			{"trace", LevelDebug},
+		}
+		for _, tc := range testCases {
+			got := ParseLevel(tc.str)
+			assert.Equal(t, tc.level, got, "expected level %v for %q, got %v", tc.level, tc.str, got)
+		}
+	})
+	t.Run("New returns nil when disabled", func(t *testing.T) {
+		clearEnv(t)
+		assert.Nil(t, New(&testSink{}, 0, nil), "expected nil logger")
+
+		var l *Logger
+		assert.False(t, l.LevelComponentEnabled(LevelInfo, ComponentCommand), "expected nil logger to be disabled")
+		l.Print(LevelInfo, ComponentCommand, "message")
+		assert.Nil(t, l.Close(), "Close error on nil logger")
+	})
+	t.Run("environment", func(t *testing.T) {
+		clearEnv(t)
+		defer clearEnv(t)
+		assert.Nil(t, os.Setenv(EnvCommand, "debug"), "Setenv error")
+		assert.Nil(t, os.Setenv(EnvMaxDocumentLength, "10"), "Setenv error")
+
+		l := New(&testSink{}, 0, nil)
+		assert.NotNil(t, l, "expected non-nil logger")
+		assert.True(t, l.LevelComponentEnabled(LevelDebug, ComponentCommand), "expected command debug to be enabled")
+		assert.False(t, l.LevelComponentEnabled(LevelInfo, ComponentTopology), "expected topology to be disabled")
+		assert.Equal(t, uint(10), l.MaxDocumentLength, "expected max document length %d, got %d", 10, l.MaxDocumentLength)
+	})
+	t.Run("options take precedence over environment", func(t *testing.T) {
+		clearEnv(t)
+		defer clearEnv(t)
+		assert.Nil(t, os.Setenv(EnvAll, "debug"), "Setenv error")
+
+		l := New(&testSink{}, 5, map[Component]Level{ComponentConnection: LevelOff})
+		assert.True(t, l.LevelComponentEnabled(LevelDebug, ComponentCommand), "expected command debug to be enabled")
+		assert.False(t, l.LevelComponentEnabled(LevelInfo, ComponentConnection), "expected connection to be disabled")
+		assert.Equal(t, uint(5), l.MaxDocumentLength, "expected max document length %d, got %d", 5, l.MaxDocumentLength)
+	})
+	t.Run("Truncate", func(t *testing.T) {
+		l := &Logger{MaxDocumentLength: 5}
+		assert.Equal(t, "abc", l.Truncate("abc"), "expected short string to be unchanged")
+		assert.Equal(t, "abcde...", l.Truncate("abcdefgh"), "expected string to be truncated")
+		// "é" is two bytes, so cutting at byte 5 would split it.
+		assert.Equal(t, "abcd...", l.Truncate("abcdéfgh"), "expected multi-byte character not to be split")
+	})
+	t.Run("CommandMonitor", func(t *testing.T) {
+		sink := &testSink{}
+		l := &Logger{
+			ComponentLevels:   map[Component]Level{ComponentCommand: LevelDebug},
+			Sink:              sink,
+			MaxDocumentLength: DefaultMaxDocumentLength,
+		}
+		var forwarded bool
+		monitor := l.CommandMonitor(&event.CommandMonitor{
+			Started: func(context.Context, *event.CommandStartedEvent) { forwarded = true },
+		})
+		monitor.Started(context.Background(), &event.CommandStartedEvent{
+			Command:     bson.Raw(bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "ping", 1))),
+			CommandName: "ping",
+		})
+		monitor.Succeeded(context.Background(), &event.CommandSucceededEvent{
+			CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "ping"},
+			Reply:                bson.Raw(bsoncore.BuildDocumentFromElements(nil)),
+		})
+
+		assert.True(t, forwarded, "expected event to be forwarded")
+		assert.Equal(t, []string{"Command started", "Command succeeded"}, sink.messages,
+			"unexpected messages %v", sink.messages)
+		kvs := sink.kvs[0]
+		assert.Equal(t, "component", kvs[len(kvs)-2], "expected component key, got %v", kvs[len(kvs)-2])
+		assert.Equal(t, "command", kvs[len(kvs)-1], "expected command component, got %v", kvs[len(kvs)-1])
+	})
+	t.Run("CommandMonitor disabled", func(t *testing.T) {
+		var l *Logger
+		next := &event.CommandMonitor{}
+		assert.Equal(t, next, l.CommandMonitor(next), "expected monitor to be returned unchanged")
+	})
+	t.Run("IOSink", func(t *testing.T) {
+		var buf bytes.Buffer
+		NewIOSink(&buf).Info(int(LevelInfo), "hello", "key", "value", "n", 1)
+
+		var doc map[string]interface{}
+		assert.Nil(t, json.Unmarshal(buf.Bytes(), &doc), "Unmarshal error for %q", buf.String())
+		assert.Equal(t, "hello", doc["message"], "expected message %q, got %v", "hello", doc["message"])
+		assert.Equal(t, "value", doc["key"], "expected key %q, got %v", "value", doc["key"])
+		assert.Equal(t, float64(1), doc["n"], "expected n %v, got %v", 1, doc["n"])
+	})
+}
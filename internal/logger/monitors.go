@@ -0,0 +1,230 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package logger
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/description"
+)
+
+// CommandMonitor returns a CommandMonitor that logs command events and then forwards them to next. If command logging
+// is disabled, next is returned unchanged. next can be nil.
+func (l *Logger) CommandMonitor(next *event.CommandMonitor) *event.CommandMonitor {
+	if !l.LevelComponentEnabled(LevelDebug, ComponentCommand) {
+		return next
+	}
+	if next == nil {
+		next = &event.CommandMonitor{}
+	}
+
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, evt *event.CommandStartedEvent) {
+			l.Print(LevelDebug, ComponentCommand, "Command started",
+				"commandName", evt.CommandName,
+				"databaseName", evt.DatabaseName,
+				"requestId", evt.RequestID,
+				"connectionId", evt.ConnectionID,
+				"command", l.Truncate(evt.Command.String()),
+			)
+			if next.Started != nil {
+				next.Started(ctx, evt)
+			}
+		},
+		Succeeded: func(ctx context.Context, evt *event.CommandSucceededEvent) {
+			l.Print(LevelDebug, ComponentCommand, "Command succeeded",
+				"commandName", evt.CommandName,
+				"requestId", evt.RequestID,
+				"connectionId", evt.ConnectionID,
+				"durationMS", durationMS(evt.DurationNanos),
+				"reply", l.Truncate(evt.Reply.String()),
+			)
+			if next.Succeeded != nil {
+				next.Succeeded(ctx, evt)
+			}
+		},
+		Failed: func(ctx context.Context, evt *event.CommandFailedEvent) {
+			l.Error(LevelDebug, ComponentCommand, fmt.Errorf("%s", evt.Failure), "Command failed",
+				"commandName", evt.CommandName,
+				"requestId", evt.RequestID,
+				"connectionId", evt.ConnectionID,
+				"durationMS", durationMS(evt.DurationNanos),
+			)
+			if next.Failed != nil {
+				next.Failed(ctx, evt)
+			}
+		},
+	}
+}
+
+// poolEventMessages maps connection pool event types to log messages.
+var poolEventMessages = map[string]string{
+	event.PoolCreated:        "Connection pool created",
+	event.PoolCleared:        "Connection pool cleared",
+	event.PoolClosedEvent:    "Connection pool closed",
+	event.ConnectionCreated:  "Connection created",
+	event.ConnectionClosed:   "Connection closed",
+	event.GetStarted:         "Connection checkout started",
+	event.GetSucceeded:       "Connection checked out",
+	event.GetFailed:          "Connection checkout failed",
+	event.ConnectionReturned: "Connection checked in",
+}
+
+// PoolMonitor returns a PoolMonitor that logs connection pool events and then forwards them to next. If connection
+// logging is disabled, next is returned unchanged. next can be nil.
+func (l *Logger) PoolMonitor(next *event.PoolMonitor) *event.PoolMonitor {
+	if !l.LevelComponentEnabled(LevelDebug, ComponentConnection) {
+		return next
+	}
+
+	return &event.PoolMonitor{
+		Event: func(evt *event.PoolEvent) {
+			message, ok := poolEventMessages[evt.Type]
+			if !ok {
+				message = evt.Type
+			}
+			keysAndValues := []interface{}{"serverAddress", evt.Address}
+			if evt.ConnectionID != 0 {
+				keysAndValues = append(keysAndValues, "connectionId", evt.ConnectionID)
+			}
+			if evt.Reason != "" {
+				keysAndValues = append(keysAndValues, "reason", evt.Reason)
+			}
+			if opts := evt.PoolOptions; opts != nil {
+				keysAndValues = append(keysAndValues, "maxPoolSize", opts.MaxPoolSize, "minPoolSize", opts.MinPoolSize)
+			}
+			l.Print(LevelDebug, ComponentConnection, message, keysAndValues...)
+
+			if next != nil && next.Event != nil {
+				next.Event(evt)
+			}
+		},
+	}
+}
+
+// ServerMonitor returns a ServerMonitor that logs SDAM events and then forwards them to next. If topology logging is
+// disabled, next is returned unchanged. next can be nil.
+func (l *Logger) ServerMonitor(next *event.ServerMonitor) *event.ServerMonitor {
+	if !l.LevelComponentEnabled(LevelDebug, ComponentTopology) {
+		return next
+	}
+	if next == nil {
+		next = &event.ServerMonitor{}
+	}
+
+	return &event.ServerMonitor{
+		ServerDescriptionChanged: func(evt *event.ServerDescriptionChangedEvent) {
+			l.Print(LevelDebug, ComponentTopology, "Server description changed",
+				"topologyId", evt.TopologyID.Hex(),
+				"serverAddress", evt.Address.String(),
+				"previousDescription", serverString(evt.PreviousDescription),
+				"newDescription", serverString(evt.NewDescription),
+			)
+			if next.ServerDescriptionChanged != nil {
+				next.ServerDescriptionChanged(evt)
+			}
+		},
+		ServerOpening: func(evt *event.ServerOpeningEvent) {
+			l.Print(LevelDebug, ComponentTopology, "Starting server monitoring",
+				"topologyId", evt.TopologyID.Hex(),
+				"serverAddress", evt.Address.String(),
+			)
+			if next.ServerOpening != nil {
+				next.ServerOpening(evt)
+			}
+		},
+		ServerClosed: func(evt *event.ServerClosedEvent) {
+			l.Print(LevelDebug, ComponentTopology, "Stopped server monitoring",
+				"topologyId", evt.TopologyID.Hex(),
+				"serverAddress", evt.Address.String(),
+			)
+			if next.ServerClosed != nil {
+				next.ServerClosed(evt)
+			}
+		},
+		TopologyDescriptionChanged: func(evt *event.TopologyDescriptionChangedEvent) {
+			l.Print(LevelDebug, ComponentTopology, "Topology description changed",
+				"topologyId", evt.TopologyID.Hex(),
+				"previousDescription", TopologyString(evt.PreviousDescription),
+				"newDescription", TopologyString(evt.NewDescription),
+			)
+			if next.TopologyDescriptionChanged != nil {
+				next.TopologyDescriptionChanged(evt)
+			}
+		},
+		TopologyOpening: func(evt *event.TopologyOpeningEvent) {
+			l.Print(LevelDebug, ComponentTopology, "Starting topology monitoring", "topologyId", evt.TopologyID.Hex())
+			if next.TopologyOpening != nil {
+				next.TopologyOpening(evt)
+			}
+		},
+		TopologyClosed: func(evt *event.TopologyClosedEvent) {
+			l.Print(LevelDebug, ComponentTopology, "Stopped topology monitoring", "topologyId", evt.TopologyID.Hex())
+			if next.TopologyClosed != nil {
+				next.TopologyClosed(evt)
+			}
+		},
+		ServerHeartbeatStarted: func(evt *event.ServerHeartbeatStartedEvent) {
+			l.Print(LevelDebug, ComponentTopology, "Server heartbeat started",
+				"connectionId", evt.ConnectionID,
+				"awaited", evt.Awaited,
+			)
+			if next.ServerHeartbeatStarted != nil {
+				next.ServerHeartbeatStarted(evt)
+			}
+		},
+		ServerHeartbeatSucceeded: func(evt *event.ServerHeartbeatSucceededEvent) {
+			l.Print(LevelDebug, ComponentTopology, "Server heartbeat succeeded",
+				"connectionId", evt.ConnectionID,
+				"awaited", evt.Awaited,
+				"durationMS", durationMS(evt.DurationNanos),
+				"reply", serverString(evt.Reply),
+			)
+			if next.ServerHeartbeatSucceeded != nil {
+				next.ServerHeartbeatSucceeded(evt)
+			}
+		},
+		ServerHeartbeatFailed: func(evt *event.ServerHeartbeatFailedEvent) {
+			l.Error(LevelDebug, ComponentTopology, evt.Failure, "Server heartbeat failed",
+				"connectionId", evt.ConnectionID,
+				"awaited", evt.Awaited,
+				"durationMS", durationMS(evt.DurationNanos),
+			)
+			if next.ServerHeartbeatFailed != nil {
+				next.ServerHeartbeatFailed(evt)
+			}
+		},
+	}
+}
+
+// TopologyString returns a short description of a topology suitable for log messages.
+func TopologyString(desc description.Topology) string {
+	servers := make([]string, 0, len(desc.Servers))
+	for _, s := range desc.Servers {
+		servers = append(servers, serverString(s))
+	}
+	return fmt.Sprintf("Type: %s, Servers: [%s]", desc.Kind, strings.Join(servers, ", "))
+}
+
+func serverString(desc description.Server) string {
+	str := fmt.Sprintf("{ Addr: %s, Type: %s", desc.Addr, desc.Kind)
+	if desc.SetName != "" {
+		str += ", SetName: " + desc.SetName
+	}
+	if desc.LastError != nil {
+		str += ", Last error: " + desc.LastError.Error()
+	}
+	return str + " }"
+}
+
+func durationMS(nanos int64) int64 {
+	return nanos / int64(time.Millisecond)
+}
@@ -0,0 +1,25 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package internal
+
+import (
+	"context"
+	"time"
+)
+
+// MakeTimeoutContext returns a child of ctx that expires after the given client-side timeout. If the timeout is nil
+// or zero, or ctx already has a deadline, ctx is returned unchanged along with a no-op cancel function. The returned
+// cancel function must always be called to release resources.
+func MakeTimeoutContext(ctx context.Context, timeout *time.Duration) (context.Context, context.CancelFunc) {
+	if timeout == nil || *timeout <= 0 {
+		return ctx, func() {}
+	}
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, *timeout)
+}
@@ -0,0 +1,109 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package builder
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Accumulator represents an accumulator expression that computes an output field of the $group and $bucket stages
+// from the documents in each group.
+type Accumulator struct {
+	// The name of the output field.
+	Field string
+
+	// The accumulator operator, such as "$sum".
+	Operator string
+
+	// The expression the operator is applied to.
+	Expression interface{}
+}
+
+// Sum creates an accumulator that computes the sum of expr in the field. Use a value of 1 for expr to count the
+// documents.
+func Sum(field string, expr interface{}) Accumulator {
+	return Accumulator{Field: field, Operator: "$sum", Expression: expr}
+}
+
+// Avg creates an accumulator that computes the average of expr in the field.
+func Avg(field string, expr interface{}) Accumulator {
+	return Accumulator{Field: field, Operator: "$avg", Expression: expr}
+}
+
+// Min creates an accumulator that computes the minimum of expr in the field.
+func Min(field string, expr interface{}) Accumulator {
+	return Accumulator{Field: field, Operator: "$min", Expression: expr}
+}
+
+// Max creates an accumulator that computes the maximum of expr in the field.
+func Max(field string, expr interface{}) Accumulator {
+	return Accumulator{Field: field, Operator: "$max", Expression: expr}
+}
+
+// First creates an accumulator that sets the field to the value of expr for the first document in each group.
+func First(field string, expr interface{}) Accumulator {
+	return Accumulator{Field: field, Operator: "$first", Expression: expr}
+}
+
+// Last creates an accumulator that sets the field to the value of expr for the last document in each group.
+func Last(field string, expr interface{}) Accumulator {
+	return Accumulator{Field: field, Operator: "$last", Expression: expr}
+}
+
+// Push creates an accumulator that sets the field to an array of the values of expr for all documents in each group.
+func Push(field string, expr interface{}) Accumulator {
+	return Accumulator{Field: field, Operator: "$push", Expression: expr}
+}
+
+// AddToSet creates an accumulator that sets the field to an array of the unique values of expr for all documents in
+// each group.
+func AddToSet(field string, expr interface{}) Accumulator {
+	return Accumulator{Field: field, Operator: "$addToSet", Expression: expr}
+}
+
+// StdDevPop creates an accumulator that computes the population standard deviation of expr in the field.
+func StdDevPop(field string, expr interface{}) Accumulator {
+	return Accumulator{Field: field, Operator: "$stdDevPop", Expression: expr}
+}
+
+// StdDevSamp creates an accumulator that computes the sample standard deviation of expr in the field.
+func StdDevSamp(field string, expr interface{}) Accumulator {
+	return Accumulator{Field: field, Operator: "$stdDevSamp", Expression: expr}
+}
+
+// MergeObjects creates an accumulator that sets the field to a document that combines the documents produced by expr.
+func MergeObjects(field string, expr interface{}) Accumulator {
+	return Accumulator{Field: field, Operator: "$mergeObjects", Expression: expr}
+}
+
+// appendAccumulators appends the accumulators to doc as output fields. It returns an error message if any of them is
+// invalid.
+func appendAccumulators(doc bson.D, accumulators []Accumulator) (bson.D, string) {
+	seen := make(map[string]bool, len(doc)+len(accumulators))
+	for _, elem := range doc {
+		seen[elem.Key] = true
+	}
+
+	for _, acc := range accumulators {
+		switch {
+		case acc.Field == "":
+			return nil, "accumulator field name cannot be empty"
+		case strings.Contains(acc.Field, "."):
+			return nil, fmt.Sprintf("accumulator field name %q cannot contain '.'", acc.Field)
+		case seen[acc.Field]:
+			return nil, fmt.Sprintf("duplicate field name %q", acc.Field)
+		case !strings.HasPrefix(acc.Operator, "$"):
+			return nil, fmt.Sprintf("accumulator operator %q for field %q must start with '$'", acc.Operator, acc.Field)
+		}
+		seen[acc.Field] = true
+		doc = append(doc, bson.E{Key: acc.Field, Value: bson.D{{Key: acc.Operator, Value: acc.Expression}}})
+	}
+	return doc, ""
+}
@@ -0,0 +1,648 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package builder
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// PipelineError is returned by PipelineBuilder.Build if a stage of the pipeline is invalid.
+type PipelineError struct {
+	Index   int    // The index of the stage in the pipeline.
+	Stage   string // The name of the stage, such as "$out".
+	Message string
+}
+
+// Error implements the error interface.
+func (e PipelineError) Error() string {
+	return fmt.Sprintf("invalid %s stage at index %d: %s", e.Stage, e.Index, e.Message)
+}
+
+// Stages that cannot be used in the sub-pipelines of other stages.
+var (
+	outputStages           = []string{"$out", "$merge"}
+	facetDisallowedStages  = []string{"$out", "$merge", "$facet", "$collStats", "$geoNear", "$indexStats", "$planCacheStats"}
+	mergeWhenMatchedValues = []string{"replace", "keepExisting", "merge", "fail"}
+	mergeWhenNotMatched    = []string{"insert", "discard", "fail"}
+)
+
+// The only stages that can be used in the whenMatched pipeline of a $merge stage.
+var mergeWhenMatchedStages = []string{"$addFields", "$set", "$project", "$unset", "$replaceRoot", "$replaceWith"}
+
+// PipelineBuilder builds an aggregation pipeline stage by stage. Each stage is validated when it is added and the
+// first error is returned by Build, so that mistakes such as a $out stage that is not the last stage are caught before
+// the pipeline is sent to the server:
+//
+//    pipeline, err := builder.Pipeline().
+//        Match(builder.Eq("status", "A")).
+//        Group("$cust_id", builder.Sum("total", "$amount")).
+//        Sort(bson.D{{"total", -1}}).
+//        Build()
+//    if err != nil {
+//        return err
+//    }
+//    cursor, err := coll.Aggregate(ctx, pipeline)
+type PipelineBuilder struct {
+	stages mongo.Pipeline
+	err    error
+}
+
+// Pipeline creates a new, empty PipelineBuilder.
+func Pipeline() *PipelineBuilder {
+	return &PipelineBuilder{}
+}
+
+// Build returns the pipeline, which can be passed to Collection.Aggregate, Database.Aggregate, or Collection.Watch. It
+// returns a PipelineError if any of the stages are invalid.
+func (pb *PipelineBuilder) Build() (mongo.Pipeline, error) {
+	if pb.err != nil {
+		return nil, pb.err
+	}
+
+	for i, stage := range pb.stages {
+		if name := stage[0].Key; contains(outputStages, name) && i != len(pb.stages)-1 {
+			return nil, PipelineError{Index: i, Stage: name, Message: "must be the last stage of the pipeline"}
+		}
+	}
+
+	pipeline := make(mongo.Pipeline, len(pb.stages))
+	copy(pipeline, pb.stages)
+	return pipeline, nil
+}
+
+// addStage appends the stage to the pipeline. If msg is not empty, the stage is invalid and an error is recorded
+// unless an earlier stage was also invalid.
+func (pb *PipelineBuilder) addStage(name string, val interface{}, msg string) *PipelineBuilder {
+	if msg != "" && pb.err == nil {
+		pb.err = PipelineError{Index: len(pb.stages), Stage: name, Message: msg}
+	}
+	pb.stages = append(pb.stages, bson.D{{Key: name, Value: val}})
+	return pb
+}
+
+// Stage appends a stage that does not have a dedicated method. The name must start with '$'.
+func (pb *PipelineBuilder) Stage(name string, val interface{}) *PipelineBuilder {
+	var msg string
+	if !strings.HasPrefix(name, "$") {
+		msg = "stage name must start with '$'"
+	}
+	return pb.addStage(name, val, msg)
+}
+
+// Match appends a $match stage that passes on the documents that match filter. A filter that uses $text must be the
+// first stage of the pipeline.
+func (pb *PipelineBuilder) Match(filter interface{}) *PipelineBuilder {
+	var msg string
+	switch f := filter.(type) {
+	case nil:
+		msg = "filter cannot be nil"
+	case bson.D:
+		for _, elem := range f {
+			if elem.Key == "$text" && len(pb.stages) > 0 {
+				msg = "a filter with $text must be in the first stage of the pipeline"
+			}
+		}
+	}
+	return pb.addStage("$match", filter, msg)
+}
+
+// Group appends a $group stage that groups documents by the id expression, such as "$field", and computes the
+// accumulators for each group. Use a nil id to compute the accumulators for all documents.
+func (pb *PipelineBuilder) Group(id interface{}, accumulators ...Accumulator) *PipelineBuilder {
+	doc, msg := appendAccumulators(bson.D{{Key: "_id", Value: id}}, accumulators)
+	return pb.addStage("$group", doc, msg)
+}
+
+// Lookup appends a $lookup stage that adds an array field named as to each document with the documents of the from
+// collection whose foreignField equals the document's localField.
+func (pb *PipelineBuilder) Lookup(from, localField, foreignField, as string) *PipelineBuilder {
+	doc := bson.D{
+		{Key: "from", Value: from},
+		{Key: "localField", Value: localField},
+		{Key: "foreignField", Value: foreignField},
+		{Key: "as", Value: as},
+	}
+	var msg string
+	switch {
+	case from == "":
+		msg = "from cannot be empty"
+	case localField == "" || foreignField == "":
+		msg = "localField and foreignField cannot be empty"
+	case as == "":
+		msg = "as cannot be empty"
+	}
+	return pb.addStage("$lookup", doc, msg)
+}
+
+// LookupPipeline appends a $lookup stage that adds an array field named as to each document with the result of running
+// pipeline on the from collection. The let document defines variables that can be used in the pipeline and can be
+// nil. The pipeline cannot contain $out or $merge stages.
+func (pb *PipelineBuilder) LookupPipeline(from string, let interface{}, pipeline *PipelineBuilder, as string) *PipelineBuilder {
+	stages, msg := subPipeline(pipeline, outputStages)
+	doc := bson.D{{Key: "from", Value: from}}
+	if let != nil {
+		doc = append(doc, bson.E{Key: "let", Value: let})
+	}
+	doc = append(doc, bson.E{Key: "pipeline", Value: stages}, bson.E{Key: "as", Value: as})
+
+	switch {
+	case from == "":
+		msg = "from cannot be empty"
+	case as == "":
+		msg = "as cannot be empty"
+	case pipeline == nil:
+		msg = "pipeline cannot be nil"
+	}
+	return pb.addStage("$lookup", doc, msg)
+}
+
+// Unwind appends an $unwind stage that outputs a document for each element of the array at path, which must start
+// with '$'. The options can be nil.
+func (pb *PipelineBuilder) Unwind(path string, opts *UnwindOptions) *PipelineBuilder {
+	var msg string
+	if !strings.HasPrefix(path, "$") {
+		msg = fmt.Sprintf("path %q must start with '$'", path)
+	}
+	if opts == nil {
+		return pb.addStage("$unwind", path, msg)
+	}
+
+	doc := bson.D{{Key: "path", Value: path}}
+	if opts.IncludeArrayIndex != nil {
+		doc = append(doc, bson.E{Key: "includeArrayIndex", Value: *opts.IncludeArrayIndex})
+		if strings.HasPrefix(*opts.IncludeArrayIndex, "$") {
+			msg = fmt.Sprintf("includeArrayIndex %q cannot start with '$'", *opts.IncludeArrayIndex)
+		}
+	}
+	if opts.PreserveNullAndEmptyArrays != nil {
+		doc = append(doc, bson.E{Key: "preserveNullAndEmptyArrays", Value: *opts.PreserveNullAndEmptyArrays})
+	}
+	return pb.addStage("$unwind", doc, msg)
+}
+
+// Facet appends a $facet stage that runs each facet's pipeline on the same input documents and outputs a single
+// document with a field for each facet. Facet pipelines cannot be empty or contain $facet, $out, or $merge stages.
+func (pb *PipelineBuilder) Facet(facets ...Facet) *PipelineBuilder {
+	var msg string
+	if len(facets) == 0 {
+		msg = "at least one facet is required"
+	}
+
+	doc := make(bson.D, 0, len(facets))
+	seen := make(map[string]bool, len(facets))
+	for _, facet := range facets {
+		stages, facetMsg := subPipeline(facet.Pipeline, facetDisallowedStages)
+		switch {
+		case facet.Name == "":
+			facetMsg = "facet name cannot be empty"
+		case seen[facet.Name]:
+			facetMsg = fmt.Sprintf("duplicate facet name %q", facet.Name)
+		case len(stages) == 0 && facetMsg == "":
+			facetMsg = fmt.Sprintf("the pipeline for facet %q cannot be empty", facet.Name)
+		case facetMsg != "":
+			facetMsg = fmt.Sprintf("facet %q: %s", facet.Name, facetMsg)
+		}
+		if msg == "" {
+			msg = facetMsg
+		}
+		seen[facet.Name] = true
+		doc = append(doc, bson.E{Key: facet.Name, Value: stages})
+	}
+	return pb.addStage("$facet", doc, msg)
+}
+
+// Bucket appends a $bucket stage that groups documents into buckets by the groupBy expression. The boundaries are the
+// sorted lower bounds of the buckets and the upper bound of the last bucket, so at least two are required. The options
+// can be nil.
+func (pb *PipelineBuilder) Bucket(groupBy interface{}, boundaries []interface{}, opts *BucketOptions) *PipelineBuilder {
+	doc := bson.D{
+		{Key: "groupBy", Value: groupBy},
+		{Key: "boundaries", Value: bson.A(boundaries)},
+	}
+
+	var msg string
+	if opts != nil {
+		if opts.Default != nil {
+			doc = append(doc, bson.E{Key: "default", Value: opts.Default})
+		}
+		if len(opts.Output) > 0 {
+			var output bson.D
+			output, msg = appendAccumulators(nil, opts.Output)
+			doc = append(doc, bson.E{Key: "output", Value: output})
+		}
+	}
+
+	switch {
+	case groupBy == nil:
+		msg = "groupBy cannot be nil"
+	case len(boundaries) < 2:
+		msg = "at least two boundaries are required"
+	}
+	return pb.addStage("$bucket", doc, msg)
+}
+
+// GraphLookup appends a $graphLookup stage that recursively searches the from collection, starting with documents
+// whose connectToField matches startWith and continuing with documents whose connectToField matches the
+// connectFromField of documents already found. The documents found are added to an array field named as. The options
+// can be nil.
+func (pb *PipelineBuilder) GraphLookup(from string, startWith interface{}, connectFromField, connectToField,
+	as string, opts *GraphLookupOptions) *PipelineBuilder {
+
+	doc := bson.D{
+		{Key: "from", Value: from},
+		{Key: "startWith", Value: startWith},
+		{Key: "connectFromField", Value: connectFromField},
+		{Key: "connectToField", Value: connectToField},
+		{Key: "as", Value: as},
+	}
+
+	var msg string
+	if opts != nil {
+		if opts.MaxDepth != nil {
+			doc = append(doc, bson.E{Key: "maxDepth", Value: *opts.MaxDepth})
+			if *opts.MaxDepth < 0 {
+				msg = "maxDepth cannot be negative"
+			}
+		}
+		if opts.DepthField != nil {
+			doc = append(doc, bson.E{Key: "depthField", Value: *opts.DepthField})
+		}
+		if opts.RestrictSearchWithMatch != nil {
+			doc = append(doc, bson.E{Key: "restrictSearchWithMatch", Value: opts.RestrictSearchWithMatch})
+		}
+	}
+
+	switch {
+	case from == "":
+		msg = "from cannot be empty"
+	case startWith == nil:
+		msg = "startWith cannot be nil"
+	case connectFromField == "" || connectToField == "":
+		msg = "connectFromField and connectToField cannot be empty"
+	case as == "":
+		msg = "as cannot be empty"
+	}
+	return pb.addStage("$graphLookup", doc, msg)
+}
+
+// Merge appends a $merge stage that writes the output of the pipeline to a collection. If database is empty, the
+// collection is in the database the pipeline is run in. The options can be nil. The $merge stage must be the last
+// stage of the pipeline.
+func (pb *PipelineBuilder) Merge(database, collection string, opts *MergeOptions) *PipelineBuilder {
+	var into interface{} = collection
+	if database != "" {
+		into = bson.D{{Key: "db", Value: database}, {Key: "coll", Value: collection}}
+	}
+	doc := bson.D{{Key: "into", Value: into}}
+
+	var msg string
+	if opts != nil {
+		if len(opts.On) > 0 {
+			doc = append(doc, bson.E{Key: "on", Value: opts.On})
+		}
+		if opts.Let != nil {
+			doc = append(doc, bson.E{Key: "let", Value: opts.Let})
+			if opts.WhenMatchedPipeline == nil {
+				msg = "let can only be used with a whenMatched pipeline"
+			}
+		}
+		switch {
+		case opts.WhenMatched != nil && opts.WhenMatchedPipeline != nil:
+			msg = "whenMatched and whenMatchedPipeline cannot both be set"
+		case opts.WhenMatched != nil:
+			doc = append(doc, bson.E{Key: "whenMatched", Value: *opts.WhenMatched})
+			if !contains(mergeWhenMatchedValues, *opts.WhenMatched) {
+				msg = fmt.Sprintf("invalid whenMatched value %q", *opts.WhenMatched)
+			}
+		case opts.WhenMatchedPipeline != nil:
+			stages, pipelineMsg := validSubPipeline(opts.WhenMatchedPipeline, func(name string) bool {
+				return contains(mergeWhenMatchedStages, name)
+			})
+			doc = append(doc, bson.E{Key: "whenMatched", Value: stages})
+			if pipelineMsg != "" {
+				msg = pipelineMsg
+			}
+		}
+		if opts.WhenNotMatched != nil {
+			doc = append(doc, bson.E{Key: "whenNotMatched", Value: *opts.WhenNotMatched})
+			if !contains(mergeWhenNotMatched, *opts.WhenNotMatched) {
+				msg = fmt.Sprintf("invalid whenNotMatched value %q", *opts.WhenNotMatched)
+			}
+		}
+	}
+
+	if collection == "" {
+		msg = "collection cannot be empty"
+	}
+	return pb.addStage("$merge", doc, msg)
+}
+
+// Out appends an $out stage that writes the output of the pipeline to a collection, replacing the collection if it
+// exists. If database is empty, the collection is in the database the pipeline is run in. The $out stage must be the
+// last stage of the pipeline.
+func (pb *PipelineBuilder) Out(database, collection string) *PipelineBuilder {
+	var val interface{} = collection
+	if database != "" {
+		val = bson.D{{Key: "db", Value: database}, {Key: "coll", Value: collection}}
+	}
+
+	var msg string
+	if collection == "" {
+		msg = "collection cannot be empty"
+	}
+	return pb.addStage("$out", val, msg)
+}
+
+// Sort appends a $sort stage that sorts documents by the fields in sort, which map to 1 for ascending order, -1 for
+// descending order, or a $meta expression.
+func (pb *PipelineBuilder) Sort(sort bson.D) *PipelineBuilder {
+	var msg string
+	if len(sort) == 0 {
+		msg = "at least one sort field is required"
+	}
+	return pb.addStage("$sort", sort, msg)
+}
+
+// Project appends a $project stage that reshapes documents using projection, which can be a ProjectionBuilder.
+func (pb *PipelineBuilder) Project(projection interface{}) *PipelineBuilder {
+	var msg string
+	switch p := projection.(type) {
+	case nil:
+		msg = "projection cannot be nil"
+	case bson.D:
+		if len(p) == 0 {
+			msg = "projection cannot be empty"
+		}
+	case *ProjectionBuilder:
+		if len(p.D()) == 0 {
+			msg = "projection cannot be empty"
+		}
+	}
+	return pb.addStage("$project", projection, msg)
+}
+
+// AddFields appends an $addFields stage that adds fields to documents or replaces existing fields.
+func (pb *PipelineBuilder) AddFields(fields bson.D) *PipelineBuilder {
+	var msg string
+	if len(fields) == 0 {
+		msg = "at least one field is required"
+	}
+	return pb.addStage("$addFields", fields, msg)
+}
+
+// ReplaceRoot appends a $replaceRoot stage that replaces each document with the document that newRoot evaluates to,
+// such as "$field" or an expression that creates a document.
+func (pb *PipelineBuilder) ReplaceRoot(newRoot interface{}) *PipelineBuilder {
+	var msg string
+	switch r := newRoot.(type) {
+	case nil:
+		msg = "newRoot cannot be nil"
+	case string:
+		if !strings.HasPrefix(r, "$") {
+			msg = fmt.Sprintf("newRoot %q must be a field path starting with '$'", r)
+		}
+	}
+	return pb.addStage("$replaceRoot", bson.D{{Key: "newRoot", Value: newRoot}}, msg)
+}
+
+// UnionWith appends a $unionWith stage that adds the documents of collection to the output, after running pipeline on
+// them. The pipeline can be nil and cannot contain $out or $merge stages.
+func (pb *PipelineBuilder) UnionWith(collection string, pipeline *PipelineBuilder) *PipelineBuilder {
+	var val interface{} = collection
+	var msg string
+	if pipeline != nil {
+		var stages bson.A
+		stages, msg = subPipeline(pipeline, outputStages)
+		val = bson.D{{Key: "coll", Value: collection}, {Key: "pipeline", Value: stages}}
+	}
+
+	if collection == "" {
+		msg = "collection cannot be empty"
+	}
+	return pb.addStage("$unionWith", val, msg)
+}
+
+// Limit appends a $limit stage that passes on the first n documents. The value of n must be positive.
+func (pb *PipelineBuilder) Limit(n int64) *PipelineBuilder {
+	var msg string
+	if n <= 0 {
+		msg = "limit must be positive"
+	}
+	return pb.addStage("$limit", n, msg)
+}
+
+// Skip appends a $skip stage that skips the first n documents. The value of n cannot be negative.
+func (pb *PipelineBuilder) Skip(n int64) *PipelineBuilder {
+	var msg string
+	if n < 0 {
+		msg = "skip cannot be negative"
+	}
+	return pb.addStage("$skip", n, msg)
+}
+
+// subPipeline builds pipeline for use in a stage. It returns an error message if pipeline is invalid or contains any
+// of the disallowed stages.
+func subPipeline(pipeline *PipelineBuilder, disallowed []string) (bson.A, string) {
+	return validSubPipeline(pipeline, func(name string) bool {
+		return !contains(disallowed, name)
+	})
+}
+
+// validSubPipeline builds pipeline and returns its stages, or an error message if the pipeline is invalid or has a
+// stage for which valid returns false.
+func validSubPipeline(pipeline *PipelineBuilder, valid func(name string) bool) (bson.A, string) {
+	if pipeline == nil {
+		return bson.A{}, ""
+	}
+
+	stages, err := pipeline.Build()
+	if err != nil {
+		return nil, fmt.Sprintf("invalid pipeline: %v", err)
+	}
+
+	arr := make(bson.A, 0, len(stages))
+	for i, stage := range stages {
+		if name := stage[0].Key; !valid(name) {
+			return nil, fmt.Sprintf("invalid pipeline: %v", PipelineError{
+				Index:   i,
+				Stage:   name,
+				Message: "cannot be used in this pipeline",
+			})
+		}
+		arr = append(arr, stage)
+	}
+	return arr, ""
+}
+
+func contains(vals []string, val string) bool {
+	for _, v := range vals {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
+
+// Facet represents a named pipeline of a $facet stage.
+type Facet struct {
+	Name     string
+	Pipeline *PipelineBuilder
+}
+
+// UnwindOptions represents options that can be used to configure an $unwind stage.
+type UnwindOptions struct {
+	// The name of a field that is set to the index of the array element in each output document. The default value is
+	// nil, which means that the index is not included.
+	IncludeArrayIndex *string
+
+	// If true, documents where the path is null, missing, or an empty array are passed on unchanged. The default value
+	// is false.
+	PreserveNullAndEmptyArrays *bool
+}
+
+// NewUnwindOptions creates a new UnwindOptions instance.
+func NewUnwindOptions() *UnwindOptions {
+	return &UnwindOptions{}
+}
+
+// SetIncludeArrayIndex sets the value for the IncludeArrayIndex field.
+func (uo *UnwindOptions) SetIncludeArrayIndex(field string) *UnwindOptions {
+	uo.IncludeArrayIndex = &field
+	return uo
+}
+
+// SetPreserveNullAndEmptyArrays sets the value for the PreserveNullAndEmptyArrays field.
+func (uo *UnwindOptions) SetPreserveNullAndEmptyArrays(preserve bool) *UnwindOptions {
+	uo.PreserveNullAndEmptyArrays = &preserve
+	return uo
+}
+
+// BucketOptions represents options that can be used to configure a $bucket stage.
+type BucketOptions struct {
+	// The _id of the bucket for documents that do not fall into any of the buckets. The default value is nil, which
+	// means that the aggregation fails if there are such documents.
+	Default interface{}
+
+	// The accumulators that compute the fields of each bucket. The default value is nil, which means that each
+	// bucket has a count field with the number of documents in it.
+	Output []Accumulator
+}
+
+// NewBucketOptions creates a new BucketOptions instance.
+func NewBucketOptions() *BucketOptions {
+	return &BucketOptions{}
+}
+
+// SetDefault sets the value for the Default field.
+func (bo *BucketOptions) SetDefault(def interface{}) *BucketOptions {
+	bo.Default = def
+	return bo
+}
+
+// SetOutput sets the value for the Output field.
+func (bo *BucketOptions) SetOutput(accumulators ...Accumulator) *BucketOptions {
+	bo.Output = accumulators
+	return bo
+}
+
+// GraphLookupOptions represents options that can be used to configure a $graphLookup stage.
+type GraphLookupOptions struct {
+	// The maximum recursion depth. The default value is nil, which means that there is no limit.
+	MaxDepth *int64
+
+	// The name of a field that is set to the recursion depth of each document found. The default value is nil, which
+	// means that the depth is not included.
+	DepthField *string
+
+	// A filter that documents must match to be included in the search. The default value is nil, which means that
+	// all documents are searched.
+	RestrictSearchWithMatch interface{}
+}
+
+// NewGraphLookupOptions creates a new GraphLookupOptions instance.
+func NewGraphLookupOptions() *GraphLookupOptions {
+	return &GraphLookupOptions{}
+}
+
+// SetMaxDepth sets the value for the MaxDepth field.
+func (gl *GraphLookupOptions) SetMaxDepth(depth int64) *GraphLookupOptions {
+	gl.MaxDepth = &depth
+	return gl
+}
+
+// SetDepthField sets the value for the DepthField field.
+func (gl *GraphLookupOptions) SetDepthField(field string) *GraphLookupOptions {
+	gl.DepthField = &field
+	return gl
+}
+
+// SetRestrictSearchWithMatch sets the value for the RestrictSearchWithMatch field.
+func (gl *GraphLookupOptions) SetRestrictSearchWithMatch(filter interface{}) *GraphLookupOptions {
+	gl.RestrictSearchWithMatch = filter
+	return gl
+}
+
+// MergeOptions represents options that can be used to configure a $merge stage.
+type MergeOptions struct {
+	// The fields that identify the document in the output collection that an output document matches. The default
+	// value is nil, which means that documents are matched by _id.
+	On []string
+
+	// Variables that can be used in the WhenMatchedPipeline. The default value is nil.
+	Let interface{}
+
+	// The action to take when an output document matches an existing document: "replace", "keepExisting", "merge",
+	// or "fail". The default value is nil, which means "merge". This cannot be set together with WhenMatchedPipeline.
+	WhenMatched *string
+
+	// A pipeline that updates the existing document when an output document matches it. The pipeline can only contain
+	// $addFields, $set, $project, $unset, $replaceRoot, and $replaceWith stages. The default value is nil.
+	WhenMatchedPipeline *PipelineBuilder
+
+	// The action to take when an output document does not match an existing document: "insert", "discard", or
+	// "fail". The default value is nil, which means "insert".
+	WhenNotMatched *string
+}
+
+// NewMergeOptions creates a new MergeOptions instance.
+func NewMergeOptions() *MergeOptions {
+	return &MergeOptions{}
+}
+
+// SetOn sets the value for the On field.
+func (mo *MergeOptions) SetOn(fields ...string) *MergeOptions {
+	mo.On = fields
+	return mo
+}
+
+// SetLet sets the value for the Let field.
+func (mo *MergeOptions) SetLet(let interface{}) *MergeOptions {
+	mo.Let = let
+	return mo
+}
+
+// SetWhenMatched sets the value for the WhenMatched field.
+func (mo *MergeOptions) SetWhenMatched(action string) *MergeOptions {
+	mo.WhenMatched = &action
+	return mo
+}
+
+// SetWhenMatchedPipeline sets the value for the WhenMatchedPipeline field.
+func (mo *MergeOptions) SetWhenMatchedPipeline(pipeline *PipelineBuilder) *MergeOptions {
+	mo.WhenMatchedPipeline = pipeline
+	return mo
+}
+
+// SetWhenNotMatched sets the value for the WhenNotMatched field.
+func (mo *MergeOptions) SetWhenNotMatched(action string) *MergeOptions {
+	mo.WhenNotMatched = &action
+	return mo
+}
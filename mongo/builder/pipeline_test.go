@@ -0,0 +1,138 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package builder
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestPipeline(t *testing.T) {
+	t.Run("stages", func(t *testing.T) {
+		pipeline, err := Pipeline().
+			Match(Eq("status", "A")).
+			Lookup("inventory", "item", "sku", "stock").
+			Unwind("$stock", NewUnwindOptions().SetPreserveNullAndEmptyArrays(true)).
+			Group("$cust_id", Sum("total", "$amount"), Push("items", "$item")).
+			Sort(bson.D{{"total", -1}}).
+			Limit(10).
+			Merge("reports", "totals", NewMergeOptions().SetOn("_id").SetWhenMatched("replace")).
+			Build()
+		assert.Nil(t, err, "Build error: %v", err)
+
+		expected := mongo.Pipeline{
+			{{"$match", bson.D{{"status", bson.D{{"$eq", "A"}}}}}},
+			{{"$lookup", bson.D{{"from", "inventory"}, {"localField", "item"}, {"foreignField", "sku"}, {"as", "stock"}}}},
+			{{"$unwind", bson.D{{"path", "$stock"}, {"preserveNullAndEmptyArrays", true}}}},
+			{{"$group", bson.D{
+				{"_id", "$cust_id"},
+				{"total", bson.D{{"$sum", "$amount"}}},
+				{"items", bson.D{{"$push", "$item"}}},
+			}}},
+			{{"$sort", bson.D{{"total", -1}}}},
+			{{"$limit", int64(10)}},
+			{{"$merge", bson.D{
+				{"into", bson.D{{"db", "reports"}, {"coll", "totals"}}},
+				{"on", []string{"_id"}},
+				{"whenMatched", "replace"},
+			}}},
+		}
+		assert.Equal(t, expected, pipeline, "expected pipeline %v, got %v", expected, pipeline)
+	})
+	t.Run("sub-pipelines", func(t *testing.T) {
+		pipeline, err := Pipeline().
+			LookupPipeline("orders", bson.D{{"id", "$_id"}}, Pipeline().Match(Expr(bson.D{{"$eq", bson.A{"$cust", "$$id"}}})), "orders").
+			Facet(
+				Facet{Name: "count", Pipeline: Pipeline().Stage("$count", "n")},
+				Facet{Name: "buckets", Pipeline: Pipeline().Bucket("$price", []interface{}{0, 100}, NewBucketOptions().SetDefault("other"))},
+			).
+			UnionWith("archive", nil).
+			Build()
+		assert.Nil(t, err, "Build error: %v", err)
+
+		expected := mongo.Pipeline{
+			{{"$lookup", bson.D{
+				{"from", "orders"},
+				{"let", bson.D{{"id", "$_id"}}},
+				{"pipeline", bson.A{bson.D{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$cust", "$$id"}}}}}}}}},
+				{"as", "orders"},
+			}}},
+			{{"$facet", bson.D{
+				{"count", bson.A{bson.D{{"$count", "n"}}}},
+				{"buckets", bson.A{bson.D{{"$bucket", bson.D{
+					{"groupBy", "$price"},
+					{"boundaries", bson.A{0, 100}},
+					{"default", "other"},
+				}}}}},
+			}}},
+			{{"$unionWith", "archive"}},
+		}
+		assert.Equal(t, expected, pipeline, "expected pipeline %v, got %v", expected, pipeline)
+	})
+	t.Run("merge when matched pipeline", func(t *testing.T) {
+		whenMatched := Pipeline().
+			AddFields(bson.D{{"total", bson.D{{"$add", bson.A{"$total", "$$new.total"}}}}}).
+			Stage("$unset", "tmp")
+		pipeline, err := Pipeline().
+			Merge("", "totals", NewMergeOptions().SetLet(bson.D{{"new", "$$ROOT"}}).SetWhenMatchedPipeline(whenMatched)).
+			Build()
+		assert.Nil(t, err, "Build error: %v", err)
+
+		expected := mongo.Pipeline{
+			{{"$merge", bson.D{
+				{"into", "totals"},
+				{"let", bson.D{{"new", "$$ROOT"}}},
+				{"whenMatched", bson.A{
+					bson.D{{"$addFields", bson.D{{"total", bson.D{{"$add", bson.A{"$total", "$$new.total"}}}}}}},
+					bson.D{{"$unset", "tmp"}},
+				}},
+			}}},
+		}
+		assert.Equal(t, expected, pipeline, "expected pipeline %v, got %v", expected, pipeline)
+	})
+	t.Run("errors", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			pipeline *PipelineBuilder
+			index    int
+			stage    string
+		}{
+			{"out not last", Pipeline().Out("", "coll").Match(bson.D{}), 0, "$out"},
+			{"merge not last", Pipeline().Match(bson.D{}).Merge("", "coll", nil).Limit(1), 1, "$merge"},
+			{"text not first", Pipeline().Limit(1).Match(Text("coffee")), 1, "$match"},
+			{"group duplicate field", Pipeline().Group(nil, Sum("n", 1), Avg("n", "$x")), 0, "$group"},
+			{"group dotted field", Pipeline().Group(nil, Sum("a.b", 1)), 0, "$group"},
+			{"unwind path", Pipeline().Unwind("tags", nil), 0, "$unwind"},
+			{"bucket boundaries", Pipeline().Bucket("$price", []interface{}{0}, nil), 0, "$bucket"},
+			{"graph lookup max depth", Pipeline().GraphLookup("c", "$a", "b", "a", "r", NewGraphLookupOptions().SetMaxDepth(-1)), 0, "$graphLookup"},
+			{"merge when matched", Pipeline().Merge("", "coll", NewMergeOptions().SetWhenMatched("upsert")), 0, "$merge"},
+			{"merge let", Pipeline().Merge("", "coll", NewMergeOptions().SetLet(bson.D{{"x", 1}})), 0, "$merge"},
+			{"merge when matched pipeline match", Pipeline().Merge("", "coll", NewMergeOptions().SetWhenMatchedPipeline(Pipeline().Match(bson.D{}))), 0, "$merge"},
+			{"merge when matched pipeline group", Pipeline().Merge("", "coll", NewMergeOptions().SetWhenMatchedPipeline(Pipeline().Stage("$set", bson.D{{"a", 1}}).Group(nil))), 0, "$merge"},
+			{"facet out", Pipeline().Facet(Facet{Name: "f", Pipeline: Pipeline().Out("", "coll")}), 0, "$facet"},
+			{"facet empty", Pipeline().Facet(Facet{Name: "f"}), 0, "$facet"},
+			{"lookup merge", Pipeline().LookupPipeline("c", nil, Pipeline().Merge("", "coll", nil), "r"), 0, "$lookup"},
+			{"union with out", Pipeline().UnionWith("c", Pipeline().Out("", "coll")), 0, "$unionWith"},
+			{"empty sort", Pipeline().Sort(bson.D{}), 0, "$sort"},
+			{"empty projection", Pipeline().Project(Projection()), 0, "$project"},
+			{"replace root", Pipeline().ReplaceRoot("field"), 0, "$replaceRoot"},
+			{"first error", Pipeline().Limit(0).Skip(-1), 0, "$limit"},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.pipeline.Build()
+				pe, ok := err.(PipelineError)
+				assert.True(t, ok, "expected error type %T, got %T (%v)", PipelineError{}, err, err)
+				assert.Equal(t, tc.index, pe.Index, "expected index %v, got %v", tc.index, pe.Index)
+				assert.Equal(t, tc.stage, pe.Stage, "expected stage %v, got %v", tc.stage, pe.Stage)
+			})
+		}
+	})
+}
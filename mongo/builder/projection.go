@@ -0,0 +1,90 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package builder
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// ProjectionBuilder builds a projection document, which can be used with options such as FindOptions.Projection to
+// select the fields that are returned. A projection cannot mix included and excluded fields, except for excluding the
+// _id field.
+type ProjectionBuilder struct {
+	doc bson.D
+}
+
+var _ bson.Marshaler = (*ProjectionBuilder)(nil)
+
+// Projection creates a new, empty ProjectionBuilder.
+func Projection() *ProjectionBuilder {
+	return &ProjectionBuilder{}
+}
+
+func (pb *ProjectionBuilder) add(field string, val interface{}) *ProjectionBuilder {
+	pb.doc = append(pb.doc, bson.E{Key: field, Value: val})
+	return pb
+}
+
+// Include includes the fields in the returned documents.
+func (pb *ProjectionBuilder) Include(fields ...string) *ProjectionBuilder {
+	for _, field := range fields {
+		pb.add(field, int32(1))
+	}
+	return pb
+}
+
+// Exclude excludes the fields from the returned documents.
+func (pb *ProjectionBuilder) Exclude(fields ...string) *ProjectionBuilder {
+	for _, field := range fields {
+		pb.add(field, int32(0))
+	}
+	return pb
+}
+
+// ExcludeID excludes the _id field from the returned documents. The _id field is included by default.
+func (pb *ProjectionBuilder) ExcludeID() *ProjectionBuilder {
+	return pb.add("_id", int32(0))
+}
+
+// ElemMatch includes only the first element of the array in the field that matches filter.
+func (pb *ProjectionBuilder) ElemMatch(field string, filter bson.D) *ProjectionBuilder {
+	return pb.add(field, bson.D{{Key: "$elemMatch", Value: filter}})
+}
+
+// Slice includes only the first limit elements of the array in the field, or the last limit elements if limit is
+// negative.
+func (pb *ProjectionBuilder) Slice(field string, limit int32) *ProjectionBuilder {
+	return pb.add(field, bson.D{{Key: "$slice", Value: limit}})
+}
+
+// SliceSkip includes limit elements of the array in the field after skipping the first skip elements. If skip is
+// negative, it counts from the end of the array.
+func (pb *ProjectionBuilder) SliceSkip(field string, skip, limit int32) *ProjectionBuilder {
+	return pb.add(field, bson.D{{Key: "$slice", Value: bson.A{skip, limit}}})
+}
+
+// TextScore includes the score of a text search, which is created by Text, in the field.
+func (pb *ProjectionBuilder) TextScore(field string) *ProjectionBuilder {
+	return pb.add(field, bson.D{{Key: "$meta", Value: "textScore"}})
+}
+
+// D returns a copy of the projection document. Changes to the returned document do not affect the builder, and later
+// calls to the builder do not affect the returned document.
+func (pb *ProjectionBuilder) D() bson.D {
+	return append(bson.D(nil), pb.doc...)
+}
+
+// Document returns the projection document as a bsoncore.Document.
+func (pb *ProjectionBuilder) Document() (bsoncore.Document, error) {
+	return bson.Marshal(pb.doc)
+}
+
+// MarshalBSON implements the bson.Marshaler interface.
+func (pb *ProjectionBuilder) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(pb.doc)
+}
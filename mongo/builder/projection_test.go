@@ -0,0 +1,48 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package builder
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestProjection(t *testing.T) {
+	projection := Projection().
+		Include("a", "b").
+		ExcludeID().
+		Slice("comments", -5).
+		SliceSkip("history", 10, 5).
+		ElemMatch("grades", Gte("score", 90)).
+		TextScore("score")
+	expected := bson.D{
+		{"a", int32(1)},
+		{"b", int32(1)},
+		{"_id", int32(0)},
+		{"comments", bson.D{{"$slice", int32(-5)}}},
+		{"history", bson.D{{"$slice", bson.A{int32(10), int32(5)}}}},
+		{"grades", bson.D{{"$elemMatch", bson.D{{"score", bson.D{{"$gte", 90}}}}}}},
+		{"score", bson.D{{"$meta", "textScore"}}},
+	}
+	assert.Equal(t, expected, projection.D(), "expected projection %v, got %v", expected, projection.D())
+
+	// D returns a copy, so changing it does not change the builder.
+	doc := projection.D()
+	doc[0].Value = int32(0)
+	assert.Equal(t, expected, projection.D(), "expected projection %v, got %v", expected, projection.D())
+
+	// The builder can be used directly as an option value.
+	opts := options.Find().SetProjection(Projection().Exclude("a"))
+	got, err := bson.Marshal(opts.Projection)
+	assert.Nil(t, err, "Marshal error: %v", err)
+	want, err := bson.Marshal(bson.D{{"a", int32(0)}})
+	assert.Nil(t, err, "Marshal error: %v", err)
+	assert.Equal(t, want, got, "expected %v, got %v", bson.Raw(want), bson.Raw(got))
+}
@@ -0,0 +1,257 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package builder provides typed constructors for query filters, update documents, and projections so that operator
+// names do not have to be written by hand.
+//
+// Query operators are functions that return a bson.D, which can be passed directly to methods such as
+// Collection.Find and combined with And, Or, and Nor:
+//
+//    filter := builder.And(
+//        builder.Gte("age", 21),
+//        builder.In("status", "active", "pending"),
+//    )
+//    cursor, err := coll.Find(ctx, filter)
+//
+// Updates and projections are built by chaining methods on an UpdateBuilder or a ProjectionBuilder. Both can be passed
+// directly to the driver because they implement the bson.Marshaler interface:
+//
+//    update := builder.Update().Set("status", "inactive").Inc("visits", 1)
+//    res, err := coll.UpdateOne(ctx, builder.Eq("_id", id), update)
+//
+// Aggregation pipelines are built with a PipelineBuilder, which validates each stage before the pipeline is sent to
+// the server.
+package builder
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// fieldOp creates a document that applies the operator op with value val to the field.
+func fieldOp(field, op string, val interface{}) bson.D {
+	return bson.D{{Key: field, Value: bson.D{{Key: op, Value: val}}}}
+}
+
+// Comparison query operators.
+
+// Eq creates a filter that matches documents where the value of the field equals val.
+func Eq(field string, val interface{}) bson.D {
+	return fieldOp(field, "$eq", val)
+}
+
+// Ne creates a filter that matches documents where the value of the field does not equal val.
+func Ne(field string, val interface{}) bson.D {
+	return fieldOp(field, "$ne", val)
+}
+
+// Gt creates a filter that matches documents where the value of the field is greater than val.
+func Gt(field string, val interface{}) bson.D {
+	return fieldOp(field, "$gt", val)
+}
+
+// Gte creates a filter that matches documents where the value of the field is greater than or equal to val.
+func Gte(field string, val interface{}) bson.D {
+	return fieldOp(field, "$gte", val)
+}
+
+// Lt creates a filter that matches documents where the value of the field is less than val.
+func Lt(field string, val interface{}) bson.D {
+	return fieldOp(field, "$lt", val)
+}
+
+// Lte creates a filter that matches documents where the value of the field is less than or equal to val.
+func Lte(field string, val interface{}) bson.D {
+	return fieldOp(field, "$lte", val)
+}
+
+// In creates a filter that matches documents where the value of the field equals any of vals.
+func In(field string, vals ...interface{}) bson.D {
+	return fieldOp(field, "$in", bson.A(vals))
+}
+
+// Nin creates a filter that matches documents where the value of the field equals none of vals or the field does not
+// exist.
+func Nin(field string, vals ...interface{}) bson.D {
+	return fieldOp(field, "$nin", bson.A(vals))
+}
+
+// Logical query operators.
+
+// And creates a filter that matches documents that match all of filters.
+func And(filters ...bson.D) bson.D {
+	return bson.D{{Key: "$and", Value: filterArray(filters)}}
+}
+
+// Or creates a filter that matches documents that match at least one of filters.
+func Or(filters ...bson.D) bson.D {
+	return bson.D{{Key: "$or", Value: filterArray(filters)}}
+}
+
+// Nor creates a filter that matches documents that match none of filters.
+func Nor(filters ...bson.D) bson.D {
+	return bson.D{{Key: "$nor", Value: filterArray(filters)}}
+}
+
+// Not creates a filter that matches documents where the value of the field does not match the operator expression
+// expr, such as bson.D{{"$gt", 5}}, or where the field does not exist. The expression can also be a regular
+// expression of type primitive.Regex.
+func Not(field string, expr interface{}) bson.D {
+	return fieldOp(field, "$not", expr)
+}
+
+func filterArray(filters []bson.D) bson.A {
+	arr := make(bson.A, 0, len(filters))
+	for _, f := range filters {
+		arr = append(arr, f)
+	}
+	return arr
+}
+
+// Element query operators.
+
+// Exists creates a filter that matches documents that contain the field if exists is true, or documents that do not
+// contain the field if exists is false.
+func Exists(field string, exists bool) bson.D {
+	return fieldOp(field, "$exists", exists)
+}
+
+// Type creates a filter that matches documents where the value of the field is of any of the given BSON types.
+func Type(field string, types ...bsontype.Type) bson.D {
+	arr := make(bson.A, 0, len(types))
+	for _, t := range types {
+		arr = append(arr, int32(t))
+	}
+	return fieldOp(field, "$type", arr)
+}
+
+// Evaluation query operators.
+
+// Expr creates a filter that matches documents for which the aggregation expression expr evaluates to true.
+func Expr(expr interface{}) bson.D {
+	return bson.D{{Key: "$expr", Value: expr}}
+}
+
+// Mod creates a filter that matches documents where the value of the field divided by divisor has the given
+// remainder.
+func Mod(field string, divisor, remainder int64) bson.D {
+	return fieldOp(field, "$mod", bson.A{divisor, remainder})
+}
+
+// Regex creates a filter that matches documents where the value of the field matches the regular expression pattern
+// with the given options, such as "i" for case insensitive matching.
+func Regex(field, pattern, options string) bson.D {
+	return bson.D{{Key: field, Value: primitive.Regex{Pattern: pattern, Options: options}}}
+}
+
+// Text creates a filter that performs a text search for search on the fields of the collection's text index.
+func Text(search string) bson.D {
+	return bson.D{{Key: "$text", Value: bson.D{{Key: "$search", Value: search}}}}
+}
+
+// Array query operators.
+
+// All creates a filter that matches documents where the value of the field is an array that contains all of vals.
+func All(field string, vals ...interface{}) bson.D {
+	return fieldOp(field, "$all", bson.A(vals))
+}
+
+// ElemMatch creates a filter that matches documents where the value of the field is an array that contains at least
+// one element that matches filter.
+func ElemMatch(field string, filter bson.D) bson.D {
+	return fieldOp(field, "$elemMatch", filter)
+}
+
+// Size creates a filter that matches documents where the value of the field is an array with size elements.
+func Size(field string, size int32) bson.D {
+	return fieldOp(field, "$size", size)
+}
+
+// Geospatial query operators.
+
+// Point creates a GeoJSON point with the given longitude and latitude.
+func Point(longitude, latitude float64) bson.D {
+	return bson.D{
+		{Key: "type", Value: "Point"},
+		{Key: "coordinates", Value: bson.A{longitude, latitude}},
+	}
+}
+
+// Polygon creates a GeoJSON polygon from rings of [longitude, latitude] positions. The first ring is the exterior of
+// the polygon and any other rings are holes. Each ring must be closed, meaning that its first and last positions are
+// the same.
+func Polygon(rings ...[][2]float64) bson.D {
+	coords := make(bson.A, 0, len(rings))
+	for _, ring := range rings {
+		positions := make(bson.A, 0, len(ring))
+		for _, pos := range ring {
+			positions = append(positions, bson.A{pos[0], pos[1]})
+		}
+		coords = append(coords, positions)
+	}
+	return bson.D{
+		{Key: "type", Value: "Polygon"},
+		{Key: "coordinates", Value: coords},
+	}
+}
+
+// GeoWithin creates a filter that matches documents with geospatial data in the field that is entirely within the
+// GeoJSON geometry, such as one created by Polygon.
+func GeoWithin(field string, geometry interface{}) bson.D {
+	return fieldOp(field, "$geoWithin", bson.D{{Key: "$geometry", Value: geometry}})
+}
+
+// GeoWithinBox creates a filter that matches documents with legacy coordinate pairs in the field that are within the
+// box with the given bottom left and upper right corners.
+func GeoWithinBox(field string, bottomLeftX, bottomLeftY, upperRightX, upperRightY float64) bson.D {
+	box := bson.A{bson.A{bottomLeftX, bottomLeftY}, bson.A{upperRightX, upperRightY}}
+	return fieldOp(field, "$geoWithin", bson.D{{Key: "$box", Value: box}})
+}
+
+// GeoWithinCenter creates a filter that matches documents with legacy coordinate pairs in the field that are within the
+// circle with the given center and radius on a flat surface.
+func GeoWithinCenter(field string, x, y, radius float64) bson.D {
+	center := bson.A{bson.A{x, y}, radius}
+	return fieldOp(field, "$geoWithin", bson.D{{Key: "$center", Value: center}})
+}
+
+// GeoWithinCenterSphere creates a filter that matches documents with geospatial data in the field that is within the
+// circle with the given center and radius in radians on a sphere.
+func GeoWithinCenterSphere(field string, x, y, radius float64) bson.D {
+	center := bson.A{bson.A{x, y}, radius}
+	return fieldOp(field, "$geoWithin", bson.D{{Key: "$centerSphere", Value: center}})
+}
+
+// GeoIntersects creates a filter that matches documents with geospatial data in the field that intersects the GeoJSON
+// geometry.
+func GeoIntersects(field string, geometry interface{}) bson.D {
+	return fieldOp(field, "$geoIntersects", bson.D{{Key: "$geometry", Value: geometry}})
+}
+
+// Near creates a filter that matches documents with geospatial data in the field, sorted from nearest to farthest from
+// the GeoJSON point. The maxDistance and minDistance are in meters and are not sent if they are nil.
+func Near(field string, point bson.D, maxDistance, minDistance *float64) bson.D {
+	return fieldOp(field, "$near", nearDoc(point, maxDistance, minDistance))
+}
+
+// NearSphere creates a filter that matches documents with geospatial data in the field, sorted from nearest to
+// farthest from the GeoJSON point using spherical geometry. The maxDistance and minDistance are in meters and are not
+// sent if they are nil.
+func NearSphere(field string, point bson.D, maxDistance, minDistance *float64) bson.D {
+	return fieldOp(field, "$nearSphere", nearDoc(point, maxDistance, minDistance))
+}
+
+func nearDoc(point bson.D, maxDistance, minDistance *float64) bson.D {
+	doc := bson.D{{Key: "$geometry", Value: point}}
+	if maxDistance != nil {
+		doc = append(doc, bson.E{Key: "$maxDistance", Value: *maxDistance})
+	}
+	if minDistance != nil {
+		doc = append(doc, bson.E{Key: "$minDistance", Value: *minDistance})
+	}
+	return doc
+}
@@ -0,0 +1,102 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package builder
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+)
+
+func TestQuery(t *testing.T) {
+	maxDistance := 100.0
+	testCases := []struct {
+		name     string
+		filter   bson.D
+		expected bson.D
+	}{
+		{"Eq", Eq("x", 1), bson.D{{"x", bson.D{{"$eq", 1}}}}},
+		{"Ne", Ne("x", 1), bson.D{{"x", bson.D{{"$ne", 1}}}}},
+		{"Gt", Gt("x", 1), bson.D{{"x", bson.D{{"$gt", 1}}}}},
+		{"Gte", Gte("x", 1), bson.D{{"x", bson.D{{"$gte", 1}}}}},
+		{"Lt", Lt("x", 1), bson.D{{"x", bson.D{{"$lt", 1}}}}},
+		{"Lte", Lte("x", 1), bson.D{{"x", bson.D{{"$lte", 1}}}}},
+		{"In", In("x", 1, "a"), bson.D{{"x", bson.D{{"$in", bson.A{1, "a"}}}}}},
+		{"Nin", Nin("x", 1, "a"), bson.D{{"x", bson.D{{"$nin", bson.A{1, "a"}}}}}},
+		{
+			"And",
+			And(Eq("x", 1), Exists("y", false)),
+			bson.D{{"$and", bson.A{
+				bson.D{{"x", bson.D{{"$eq", 1}}}},
+				bson.D{{"y", bson.D{{"$exists", false}}}},
+			}}},
+		},
+		{"Or", Or(Eq("x", 1)), bson.D{{"$or", bson.A{bson.D{{"x", bson.D{{"$eq", 1}}}}}}}},
+		{"Nor", Nor(Eq("x", 1)), bson.D{{"$nor", bson.A{bson.D{{"x", bson.D{{"$eq", 1}}}}}}}},
+		{"Not", Not("x", bson.D{{"$gt", 5}}), bson.D{{"x", bson.D{{"$not", bson.D{{"$gt", 5}}}}}}},
+		{
+			"Type",
+			Type("x", bsontype.String, bsontype.Int32),
+			bson.D{{"x", bson.D{{"$type", bson.A{int32(2), int32(16)}}}}},
+		},
+		{"Expr", Expr(bson.D{{"$gt", bson.A{"$a", "$b"}}}), bson.D{{"$expr", bson.D{{"$gt", bson.A{"$a", "$b"}}}}}},
+		{"Mod", Mod("x", 4, 0), bson.D{{"x", bson.D{{"$mod", bson.A{int64(4), int64(0)}}}}}},
+		{"Regex", Regex("x", "^a", "i"), bson.D{{"x", primitive.Regex{Pattern: "^a", Options: "i"}}}},
+		{"Text", Text("coffee"), bson.D{{"$text", bson.D{{"$search", "coffee"}}}}},
+		{"All", All("x", 1, 2), bson.D{{"x", bson.D{{"$all", bson.A{1, 2}}}}}},
+		{
+			"ElemMatch",
+			ElemMatch("x", Gt("y", 1)),
+			bson.D{{"x", bson.D{{"$elemMatch", bson.D{{"y", bson.D{{"$gt", 1}}}}}}}},
+		},
+		{"Size", Size("x", 2), bson.D{{"x", bson.D{{"$size", int32(2)}}}}},
+		{
+			"GeoWithin",
+			GeoWithin("loc", Polygon([][2]float64{{0, 0}, {1, 0}, {1, 1}, {0, 0}})),
+			bson.D{{"loc", bson.D{{"$geoWithin", bson.D{{"$geometry", bson.D{
+				{"type", "Polygon"},
+				{"coordinates", bson.A{bson.A{
+					bson.A{0.0, 0.0}, bson.A{1.0, 0.0}, bson.A{1.0, 1.0}, bson.A{0.0, 0.0},
+				}}},
+			}}}}}}},
+		},
+		{
+			"GeoWithinBox",
+			GeoWithinBox("loc", 0, 0, 1, 1),
+			bson.D{{"loc", bson.D{{"$geoWithin", bson.D{{"$box", bson.A{bson.A{0.0, 0.0}, bson.A{1.0, 1.0}}}}}}}},
+		},
+		{
+			"GeoWithinCenterSphere",
+			GeoWithinCenterSphere("loc", 1, 2, 0.5),
+			bson.D{{"loc", bson.D{{"$geoWithin", bson.D{{"$centerSphere", bson.A{bson.A{1.0, 2.0}, 0.5}}}}}}},
+		},
+		{
+			"GeoIntersects",
+			GeoIntersects("loc", Point(1, 2)),
+			bson.D{{"loc", bson.D{{"$geoIntersects", bson.D{{"$geometry", bson.D{
+				{"type", "Point"},
+				{"coordinates", bson.A{1.0, 2.0}},
+			}}}}}}},
+		},
+		{
+			"Near",
+			Near("loc", Point(1, 2), &maxDistance, nil),
+			bson.D{{"loc", bson.D{{"$near", bson.D{
+				{"$geometry", bson.D{{"type", "Point"}, {"coordinates", bson.A{1.0, 2.0}}}},
+				{"$maxDistance", 100.0},
+			}}}}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.filter, "expected filter %v, got %v", tc.expected, tc.filter)
+		})
+	}
+}
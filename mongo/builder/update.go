@@ -0,0 +1,206 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package builder
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// UpdateBuilder builds an update document from update operators. Multiple updates to different fields with the same
+// operator are grouped under a single operator key in the order they were added.
+type UpdateBuilder struct {
+	doc bson.D
+}
+
+var _ bson.Marshaler = (*UpdateBuilder)(nil)
+
+// Update creates a new, empty UpdateBuilder.
+func Update() *UpdateBuilder {
+	return &UpdateBuilder{}
+}
+
+// add appends the field and value to the document for the operator op, creating it if necessary.
+func (ub *UpdateBuilder) add(op, field string, val interface{}) *UpdateBuilder {
+	for i, elem := range ub.doc {
+		if elem.Key == op {
+			ub.doc[i].Value = append(elem.Value.(bson.D), bson.E{Key: field, Value: val})
+			return ub
+		}
+	}
+	ub.doc = append(ub.doc, bson.E{Key: op, Value: bson.D{{Key: field, Value: val}}})
+	return ub
+}
+
+// Field update operators.
+
+// Set sets the value of the field to val.
+func (ub *UpdateBuilder) Set(field string, val interface{}) *UpdateBuilder {
+	return ub.add("$set", field, val)
+}
+
+// SetOnInsert sets the value of the field to val if the update results in an insert. It has no effect on updates to
+// existing documents.
+func (ub *UpdateBuilder) SetOnInsert(field string, val interface{}) *UpdateBuilder {
+	return ub.add("$setOnInsert", field, val)
+}
+
+// Unset removes the field.
+func (ub *UpdateBuilder) Unset(field string) *UpdateBuilder {
+	return ub.add("$unset", field, "")
+}
+
+// Inc increments the value of the field by amount, which can be negative.
+func (ub *UpdateBuilder) Inc(field string, amount interface{}) *UpdateBuilder {
+	return ub.add("$inc", field, amount)
+}
+
+// Mul multiplies the value of the field by factor.
+func (ub *UpdateBuilder) Mul(field string, factor interface{}) *UpdateBuilder {
+	return ub.add("$mul", field, factor)
+}
+
+// Min sets the value of the field to val if val is less than the current value.
+func (ub *UpdateBuilder) Min(field string, val interface{}) *UpdateBuilder {
+	return ub.add("$min", field, val)
+}
+
+// Max sets the value of the field to val if val is greater than the current value.
+func (ub *UpdateBuilder) Max(field string, val interface{}) *UpdateBuilder {
+	return ub.add("$max", field, val)
+}
+
+// Rename renames the field to newName.
+func (ub *UpdateBuilder) Rename(field, newName string) *UpdateBuilder {
+	return ub.add("$rename", field, newName)
+}
+
+// CurrentDate sets the value of the field to the current date as a BSON datetime.
+func (ub *UpdateBuilder) CurrentDate(field string) *UpdateBuilder {
+	return ub.add("$currentDate", field, true)
+}
+
+// CurrentTimestamp sets the value of the field to the current date as a BSON timestamp.
+func (ub *UpdateBuilder) CurrentTimestamp(field string) *UpdateBuilder {
+	return ub.add("$currentDate", field, bson.D{{Key: "$type", Value: "timestamp"}})
+}
+
+// Array update operators.
+
+// AddToSet adds val to the array in the field unless it is already present.
+func (ub *UpdateBuilder) AddToSet(field string, val interface{}) *UpdateBuilder {
+	return ub.add("$addToSet", field, val)
+}
+
+// AddToSetEach adds each of vals to the array in the field unless it is already present.
+func (ub *UpdateBuilder) AddToSetEach(field string, vals ...interface{}) *UpdateBuilder {
+	return ub.add("$addToSet", field, bson.D{{Key: "$each", Value: bson.A(vals)}})
+}
+
+// PopFirst removes the first element of the array in the field.
+func (ub *UpdateBuilder) PopFirst(field string) *UpdateBuilder {
+	return ub.add("$pop", field, int32(-1))
+}
+
+// PopLast removes the last element of the array in the field.
+func (ub *UpdateBuilder) PopLast(field string) *UpdateBuilder {
+	return ub.add("$pop", field, int32(1))
+}
+
+// Pull removes all elements of the array in the field that are equal to val. The value can also be a query operator
+// expression, such as bson.D{{"$gte", 6}}, or a filter on the fields of embedded documents, in which case the
+// elements that match it are removed.
+func (ub *UpdateBuilder) Pull(field string, val interface{}) *UpdateBuilder {
+	return ub.add("$pull", field, val)
+}
+
+// PullAll removes all elements of the array in the field that are equal to any of vals.
+func (ub *UpdateBuilder) PullAll(field string, vals ...interface{}) *UpdateBuilder {
+	return ub.add("$pullAll", field, bson.A(vals))
+}
+
+// Push appends val to the array in the field.
+func (ub *UpdateBuilder) Push(field string, val interface{}) *UpdateBuilder {
+	return ub.add("$push", field, val)
+}
+
+// PushEach appends each of vals to the array in the field. The modifiers can be nil.
+func (ub *UpdateBuilder) PushEach(field string, modifiers *PushModifiers, vals ...interface{}) *UpdateBuilder {
+	doc := bson.D{{Key: "$each", Value: bson.A(vals)}}
+	if modifiers != nil {
+		if modifiers.Position != nil {
+			doc = append(doc, bson.E{Key: "$position", Value: *modifiers.Position})
+		}
+		if modifiers.Slice != nil {
+			doc = append(doc, bson.E{Key: "$slice", Value: *modifiers.Slice})
+		}
+		if modifiers.Sort != nil {
+			doc = append(doc, bson.E{Key: "$sort", Value: modifiers.Sort})
+		}
+	}
+	return ub.add("$push", field, doc)
+}
+
+// D returns a copy of the update document. Changes to the returned document do not affect the builder, and later calls
+// to the builder do not affect the returned document.
+func (ub *UpdateBuilder) D() bson.D {
+	doc := make(bson.D, len(ub.doc))
+	for i, elem := range ub.doc {
+		fields := elem.Value.(bson.D)
+		doc[i] = bson.E{Key: elem.Key, Value: append(bson.D(nil), fields...)}
+	}
+	return doc
+}
+
+// Document returns the update document as a bsoncore.Document.
+func (ub *UpdateBuilder) Document() (bsoncore.Document, error) {
+	return bson.Marshal(ub.doc)
+}
+
+// MarshalBSON implements the bson.Marshaler interface.
+func (ub *UpdateBuilder) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(ub.doc)
+}
+
+// PushModifiers represents the modifiers that can be used with PushEach.
+type PushModifiers struct {
+	// The index in the array at which the values are inserted. A negative index counts from the end of the array. The
+	// default value is nil, which means that the values are appended to the end of the array.
+	Position *int32
+
+	// The number of elements that the array is trimmed to after the values are added. A negative number keeps the
+	// elements at the end of the array. The default value is nil, which means that the array is not trimmed.
+	Slice *int32
+
+	// The order in which the elements of the array are sorted after the values are added. This is either 1 or -1 to
+	// sort the elements themselves, or a document that maps element fields to 1 or -1. The default value is nil,
+	// which means that the array is not sorted.
+	Sort interface{}
+}
+
+// NewPushModifiers creates a new PushModifiers instance.
+func NewPushModifiers() *PushModifiers {
+	return &PushModifiers{}
+}
+
+// SetPosition sets the value for the Position field.
+func (pm *PushModifiers) SetPosition(position int32) *PushModifiers {
+	pm.Position = &position
+	return pm
+}
+
+// SetSlice sets the value for the Slice field.
+func (pm *PushModifiers) SetSlice(slice int32) *PushModifiers {
+	pm.Slice = &slice
+	return pm
+}
+
+// SetSort sets the value for the Sort field.
+func (pm *PushModifiers) SetSort(sort interface{}) *PushModifiers {
+	pm.Sort = sort
+	return pm
+}
@@ -0,0 +1,70 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package builder
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Run("operators are grouped", func(t *testing.T) {
+		update := Update().Set("a", 1).Inc("b", 2).Set("c", "x").Unset("d").CurrentTimestamp("e")
+		expected := bson.D{
+			{"$set", bson.D{{"a", 1}, {"c", "x"}}},
+			{"$inc", bson.D{{"b", 2}}},
+			{"$unset", bson.D{{"d", ""}}},
+			{"$currentDate", bson.D{{"e", bson.D{{"$type", "timestamp"}}}}},
+		}
+		assert.Equal(t, expected, update.D(), "expected update %v, got %v", expected, update.D())
+	})
+	t.Run("array operators", func(t *testing.T) {
+		update := Update().
+			AddToSetEach("tags", "a", "b").
+			PopFirst("queue").
+			PullAll("scores", 0, 5).
+			PushEach("items", NewPushModifiers().SetPosition(0).SetSlice(-5).SetSort(bson.D{{"score", -1}}), 1, 2)
+		expected := bson.D{
+			{"$addToSet", bson.D{{"tags", bson.D{{"$each", bson.A{"a", "b"}}}}}},
+			{"$pop", bson.D{{"queue", int32(-1)}}},
+			{"$pullAll", bson.D{{"scores", bson.A{0, 5}}}},
+			{"$push", bson.D{{"items", bson.D{
+				{"$each", bson.A{1, 2}},
+				{"$position", int32(0)},
+				{"$slice", int32(-5)},
+				{"$sort", bson.D{{"score", -1}}},
+			}}}},
+		}
+		assert.Equal(t, expected, update.D(), "expected update %v, got %v", expected, update.D())
+	})
+	t.Run("D returns a copy", func(t *testing.T) {
+		update := Update().Set("a", 1)
+		doc := update.D()
+		doc[0].Value.(bson.D)[0].Value = 2
+		doc = append(doc, bson.E{Key: "$inc", Value: bson.D{{"b", 1}}})
+		update.Set("c", 3)
+
+		expected := bson.D{{"$set", bson.D{{"a", 1}, {"c", 3}}}}
+		assert.Equal(t, expected, update.D(), "expected update %v, got %v", expected, update.D())
+		expectedDoc := bson.D{{"$set", bson.D{{"a", 2}}}, {"$inc", bson.D{{"b", 1}}}}
+		assert.Equal(t, expectedDoc, doc, "expected document %v, got %v", expectedDoc, doc)
+	})
+	t.Run("marshal", func(t *testing.T) {
+		update := Update().Set("a", int32(1))
+		got, err := bson.Marshal(update)
+		assert.Nil(t, err, "Marshal error: %v", err)
+		expected, err := bson.Marshal(bson.D{{"$set", bson.D{{"a", int32(1)}}}})
+		assert.Nil(t, err, "Marshal error: %v", err)
+		assert.Equal(t, expected, got, "expected %v, got %v", bson.Raw(expected), bson.Raw(got))
+
+		doc, err := update.Document()
+		assert.Nil(t, err, "Document error: %v", err)
+		assert.Equal(t, expected, []byte(doc), "expected %v, got %v", bson.Raw(expected), doc)
+	})
+}
@@ -8,8 +8,10 @@ package mongo
 
 import (
 	"context"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/internal"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
@@ -33,10 +35,15 @@ type bulkWrite struct {
 	collection               *Collection
 	selector                 description.ServerSelector
 	writeConcern             *writeconcern.WriteConcern
+	timeout                  *time.Duration
 	result                   BulkWriteResult
 }
 
 func (bw *bulkWrite) execute(ctx context.Context) error {
+	// The timeout applies to the bulk write as a whole rather than to each batch.
+	ctx, cancel := internal.MakeTimeoutContext(ctx, bw.timeout)
+	defer cancel()
+
 	ordered := true
 	if bw.ordered != nil {
 		ordered = *bw.ordered
@@ -184,7 +191,8 @@ func (bw *bulkWrite) runInsert(ctx context.Context, batch bulkWriteBatch) (opera
 		Session(bw.session).WriteConcern(bw.writeConcern).CommandMonitor(bw.collection.client.monitor).
 		ServerSelector(bw.selector).ClusterClock(bw.collection.client.clock).
 		Database(bw.collection.db.name).Collection(bw.collection.name).
-		Deployment(bw.collection.client.deployment).Crypt(bw.collection.client.crypt)
+		Deployment(bw.collection.client.deployment).Crypt(bw.collection.client.crypt).
+		ServerAPI(bw.collection.client.serverAPI).Timeout(bw.timeout)
 	if bw.bypassDocumentValidation != nil && *bw.bypassDocumentValidation {
 		op = op.BypassDocumentValidation(*bw.bypassDocumentValidation)
 	}
@@ -233,7 +241,8 @@ func (bw *bulkWrite) runDelete(ctx context.Context, batch bulkWriteBatch) (opera
 		Session(bw.session).WriteConcern(bw.writeConcern).CommandMonitor(bw.collection.client.monitor).
 		ServerSelector(bw.selector).ClusterClock(bw.collection.client.clock).
 		Database(bw.collection.db.name).Collection(bw.collection.name).
-		Deployment(bw.collection.client.deployment).Crypt(bw.collection.client.crypt).Hint(hasHint)
+		Deployment(bw.collection.client.deployment).Crypt(bw.collection.client.crypt).
+		ServerAPI(bw.collection.client.serverAPI).Hint(hasHint).Timeout(bw.timeout)
 	if bw.ordered != nil {
 		op = op.Ordered(*bw.ordered)
 	}
@@ -310,7 +319,8 @@ func (bw *bulkWrite) runUpdate(ctx context.Context, batch bulkWriteBatch) (opera
 		Session(bw.session).WriteConcern(bw.writeConcern).CommandMonitor(bw.collection.client.monitor).
 		ServerSelector(bw.selector).ClusterClock(bw.collection.client.clock).
 		Database(bw.collection.db.name).Collection(bw.collection.name).
-		Deployment(bw.collection.client.deployment).Crypt(bw.collection.client.crypt).Hint(hasHint)
+		Deployment(bw.collection.client.deployment).Crypt(bw.collection.client.crypt).
+		ServerAPI(bw.collection.client.serverAPI).Hint(hasHint).Timeout(bw.timeout)
 	if bw.ordered != nil {
 		op = op.Ordered(*bw.ordered)
 	}
@@ -298,3 +298,29 @@ func (umm *UpdateManyModel) SetUpsert(upsert bool) *UpdateManyModel {
 }
 
 func (*UpdateManyModel) writeModel() {}
+
+// ClientWriteModel is used to apply a WriteModel to a specific collection in a Client.BulkWrite operation.
+type ClientWriteModel struct {
+	Database   string
+	Collection string
+	Model      WriteModel
+}
+
+// NewClientWriteModel creates a new ClientWriteModel.
+func NewClientWriteModel() *ClientWriteModel {
+	return &ClientWriteModel{}
+}
+
+// SetNamespace specifies the database and collection that the write applies to. Both must be non-empty.
+func (cwm *ClientWriteModel) SetNamespace(database, collection string) *ClientWriteModel {
+	cwm.Database = database
+	cwm.Collection = collection
+	return cwm
+}
+
+// SetModel specifies the write to apply. It must be an InsertOneModel, DeleteOneModel, DeleteManyModel,
+// ReplaceOneModel, UpdateOneModel, or UpdateManyModel and cannot be nil.
+func (cwm *ClientWriteModel) SetModel(model WriteModel) *ClientWriteModel {
+	cwm.Model = model
+	return cwm
+}
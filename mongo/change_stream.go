@@ -93,6 +93,7 @@ type changeStreamConfig struct {
 	collectionName string
 	databaseName   string
 	crypt          *driver.Crypt
+	timeout        *time.Duration
 }
 
 func newChangeStream(ctx context.Context, config changeStreamConfig, pipeline interface{},
@@ -125,11 +126,17 @@ func newChangeStream(ctx context.Context, config changeStreamConfig, pipeline in
 		ReadPreference(config.readPreference).ReadConcern(config.readConcern).
 		Deployment(cs.client.deployment).ClusterClock(cs.client.clock).
 		CommandMonitor(cs.client.monitor).Session(cs.sess).ServerSelector(cs.selector).Retry(driver.RetryNone).
-		Crypt(config.crypt)
+		Crypt(config.crypt).ServerAPI(cs.client.serverAPI)
 
 	if config.crypt != nil {
 		cs.cursorOptions.Crypt = config.crypt
 	}
+	timeout := config.timeout
+	if cs.options.Timeout != nil {
+		timeout = cs.options.Timeout
+	}
+	cs.aggregate.Timeout(timeout)
+	cs.cursorOptions.Timeout = timeout
 	if cs.options.Collation != nil {
 		cs.aggregate.Collation(bsoncore.Document(cs.options.Collation.ToDocument()))
 	}
@@ -141,6 +148,7 @@ func newChangeStream(ctx context.Context, config changeStreamConfig, pipeline in
 		cs.cursorOptions.MaxTimeMS = int64(time.Duration(*cs.options.MaxAwaitTime) / time.Millisecond)
 	}
 	cs.cursorOptions.CommandMonitor = cs.client.monitor
+	cs.cursorOptions.ServerAPI = cs.client.serverAPI
 
 	switch cs.streamType {
 	case ClientStream:
@@ -39,11 +39,11 @@ func (c *changeStreamDeployment) Connection(context.Context) (driver.Connection,
 	return c.conn, nil
 }
 
-func (c *changeStreamDeployment) ProcessError(err error) {
+func (c *changeStreamDeployment) ProcessError(err error, conn driver.Connection) {
 	ep, ok := c.server.(driver.ErrorProcessor)
 	if !ok {
 		return
 	}
 
-	ep.ProcessError(err)
+	ep.ProcessError(err, conn)
 }
@@ -16,6 +16,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsoncodec"
 	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/internal/logger"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -62,7 +63,10 @@ type Client struct {
 	registry        *bsoncodec.Registry
 	marshaller      BSONAppender
 	monitor         *event.CommandMonitor
+	logger          *logger.Logger
 	sessionPool     *session.Pool
+	timeout         *time.Duration
+	serverAPI       *driver.ServerAPIOptions
 
 	// client-side encryption fields
 	keyVaultClient *Client
@@ -205,6 +209,8 @@ func (c *Client) Disconnect(ctx context.Context) error {
 		c.crypt.Close()
 	}
 
+	defer func() { _ = c.logger.Close() }()
+
 	if disconnector, ok := c.deployment.(driver.Disconnector); ok {
 		return replaceErrors(disconnector.Disconnect(ctx))
 	}
@@ -269,6 +275,9 @@ func (c *Client) StartSession(opts ...*options.SessionOptions) (Session, error)
 	if sopts.DefaultMaxCommitTime != nil {
 		coreOpts.DefaultMaxCommitTime = sopts.DefaultMaxCommitTime
 	}
+	if sopts.Snapshot != nil {
+		coreOpts.Snapshot = sopts.Snapshot
+	}
 
 	sess, err := session.NewClientSession(c.sessionPool, c.id, session.Explicit, coreOpts)
 	if err != nil {
@@ -293,7 +302,7 @@ func (c *Client) endSessions(ctx context.Context) {
 	sessionIDs := c.sessionPool.IDSlice()
 	op := operation.NewEndSessions(nil).ClusterClock(c.clock).Deployment(c.deployment).
 		ServerSelector(description.ReadPrefSelector(readpref.PrimaryPreferred())).CommandMonitor(c.monitor).
-		Database("admin").Crypt(c.crypt)
+		Database("admin").Crypt(c.crypt).ServerAPI(c.serverAPI)
 
 	totalNumIDs := len(sessionIDs)
 	var currentBatch []bsoncore.Document
@@ -369,9 +378,27 @@ func (c *Client) configure(opts *options.ClientOptions) error {
 			func(opts ...string) []string { return append(opts, comps...) },
 		))
 	}
+	// ServerAPIOptions
+	if opts.ServerAPIOptions != nil {
+		c.serverAPI = driver.NewServerAPIOptions(string(opts.ServerAPIOptions.ServerAPIVersion))
+		c.serverAPI.Strict = opts.ServerAPIOptions.Strict
+		c.serverAPI.DeprecationErrors = opts.ServerAPIOptions.DeprecationErrors
+
+		serverOpts = append(serverOpts, topology.WithServerAPI(
+			func(*driver.ServerAPIOptions) *driver.ServerAPIOptions { return c.serverAPI },
+		))
+	}
+	// LoadBalanced
+	loadBalanced := opts.LoadBalanced != nil && *opts.LoadBalanced
+	if loadBalanced {
+		topologyOpts = append(topologyOpts, topology.WithLoadBalanced(
+			func(bool) bool { return true },
+		))
+	}
 	// Handshaker
 	var handshaker = func(driver.Handshaker) driver.Handshaker {
-		return operation.NewIsMaster().AppName(appName).Compressors(comps).ClusterClock(c.clock)
+		return operation.NewIsMaster().AppName(appName).Compressors(comps).ClusterClock(c.clock).
+			ServerAPI(c.serverAPI).LoadBalanced(loadBalanced)
 	}
 	// Auth & Database & Password & Username
 	if opts.Auth != nil {
@@ -403,6 +430,8 @@ func (c *Client) configure(opts *options.ClientOptions) error {
 			Authenticator: authenticator,
 			Compressors:   comps,
 			ClusterClock:  c.clock,
+			ServerAPI:     c.serverAPI,
+			LoadBalanced:  loadBalanced,
 		}
 		if mechanism == "" {
 			// Required for SASL mechanism negotiation during handshake
@@ -480,18 +509,43 @@ func (c *Client) configure(opts *options.ClientOptions) error {
 			topology.WithMinConnections(func(uint64) uint64 { return *opts.MinPoolSize }),
 		)
 	}
+	// LoggerOptions
+	var loggerSink logger.LogSink
+	var maxDocumentLength uint
+	componentLevels := make(map[logger.Component]logger.Level)
+	if lo := opts.LoggerOptions; lo != nil {
+		if lo.Sink != nil {
+			loggerSink = lo.Sink
+		}
+		maxDocumentLength = lo.MaxDocumentLength
+		for component, level := range lo.ComponentLevels {
+			componentLevels[logger.Component(component)] = logger.Level(level)
+		}
+	}
+	c.logger = logger.New(loggerSink, maxDocumentLength, componentLevels)
+	if c.logger != nil {
+		topologyOpts = append(topologyOpts, topology.WithLogger(
+			func(*logger.Logger) *logger.Logger { return c.logger },
+		))
+	}
 	// PoolMonitor
-	if opts.PoolMonitor != nil {
+	if poolMonitor := c.logger.PoolMonitor(opts.PoolMonitor); poolMonitor != nil {
 		serverOpts = append(
 			serverOpts,
-			topology.WithConnectionPoolMonitor(func(*event.PoolMonitor) *event.PoolMonitor { return opts.PoolMonitor }),
+			topology.WithConnectionPoolMonitor(func(*event.PoolMonitor) *event.PoolMonitor { return poolMonitor }),
 		)
 	}
 	// Monitor
-	if opts.Monitor != nil {
-		c.monitor = opts.Monitor
+	if monitor := c.logger.CommandMonitor(opts.Monitor); monitor != nil {
+		c.monitor = monitor
 		connOpts = append(connOpts, topology.WithMonitor(
-			func(*event.CommandMonitor) *event.CommandMonitor { return opts.Monitor },
+			func(*event.CommandMonitor) *event.CommandMonitor { return monitor },
+		))
+	}
+	// ServerMonitor
+	if serverMonitor := c.logger.ServerMonitor(opts.ServerMonitor); serverMonitor != nil {
+		topologyOpts = append(topologyOpts, topology.WithTopologyServerMonitor(
+			func(*event.ServerMonitor) *event.ServerMonitor { return serverMonitor },
 		))
 	}
 	// ReadConcern
@@ -538,6 +592,8 @@ func (c *Client) configure(opts *options.ClientOptions) error {
 			topology.WithWriteTimeout(func(time.Duration) time.Duration { return *opts.SocketTimeout }),
 		)
 	}
+	// Timeout
+	c.timeout = opts.Timeout
 	// TLSConfig
 	if opts.TLSConfig != nil {
 		connOpts = append(connOpts, topology.WithTLSConfig(
@@ -718,11 +774,16 @@ func (c *Client) ListDatabases(ctx context.Context, filter interface{}, opts ...
 	ldo := options.MergeListDatabasesOptions(opts...)
 	op := operation.NewListDatabases(filterDoc).
 		Session(sess).ReadPreference(c.readPreference).CommandMonitor(c.monitor).
-		ServerSelector(selector).ClusterClock(c.clock).Database("admin").Deployment(c.deployment).Crypt(c.crypt)
+		ServerSelector(selector).ClusterClock(c.clock).Database("admin").Deployment(c.deployment).Crypt(c.crypt).
+		ServerAPI(c.serverAPI).
+		Timeout(c.timeout)
 
 	if ldo.NameOnly != nil {
 		op = op.NameOnly(*ldo.NameOnly)
 	}
+	if ldo.Timeout != nil {
+		op = op.Timeout(ldo.Timeout)
+	}
 	if ldo.AuthorizedDatabases != nil {
 		op = op.AuthorizedDatabases(*ldo.AuthorizedDatabases)
 	}
@@ -828,6 +889,7 @@ func (c *Client) Watch(ctx context.Context, pipeline interface{},
 		registry:       c.registry,
 		streamType:     ClientStream,
 		crypt:          c.crypt,
+		timeout:        c.timeout,
 	}
 
 	return newChangeStream(ctx, csConfig, pipeline, opts...)
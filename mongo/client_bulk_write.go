@@ -0,0 +1,275 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/internal"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// clientWriteGroup is a group of writes to a single collection that are executed as one Collection.BulkWrite.
+type clientWriteGroup struct {
+	coll    *Collection
+	models  []WriteModel
+	indexes []int64 // the index of each model in the slice passed to Client.BulkWrite
+	ids     map[int]interface{}
+}
+
+// BulkWrite performs a bulk write operation (https://docs.mongodb.com/manual/core/bulk-write-operations/) that can
+// write to multiple collections in multiple databases.
+//
+// The models parameter must be a slice of operations to be executed in this bulk write. It cannot be nil or empty.
+// All of the models must have a namespace and a non-nil WriteModel.
+//
+// The opts parameter can be used to specify options for the operation (see the options.BulkWriteOptions
+// documentation). If the operation is ordered, which is the default, it stops at the first error. Otherwise, all of
+// the writes are attempted.
+//
+// Writes are grouped by collection and each group is executed as a Collection.BulkWrite, using the write concern of
+// the Client. For an ordered operation, consecutive writes to the same collection form a group. For an unordered
+// operation, writes of the same kind to the same collection form a group regardless of their position.
+//
+// If write errors occur, the returned error is a BulkWriteException. The Index of each BulkWriteError is the index of
+// the model in the models slice and its Request is the WriteModel of that model. The groups of an unordered operation
+// are executed concurrently. If some of them fail with errors other than write errors, e.g. network errors, and other
+// groups cause write errors or more than one group fails, the returned error is a ClientBulkWriteException that
+// contains all of them.
+func (c *Client) BulkWrite(ctx context.Context, models []*ClientWriteModel,
+	opts ...*options.BulkWriteOptions) (*ClientBulkWriteResult, error) {
+
+	if len(models) == 0 {
+		return nil, ErrEmptySlice
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	for i, model := range models {
+		if model == nil || model.Model == nil {
+			return nil, ErrNilDocument
+		}
+		if model.Database == "" || model.Collection == "" {
+			return nil, fmt.Errorf("the model at index %d must specify a database and a collection", i)
+		}
+	}
+
+	bwo := options.MergeBulkWriteOptions(opts...)
+	ordered := bwo.Ordered == nil || *bwo.Ordered
+
+	// The timeout applies to the operation as a whole rather than to each group.
+	timeout := c.timeout
+	if bwo.Timeout != nil {
+		timeout = bwo.Timeout
+	}
+	ctx, cancel := internal.MakeTimeoutContext(ctx, timeout)
+	defer cancel()
+	bwo.Timeout = nil
+
+	groups, err := c.groupClientWriteModels(models, ordered)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ClientBulkWriteResult{
+		InsertedIDs: make(map[int64]interface{}),
+		UpsertedIDs: make(map[int64]interface{}),
+	}
+	bwErr := BulkWriteException{
+		WriteErrors: make([]BulkWriteError, 0),
+	}
+
+	var errs []error
+	if ordered {
+		for _, group := range groups {
+			res, err := group.coll.BulkWrite(ctx, group.models, bwo)
+			stop, otherErr := mergeClientWriteGroup(result, &bwErr, models, group, res, err, ordered)
+			if otherErr != nil {
+				return result, otherErr
+			}
+			if stop {
+				return result, bwErr
+			}
+		}
+	} else {
+		// The groups of an unordered operation are independent, so they are executed concurrently. Sessions must not
+		// be used concurrently, so the groups are executed one at a time if ctx contains a session.
+		limit := maxConcurrentClientWriteGroups
+		if SessionFromContext(ctx) != nil {
+			limit = 1
+		}
+		outcomes := make([]clientWriteOutcome, len(groups))
+		sem := make(chan struct{}, limit)
+		var wg sync.WaitGroup
+		for i, group := range groups {
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(i int, group *clientWriteGroup) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				outcomes[i].res, outcomes[i].err = group.coll.BulkWrite(ctx, group.models, bwo)
+			}(i, group)
+		}
+		wg.Wait()
+
+		// The outcomes are merged in the order of the groups so the order of the write errors is deterministic.
+		for i, group := range groups {
+			_, otherErr := mergeClientWriteGroup(result, &bwErr, models, group, outcomes[i].res, outcomes[i].err, ordered)
+			if otherErr != nil {
+				errs = append(errs, otherErr)
+			}
+		}
+	}
+
+	return result, clientBulkWriteError(bwErr, errs)
+}
+
+// clientBulkWriteError combines the write errors and write concern error in bwErr with the other errors that occurred
+// during a Client.BulkWrite. It returns nil if there were no errors.
+func clientBulkWriteError(bwErr BulkWriteException, errs []error) error {
+	hasBulkErr := len(bwErr.WriteErrors) > 0 || bwErr.WriteConcernError != nil
+	switch {
+	case len(errs) == 1 && !hasBulkErr:
+		return errs[0]
+	case len(errs) > 0:
+		return ClientBulkWriteException{BulkWriteException: bwErr, Errors: errs}
+	case hasBulkErr:
+		return bwErr
+	}
+	return nil
+}
+
+// maxConcurrentClientWriteGroups is the maximum number of groups of an unordered Client.BulkWrite that are executed
+// concurrently.
+const maxConcurrentClientWriteGroups = 8
+
+// clientWriteOutcome is the result of executing a clientWriteGroup.
+type clientWriteOutcome struct {
+	res *BulkWriteResult
+	err error
+}
+
+// mergeClientWriteGroup adds the result and the error of executing group to result and bwErr, translating the indexes
+// of the group to indexes in models. It returns true if an ordered operation must stop because of write errors, and
+// the error if the group failed with an error other than a BulkWriteException.
+func mergeClientWriteGroup(result *ClientBulkWriteResult, bwErr *BulkWriteException, models []*ClientWriteModel,
+	group *clientWriteGroup, res *BulkWriteResult, err error, ordered bool) (bool, error) {
+
+	if res != nil {
+		result.InsertedCount += res.InsertedCount
+		result.MatchedCount += res.MatchedCount
+		result.ModifiedCount += res.ModifiedCount
+		result.DeletedCount += res.DeletedCount
+		result.UpsertedCount += res.UpsertedCount
+		for index, id := range res.UpsertedIDs {
+			result.UpsertedIDs[group.indexes[index]] = id
+		}
+	}
+
+	// Documents are inserted unless they caused a write error or, for an ordered operation, they follow a write that
+	// caused an error. If the group failed with another error, it is not known which documents were inserted.
+	inserted := len(group.models)
+	failed := make(map[int]bool)
+	var stop bool
+	var otherErr error
+	switch tt := err.(type) {
+	case nil:
+	case BulkWriteException:
+		if tt.WriteConcernError != nil {
+			bwErr.WriteConcernError = tt.WriteConcernError
+		}
+		bwErr.Labels = append(bwErr.Labels, tt.Labels...)
+		for _, we := range tt.WriteErrors {
+			failed[we.Index] = true
+			if ordered && we.Index < inserted {
+				inserted = we.Index
+			}
+			we.Request = models[group.indexes[we.Index]].Model
+			we.Index = int(group.indexes[we.Index])
+			bwErr.WriteErrors = append(bwErr.WriteErrors, we)
+		}
+		// Like Collection.BulkWrite, an ordered operation only stops at write errors and not at a write concern
+		// error.
+		stop = len(tt.WriteErrors) > 0
+	default:
+		inserted = 0
+		otherErr = err
+	}
+	for i, id := range group.ids {
+		if i < inserted && !failed[i] {
+			result.InsertedIDs[group.indexes[i]] = id
+		}
+	}
+	return stop, otherErr
+}
+
+// groupClientWriteModels splits models into the groups of writes that are executed for a Client.BulkWrite. The _id
+// of each document to insert is added to the document if it is missing so that it can be reported in the result.
+func (c *Client) groupClientWriteModels(models []*ClientWriteModel, ordered bool) ([]*clientWriteGroup, error) {
+	var groups []*clientWriteGroup
+	var prev *clientWriteGroup
+	byKey := make(map[string]*clientWriteGroup)
+
+	for i, model := range models {
+		key := model.Database + "." + model.Collection
+		if !ordered {
+			// Collection.BulkWrite reorders the writes of an unordered operation by kind, so each group only
+			// contains writes of one kind to keep their indexes intact.
+			key = fmt.Sprintf("%s/%d", key, writeModelKind(model.Model))
+		}
+
+		var group *clientWriteGroup
+		switch {
+		case ordered && prev != nil && prev.coll.db.name == model.Database && prev.coll.name == model.Collection:
+			group = prev
+		case !ordered && byKey[key] != nil:
+			group = byKey[key]
+		default:
+			group = &clientWriteGroup{
+				coll: c.Database(model.Database).Collection(model.Collection),
+				ids:  make(map[int]interface{}),
+			}
+			groups = append(groups, group)
+			byKey[key] = group
+		}
+		prev = group
+
+		writeModel := model.Model
+		if insert, ok := writeModel.(*InsertOneModel); ok {
+			doc, id, err := transformAndEnsureIDv2(group.coll.registry, insert.Document)
+			if err != nil {
+				return nil, err
+			}
+			group.ids[len(group.models)] = id
+			writeModel = &InsertOneModel{Document: bson.Raw(doc)}
+		}
+		group.models = append(group.models, writeModel)
+		group.indexes = append(group.indexes, int64(i))
+	}
+	return groups, nil
+}
+
+// writeModelKind returns the kind of write command that is used to execute model.
+func writeModelKind(model WriteModel) writeCommandKind {
+	switch model.(type) {
+	case *InsertOneModel:
+		return insertCommand
+	case *DeleteOneModel:
+		return deleteOneCommand
+	case *DeleteManyModel:
+		return deleteManyCommand
+	case *UpdateManyModel:
+		return updateManyCommand
+	default:
+		return updateOneCommand
+	}
+}
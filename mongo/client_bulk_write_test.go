@@ -0,0 +1,145 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+)
+
+func TestClientBulkWrite(t *testing.T) {
+	client := setupClient()
+
+	t.Run("invalid models", func(t *testing.T) {
+		insert := NewInsertOneModel().SetDocument(bson.D{})
+		testCases := []struct {
+			name   string
+			models []*ClientWriteModel
+		}{
+			{"empty", nil},
+			{"nil model", []*ClientWriteModel{nil}},
+			{"nil write model", []*ClientWriteModel{NewClientWriteModel().SetNamespace("db", "coll")}},
+			{"missing database", []*ClientWriteModel{NewClientWriteModel().SetNamespace("", "coll").SetModel(insert)}},
+			{"missing collection", []*ClientWriteModel{NewClientWriteModel().SetNamespace("db", "").SetModel(insert)}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				res, err := client.BulkWrite(bgCtx, tc.models)
+				assert.NotNil(t, err, "expected BulkWrite error, got nil")
+				assert.Nil(t, res, "expected nil result, got %v", res)
+			})
+		}
+	})
+	t.Run("grouping", func(t *testing.T) {
+		models := []*ClientWriteModel{
+			NewClientWriteModel().SetNamespace("db", "foo").SetModel(NewInsertOneModel().SetDocument(bson.D{{"x", 1}})),
+			NewClientWriteModel().SetNamespace("db", "foo").SetModel(NewDeleteOneModel().SetFilter(bson.D{})),
+			NewClientWriteModel().SetNamespace("db", "bar").SetModel(NewInsertOneModel().SetDocument(bson.D{{"_id", 1}})),
+			NewClientWriteModel().SetNamespace("db", "foo").SetModel(NewInsertOneModel().SetDocument(bson.D{{"x", 2}})),
+		}
+		testCases := []struct {
+			name    string
+			ordered bool
+			indexes [][]int64
+		}{
+			{"ordered", true, [][]int64{{0, 1}, {2}, {3}}},
+			{"unordered", false, [][]int64{{0, 3}, {1}, {2}}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				groups, err := client.groupClientWriteModels(models, tc.ordered)
+				assert.Nil(t, err, "groupClientWriteModels error: %v", err)
+				assert.Equal(t, len(tc.indexes), len(groups), "expected %d groups, got %d", len(tc.indexes), len(groups))
+				for i, group := range groups {
+					assert.Equal(t, tc.indexes[i], group.indexes, "expected indexes %v for group %d, got %v",
+						tc.indexes[i], i, group.indexes)
+					assert.Equal(t, len(group.indexes), len(group.models), "expected %d models for group %d, got %d",
+						len(group.indexes), i, len(group.models))
+					first := models[group.indexes[0]]
+					assert.Equal(t, first.Collection, group.coll.name, "expected collection %q for group %d, got %q",
+						first.Collection, i, group.coll.name)
+				}
+			})
+		}
+	})
+	t.Run("inserted ids", func(t *testing.T) {
+		models := []*ClientWriteModel{
+			NewClientWriteModel().SetNamespace("db", "foo").SetModel(NewInsertOneModel().SetDocument(bson.D{{"x", 1}})),
+			NewClientWriteModel().SetNamespace("db", "foo").SetModel(NewInsertOneModel().SetDocument(bson.D{{"_id", 2}})),
+		}
+		groups, err := client.groupClientWriteModels(models, true)
+		assert.Nil(t, err, "groupClientWriteModels error: %v", err)
+		assert.Equal(t, 1, len(groups), "expected 1 group, got %d", len(groups))
+
+		ids := groups[0].ids
+		assert.Equal(t, 2, len(ids), "expected 2 ids, got %d", len(ids))
+		assert.NotNil(t, ids[0], "expected generated _id for first document")
+		assert.Equal(t, int32(2), ids[1], "expected _id 2 for second document, got %v", ids[1])
+
+		// The _id is added to the document that is sent to the server, but the original model is not modified.
+		doc := groups[0].models[0].(*InsertOneModel).Document.(bson.Raw)
+		assert.Equal(t, ids[0], doc.Lookup("_id").ObjectID(), "expected _id %v in document, got %v", ids[0],
+			doc.Lookup("_id"))
+		assert.Equal(t, bson.D{{"x", 1}}, models[0].Model.(*InsertOneModel).Document, "expected model to be unchanged")
+	})
+	t.Run("merging group results", func(t *testing.T) {
+		models := []*ClientWriteModel{
+			NewClientWriteModel().SetNamespace("db", "foo").SetModel(NewInsertOneModel().SetDocument(bson.D{{"x", 1}})),
+			NewClientWriteModel().SetNamespace("db", "bar").SetModel(NewInsertOneModel().SetDocument(bson.D{{"x", 2}})),
+			NewClientWriteModel().SetNamespace("db", "foo").SetModel(NewInsertOneModel().SetDocument(bson.D{{"x", 3}})),
+		}
+		groups, err := client.groupClientWriteModels(models, false)
+		assert.Nil(t, err, "groupClientWriteModels error: %v", err)
+
+		t.Run("write concern error does not stop ordered operation", func(t *testing.T) {
+			result := &ClientBulkWriteResult{InsertedIDs: make(map[int64]interface{})}
+			var bwErr BulkWriteException
+			wcErr := BulkWriteException{WriteConcernError: &WriteConcernError{Code: 64}}
+			stop, otherErr := mergeClientWriteGroup(result, &bwErr, models, groups[0], &BulkWriteResult{InsertedCount: 2},
+				wcErr, true)
+			assert.False(t, stop, "expected operation to continue after a write concern error")
+			assert.Nil(t, otherErr, "expected no other error, got %v", otherErr)
+			assert.NotNil(t, bwErr.WriteConcernError, "expected write concern error to be kept")
+			assert.Equal(t, 2, len(result.InsertedIDs), "expected 2 inserted ids, got %v", result.InsertedIDs)
+		})
+		t.Run("write errors stop ordered operation", func(t *testing.T) {
+			result := &ClientBulkWriteResult{InsertedIDs: make(map[int64]interface{})}
+			var bwErr BulkWriteException
+			writeErr := BulkWriteException{WriteErrors: []BulkWriteError{{WriteError: WriteError{Index: 1, Code: 11000}}}}
+			stop, _ := mergeClientWriteGroup(result, &bwErr, models, groups[0], &BulkWriteResult{InsertedCount: 1},
+				writeErr, true)
+			assert.True(t, stop, "expected operation to stop after a write error")
+			assert.Equal(t, 1, len(bwErr.WriteErrors), "expected 1 write error, got %v", bwErr.WriteErrors)
+			assert.Equal(t, 2, bwErr.WriteErrors[0].Index, "expected index 2, got %v", bwErr.WriteErrors[0].Index)
+			assert.Equal(t, models[2].Model, bwErr.WriteErrors[0].Request, "expected request of model 2")
+		})
+		t.Run("other errors keep write errors", func(t *testing.T) {
+			result := &ClientBulkWriteResult{InsertedIDs: make(map[int64]interface{})}
+			var bwErr BulkWriteException
+			writeErr := BulkWriteException{WriteErrors: []BulkWriteError{{WriteError: WriteError{Index: 0, Code: 11000}}}}
+			_, otherErr := mergeClientWriteGroup(result, &bwErr, models, groups[0], &BulkWriteResult{}, writeErr, false)
+			assert.Nil(t, otherErr, "expected no other error, got %v", otherErr)
+			_, otherErr = mergeClientWriteGroup(result, &bwErr, models, groups[1], nil, ErrClientDisconnected, false)
+			assert.True(t, otherErr == ErrClientDisconnected, "expected error %v, got %v", ErrClientDisconnected, otherErr)
+
+			err := clientBulkWriteError(bwErr, []error{otherErr})
+			cbwe, ok := err.(ClientBulkWriteException)
+			assert.True(t, ok, "expected error type %T, got %T", ClientBulkWriteException{}, err)
+			assert.Equal(t, 1, len(cbwe.WriteErrors), "expected 1 write error, got %v", cbwe.WriteErrors)
+			assert.Equal(t, 1, len(cbwe.Errors), "expected 1 error, got %v", cbwe.Errors)
+			assert.True(t, cbwe.Errors[0] == ErrClientDisconnected, "expected error %v, got %v", ErrClientDisconnected,
+				cbwe.Errors[0])
+
+			err = clientBulkWriteError(BulkWriteException{}, []error{otherErr})
+			assert.True(t, err == ErrClientDisconnected, "expected error %v, got %v", ErrClientDisconnected, err)
+			err = clientBulkWriteError(BulkWriteException{}, nil)
+			assert.Nil(t, err, "expected no error, got %v", err)
+		})
+	})
+}
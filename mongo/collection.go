@@ -38,6 +38,7 @@ type Collection struct {
 	readSelector   description.ServerSelector
 	writeSelector  description.ServerSelector
 	registry       *bsoncodec.Registry
+	timeout        *time.Duration
 }
 
 // aggregateParams is used to store information to configure an Aggregate operation.
@@ -54,6 +55,7 @@ type aggregateParams struct {
 	readSelector   description.ServerSelector
 	writeSelector  description.ServerSelector
 	readPreference *readpref.ReadPref
+	timeout        *time.Duration
 	opts           []*options.AggregateOptions
 }
 
@@ -86,6 +88,11 @@ func newCollection(db *Database, name string, opts ...*options.CollectionOptions
 		reg = collOpt.Registry
 	}
 
+	timeout := db.timeout
+	if collOpt.Timeout != nil {
+		timeout = collOpt.Timeout
+	}
+
 	readSelector := description.CompositeSelector([]description.ServerSelector{
 		description.ReadPrefSelector(rp),
 		description.LatencySelector(db.client.localThreshold),
@@ -106,6 +113,7 @@ func newCollection(db *Database, name string, opts ...*options.CollectionOptions
 		readSelector:   readSelector,
 		writeSelector:  writeSelector,
 		registry:       reg,
+		timeout:        timeout,
 	}
 
 	return coll
@@ -122,6 +130,7 @@ func (coll *Collection) copy() *Collection {
 		readSelector:   coll.readSelector,
 		writeSelector:  coll.writeSelector,
 		registry:       coll.registry,
+		timeout:        coll.timeout,
 	}
 }
 
@@ -148,6 +157,10 @@ func (coll *Collection) Clone(opts ...*options.CollectionOptions) (*Collection,
 		copyColl.registry = optsColl.Registry
 	}
 
+	if optsColl.Timeout != nil {
+		copyColl.timeout = optsColl.Timeout
+	}
+
 	copyColl.readSelector = description.CompositeSelector([]description.ServerSelector{
 		description.ReadPrefSelector(copyColl.readPreference),
 		description.LatencySelector(copyColl.client.localThreshold),
@@ -225,6 +238,10 @@ func (coll *Collection) BulkWrite(ctx context.Context, models []WriteModel,
 		collection:               coll,
 		selector:                 selector,
 		writeConcern:             wc,
+		timeout:                  coll.timeout,
+	}
+	if bwo.Timeout != nil {
+		op.timeout = bwo.Timeout
 	}
 
 	err = op.execute(ctx)
@@ -279,11 +296,15 @@ func (coll *Collection) insert(ctx context.Context, documents []interface{},
 		Session(sess).WriteConcern(wc).CommandMonitor(coll.client.monitor).
 		ServerSelector(selector).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).
-		Deployment(coll.client.deployment).Crypt(coll.client.crypt)
+		Deployment(coll.client.deployment).Crypt(coll.client.crypt).
+		ServerAPI(coll.client.serverAPI).Timeout(coll.timeout)
 	imo := options.MergeInsertManyOptions(opts...)
 	if imo.BypassDocumentValidation != nil && *imo.BypassDocumentValidation {
 		op = op.BypassDocumentValidation(*imo.BypassDocumentValidation)
 	}
+	if imo.Timeout != nil {
+		op = op.Timeout(imo.Timeout)
+	}
 	if imo.Ordered != nil {
 		op = op.Ordered(*imo.Ordered)
 	}
@@ -332,6 +353,7 @@ func (coll *Collection) InsertOne(ctx context.Context, document interface{},
 		if opt.BypassDocumentValidation != nil && *opt.BypassDocumentValidation {
 			imo = imo.SetBypassDocumentValidation(*opt.BypassDocumentValidation)
 		}
+		imo.Timeout = opt.Timeout
 		imOpts[i] = imo
 	}
 	res, err := coll.insert(ctx, []interface{}{document}, imOpts...)
@@ -454,10 +476,14 @@ func (coll *Collection) delete(ctx context.Context, filter interface{}, deleteOn
 		Session(sess).WriteConcern(wc).CommandMonitor(coll.client.monitor).
 		ServerSelector(selector).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).
-		Deployment(coll.client.deployment).Crypt(coll.client.crypt)
+		Deployment(coll.client.deployment).Crypt(coll.client.crypt).
+		ServerAPI(coll.client.serverAPI).Timeout(coll.timeout)
 	if do.Hint != nil {
 		op = op.Hint(true)
 	}
+	if do.Timeout != nil {
+		op = op.Timeout(do.Timeout)
+	}
 
 	// deleteMany cannot be retried
 	retryMode := driver.RetryNone
@@ -550,11 +576,15 @@ func (coll *Collection) updateOrReplace(ctx context.Context, filter bsoncore.Doc
 		Session(sess).WriteConcern(wc).CommandMonitor(coll.client.monitor).
 		ServerSelector(selector).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).
-		Deployment(coll.client.deployment).Crypt(coll.client.crypt).Hint(uo.Hint != nil)
+		Deployment(coll.client.deployment).Crypt(coll.client.crypt).
+		ServerAPI(coll.client.serverAPI).Hint(uo.Hint != nil).Timeout(coll.timeout)
 
 	if uo.BypassDocumentValidation != nil && *uo.BypassDocumentValidation {
 		op = op.BypassDocumentValidation(*uo.BypassDocumentValidation)
 	}
+	if uo.Timeout != nil {
+		op = op.Timeout(uo.Timeout)
+	}
 	retry := driver.RetryNone
 	// retryable writes are only enabled updateOne/replaceOne operations
 	if !multi && coll.client.retryWrites {
@@ -680,6 +710,7 @@ func (coll *Collection) ReplaceOne(ctx context.Context, filter interface{},
 		uOpts.Collation = opt.Collation
 		uOpts.Upsert = opt.Upsert
 		uOpts.Hint = opt.Hint
+		uOpts.Timeout = opt.Timeout
 		updateOptions = append(updateOptions, uOpts)
 	}
 
@@ -712,6 +743,7 @@ func (coll *Collection) Aggregate(ctx context.Context, pipeline interface{},
 		readSelector:   coll.readSelector,
 		writeSelector:  coll.writeSelector,
 		readPreference: coll.readPreference,
+		timeout:        coll.timeout,
 		opts:           opts,
 	}
 	return aggregate(a)
@@ -760,9 +792,15 @@ func aggregate(a aggregateParams) (*Cursor, error) {
 	}
 
 	ao := options.MergeAggregateOptions(a.opts...)
+	timeout := a.timeout
+	if ao.Timeout != nil {
+		timeout = ao.Timeout
+	}
 	cursorOpts := driver.CursorOptions{
 		CommandMonitor: a.client.monitor,
 		Crypt:          a.client.crypt,
+		Timeout:        timeout,
+		ServerAPI:      a.client.serverAPI,
 	}
 
 	op := operation.NewAggregate(pipelineArr).
@@ -775,7 +813,9 @@ func aggregate(a aggregateParams) (*Cursor, error) {
 		Database(a.db).
 		Collection(a.col).
 		Deployment(a.client.deployment).
-		Crypt(a.client.crypt)
+		Crypt(a.client.crypt).
+		ServerAPI(a.client.serverAPI).
+		Timeout(timeout)
 	if !hasOutputStage {
 		// Only pass the user-specified read preference if the aggregation doesn't have a $out or $merge stage.
 		// Otherwise, the read preference could be forwarded to a mongos, which would error if the aggregation were
@@ -881,13 +921,17 @@ func (coll *Collection) CountDocuments(ctx context.Context, filter interface{},
 	selector := makeReadPrefSelector(sess, coll.readSelector, coll.client.localThreshold)
 	op := operation.NewAggregate(pipelineArr).Session(sess).ReadConcern(rc).ReadPreference(coll.readPreference).
 		CommandMonitor(coll.client.monitor).ServerSelector(selector).ClusterClock(coll.client.clock).Database(coll.db.name).
-		Collection(coll.name).Deployment(coll.client.deployment).Crypt(coll.client.crypt)
+		Collection(coll.name).Deployment(coll.client.deployment).Crypt(coll.client.crypt).
+		ServerAPI(coll.client.serverAPI).Timeout(coll.timeout)
 	if countOpts.Collation != nil {
 		op.Collation(bsoncore.Document(countOpts.Collation.ToDocument()))
 	}
 	if countOpts.MaxTime != nil {
 		op.MaxTimeMS(int64(*countOpts.MaxTime / time.Millisecond))
 	}
+	if countOpts.Timeout != nil {
+		op.Timeout(countOpts.Timeout)
+	}
 	if countOpts.Hint != nil {
 		hintVal, err := transformValue(coll.registry, countOpts.Hint)
 		if err != nil {
@@ -963,12 +1007,15 @@ func (coll *Collection) EstimatedDocumentCount(ctx context.Context,
 	op := operation.NewCount().Session(sess).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).CommandMonitor(coll.client.monitor).
 		Deployment(coll.client.deployment).ReadConcern(rc).ReadPreference(coll.readPreference).
-		ServerSelector(selector).Crypt(coll.client.crypt)
+		ServerSelector(selector).Crypt(coll.client.crypt).ServerAPI(coll.client.serverAPI).Timeout(coll.timeout)
 
 	co := options.MergeEstimatedDocumentCountOptions(opts...)
 	if co.MaxTime != nil {
 		op = op.MaxTimeMS(int64(*co.MaxTime / time.Millisecond))
 	}
+	if co.Timeout != nil {
+		op = op.Timeout(co.Timeout)
+	}
 	retry := driver.RetryNone
 	if coll.client.retryReads {
 		retry = driver.RetryOncePerCommand
@@ -1029,7 +1076,7 @@ func (coll *Collection) Distinct(ctx context.Context, fieldName string, filter i
 		Session(sess).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).CommandMonitor(coll.client.monitor).
 		Deployment(coll.client.deployment).ReadConcern(rc).ReadPreference(coll.readPreference).
-		ServerSelector(selector).Crypt(coll.client.crypt)
+		ServerSelector(selector).Crypt(coll.client.crypt).ServerAPI(coll.client.serverAPI).Timeout(coll.timeout)
 
 	if option.Collation != nil {
 		op.Collation(bsoncore.Document(option.Collation.ToDocument()))
@@ -1037,6 +1084,9 @@ func (coll *Collection) Distinct(ctx context.Context, fieldName string, filter i
 	if option.MaxTime != nil {
 		op.MaxTimeMS(int64(*option.MaxTime / time.Millisecond))
 	}
+	if option.Timeout != nil {
+		op.Timeout(option.Timeout)
+	}
 	retry := driver.RetryNone
 	if coll.client.retryReads {
 		retry = driver.RetryOncePerCommand
@@ -1116,12 +1166,19 @@ func (coll *Collection) Find(ctx context.Context, filter interface{},
 		Session(sess).ReadConcern(rc).ReadPreference(coll.readPreference).
 		CommandMonitor(coll.client.monitor).ServerSelector(selector).
 		ClusterClock(coll.client.clock).Database(coll.db.name).Collection(coll.name).
-		Deployment(coll.client.deployment).Crypt(coll.client.crypt)
+		Deployment(coll.client.deployment).Crypt(coll.client.crypt).
+		ServerAPI(coll.client.serverAPI).Timeout(coll.timeout)
 
 	fo := options.MergeFindOptions(opts...)
 	cursorOpts := driver.CursorOptions{
 		CommandMonitor: coll.client.monitor,
 		Crypt:          coll.client.crypt,
+		Timeout:        coll.timeout,
+		ServerAPI:      coll.client.serverAPI,
+	}
+	if fo.Timeout != nil {
+		op.Timeout(fo.Timeout)
+		cursorOpts.Timeout = fo.Timeout
 	}
 
 	if fo.AllowDiskUse != nil {
@@ -1277,6 +1334,7 @@ func (coll *Collection) FindOne(ctx context.Context, filter interface{},
 			Skip:                opt.Skip,
 			Snapshot:            opt.Snapshot,
 			Sort:                opt.Sort,
+			Timeout:             opt.Timeout,
 		}
 	}
 	// Unconditionally send a limit to make sure only one document is returned and the cursor is not kept open
@@ -1331,7 +1389,8 @@ func (coll *Collection) findAndModify(ctx context.Context, op *operation.FindAnd
 		Collection(coll.name).
 		Deployment(coll.client.deployment).
 		Retry(retry).
-		Crypt(coll.client.crypt)
+		Crypt(coll.client.crypt).
+		ServerAPI(coll.client.serverAPI)
 
 	_, err = processWriteError(op.Execute(ctx))
 	if err != nil {
@@ -1360,13 +1419,16 @@ func (coll *Collection) FindOneAndDelete(ctx context.Context, filter interface{}
 		return &SingleResult{err: err}
 	}
 	fod := options.MergeFindOneAndDeleteOptions(opts...)
-	op := operation.NewFindAndModify(f).Remove(true)
+	op := operation.NewFindAndModify(f).Remove(true).Timeout(coll.timeout)
 	if fod.Collation != nil {
 		op = op.Collation(bsoncore.Document(fod.Collation.ToDocument()))
 	}
 	if fod.MaxTime != nil {
 		op = op.MaxTimeMS(int64(*fod.MaxTime / time.Millisecond))
 	}
+	if fod.Timeout != nil {
+		op = op.Timeout(fod.Timeout)
+	}
 	if fod.Projection != nil {
 		proj, err := transformBsoncoreDocument(coll.registry, fod.Projection)
 		if err != nil {
@@ -1422,7 +1484,8 @@ func (coll *Collection) FindOneAndReplace(ctx context.Context, filter interface{
 	}
 
 	fo := options.MergeFindOneAndReplaceOptions(opts...)
-	op := operation.NewFindAndModify(f).Update(bsoncore.Value{Type: bsontype.EmbeddedDocument, Data: r})
+	op := operation.NewFindAndModify(f).Update(bsoncore.Value{Type: bsontype.EmbeddedDocument, Data: r}).
+		Timeout(coll.timeout)
 	if fo.BypassDocumentValidation != nil && *fo.BypassDocumentValidation {
 		op = op.BypassDocumentValidation(*fo.BypassDocumentValidation)
 	}
@@ -1432,6 +1495,9 @@ func (coll *Collection) FindOneAndReplace(ctx context.Context, filter interface{
 	if fo.MaxTime != nil {
 		op = op.MaxTimeMS(int64(*fo.MaxTime / time.Millisecond))
 	}
+	if fo.Timeout != nil {
+		op = op.Timeout(fo.Timeout)
+	}
 	if fo.Projection != nil {
 		proj, err := transformBsoncoreDocument(coll.registry, fo.Projection)
 		if err != nil {
@@ -1491,7 +1557,7 @@ func (coll *Collection) FindOneAndUpdate(ctx context.Context, filter interface{}
 	}
 
 	fo := options.MergeFindOneAndUpdateOptions(opts...)
-	op := operation.NewFindAndModify(f)
+	op := operation.NewFindAndModify(f).Timeout(coll.timeout)
 
 	u, err := transformUpdateValue(coll.registry, update, true)
 	if err != nil {
@@ -1515,6 +1581,9 @@ func (coll *Collection) FindOneAndUpdate(ctx context.Context, filter interface{}
 	if fo.MaxTime != nil {
 		op = op.MaxTimeMS(int64(*fo.MaxTime / time.Millisecond))
 	}
+	if fo.Timeout != nil {
+		op = op.Timeout(fo.Timeout)
+	}
 	if fo.Projection != nil {
 		proj, err := transformBsoncoreDocument(coll.registry, fo.Projection)
 		if err != nil {
@@ -1571,6 +1640,7 @@ func (coll *Collection) Watch(ctx context.Context, pipeline interface{},
 		collectionName: coll.Name(),
 		databaseName:   coll.db.Name(),
 		crypt:          coll.client.crypt,
+		timeout:        coll.timeout,
 	}
 	return newChangeStream(ctx, csConfig, pipeline, opts...)
 }
@@ -1616,7 +1686,8 @@ func (coll *Collection) Drop(ctx context.Context) error {
 		Session(sess).WriteConcern(wc).CommandMonitor(coll.client.monitor).
 		ServerSelector(selector).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).
-		Deployment(coll.client.deployment).Crypt(coll.client.crypt)
+		Deployment(coll.client.deployment).Crypt(coll.client.crypt).
+		ServerAPI(coll.client.serverAPI).Timeout(coll.timeout)
 	err = op.Execute(ctx)
 
 	// ignore namespace not found erorrs
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsoncodec"
@@ -39,6 +40,7 @@ type Database struct {
 	readSelector   description.ServerSelector
 	writeSelector  description.ServerSelector
 	registry       *bsoncodec.Registry
+	timeout        *time.Duration
 }
 
 func newDatabase(client *Client, name string, opts ...*options.DatabaseOptions) *Database {
@@ -64,6 +66,11 @@ func newDatabase(client *Client, name string, opts ...*options.DatabaseOptions)
 		reg = dbOpt.Registry
 	}
 
+	timeout := client.timeout
+	if dbOpt.Timeout != nil {
+		timeout = dbOpt.Timeout
+	}
+
 	db := &Database{
 		client:         client,
 		name:           name,
@@ -71,6 +78,7 @@ func newDatabase(client *Client, name string, opts ...*options.DatabaseOptions)
 		readConcern:    rc,
 		writeConcern:   wc,
 		registry:       reg,
+		timeout:        timeout,
 	}
 
 	db.readSelector = description.CompositeSelector([]description.ServerSelector{
@@ -127,6 +135,7 @@ func (db *Database) Aggregate(ctx context.Context, pipeline interface{},
 		readSelector:   db.readSelector,
 		writeSelector:  db.writeSelector,
 		readPreference: db.readPreference,
+		timeout:        db.timeout,
 		opts:           opts,
 	}
 	return aggregate(a)
@@ -165,10 +174,17 @@ func (db *Database) processRunCommand(ctx context.Context, cmd interface{},
 		readSelect = sess.PinnedServer
 	}
 
+	timeout := db.timeout
+	if ro.Timeout != nil {
+		timeout = ro.Timeout
+	}
+
 	return operation.NewCommand(runCmdDoc).
 		Session(sess).CommandMonitor(db.client.monitor).
 		ServerSelector(readSelect).ClusterClock(db.client.clock).
-		Database(db.name).Deployment(db.client.deployment).ReadConcern(db.readConcern).Crypt(db.client.crypt), sess, nil
+		Database(db.name).Deployment(db.client.deployment).ReadConcern(db.readConcern).Crypt(db.client.crypt).
+		ServerAPI(db.client.serverAPI).
+		Timeout(timeout), sess, nil
 }
 
 // RunCommand executes the given command against the database.
@@ -222,7 +238,11 @@ func (db *Database) RunCommandCursor(ctx context.Context, runCommand interface{}
 		return nil, replaceErrors(err)
 	}
 
-	bc, err := op.ResultCursor(driver.CursorOptions{})
+	timeout := db.timeout
+	if ro := options.MergeRunCmdOptions(opts...); ro.Timeout != nil {
+		timeout = ro.Timeout
+	}
+	bc, err := op.ResultCursor(driver.CursorOptions{Timeout: timeout, ServerAPI: db.client.serverAPI})
 	if err != nil {
 		closeImplicitSession(sess)
 		return nil, replaceErrors(err)
@@ -266,7 +286,8 @@ func (db *Database) Drop(ctx context.Context) error {
 	op := operation.NewDropDatabase().
 		Session(sess).WriteConcern(wc).CommandMonitor(db.client.monitor).
 		ServerSelector(selector).ClusterClock(db.client.clock).
-		Database(db.name).Deployment(db.client.deployment).Crypt(db.client.crypt)
+		Database(db.name).Deployment(db.client.deployment).Crypt(db.client.crypt).
+		ServerAPI(db.client.serverAPI).Timeout(db.timeout)
 
 	err = op.Execute(ctx)
 
@@ -318,10 +339,15 @@ func (db *Database) ListCollections(ctx context.Context, filter interface{}, opt
 	selector = makeReadPrefSelector(sess, selector, db.client.localThreshold)
 
 	lco := options.MergeListCollectionsOptions(opts...)
+	timeout := db.timeout
+	if lco.Timeout != nil {
+		timeout = lco.Timeout
+	}
 	op := operation.NewListCollections(filterDoc).
 		Session(sess).ReadPreference(db.readPreference).CommandMonitor(db.client.monitor).
 		ServerSelector(selector).ClusterClock(db.client.clock).
-		Database(db.name).Deployment(db.client.deployment).Crypt(db.client.crypt)
+		Database(db.name).Deployment(db.client.deployment).Crypt(db.client.crypt).
+		ServerAPI(db.client.serverAPI).Timeout(timeout)
 	if lco.NameOnly != nil {
 		op = op.NameOnly(*lco.NameOnly)
 	}
@@ -337,7 +363,11 @@ func (db *Database) ListCollections(ctx context.Context, filter interface{}, opt
 		return nil, replaceErrors(err)
 	}
 
-	bc, err := op.Result(driver.CursorOptions{Crypt: db.client.crypt})
+	bc, err := op.Result(driver.CursorOptions{
+		Crypt:     db.client.crypt,
+		Timeout:   timeout,
+		ServerAPI: db.client.serverAPI,
+	})
 	if err != nil {
 		closeImplicitSession(sess)
 		return nil, replaceErrors(err)
@@ -407,6 +437,11 @@ func (db *Database) WriteConcern() *writeconcern.WriteConcern {
 	return db.writeConcern
 }
 
+// Timeout returns the client-side timeout used to configure the Database object.
+func (db *Database) Timeout() *time.Duration {
+	return db.timeout
+}
+
 // Watch returns a change stream for all changes to the corresponding database. See
 // https://docs.mongodb.com/manual/changeStreams/ for more information about change streams.
 //
@@ -431,6 +466,7 @@ func (db *Database) Watch(ctx context.Context, pipeline interface{},
 		streamType:     DatabaseStream,
 		databaseName:   db.Name(),
 		crypt:          db.client.crypt,
+		timeout:        db.timeout,
 	}
 	return newChangeStream(ctx, csConfig, pipeline, opts...)
 }
@@ -443,11 +479,14 @@ func (db *Database) Watch(ctx context.Context, pipeline interface{},
 // documentation).
 func (db *Database) CreateCollection(ctx context.Context, name string, opts ...*options.CreateCollectionOptions) error {
 	cco := options.MergeCreateCollectionOptions(opts...)
-	op := operation.NewCreate(name)
+	op := operation.NewCreate(name).Timeout(db.timeout)
 
 	if cco.Capped != nil {
 		op.Capped(*cco.Capped)
 	}
+	if cco.Timeout != nil {
+		op.Timeout(cco.Timeout)
+	}
 	if cco.Collation != nil {
 		op.Collation(bsoncore.Document(cco.Collation.ToDocument()))
 	}
@@ -521,7 +560,8 @@ func (db *Database) CreateView(ctx context.Context, viewName, viewOn string, pip
 
 	op := operation.NewCreate(viewName).
 		ViewOn(viewOn).
-		Pipeline(pipelineArray)
+		Pipeline(pipelineArray).
+		Timeout(db.timeout)
 	cvo := options.MergeCreateViewOptions(opts...)
 	if cvo.Collation != nil {
 		op.Collation(bsoncore.Document(cvo.Collation.ToDocument()))
@@ -562,7 +602,8 @@ func (db *Database) executeCreateOperation(ctx context.Context, op *operation.Cr
 		ClusterClock(db.client.clock).
 		Database(db.name).
 		Deployment(db.client.deployment).
-		Crypt(db.client.crypt)
+		Crypt(db.client.crypt).
+		ServerAPI(db.client.serverAPI)
 
 	return replaceErrors(op.Execute(ctx))
 }
@@ -291,6 +291,24 @@ func (bwe BulkWriteException) HasErrorLabel(label string) bool {
 	return false
 }
 
+// ClientBulkWriteException is the error type returned by an unordered Client.BulkWrite when writes to some collections
+// failed with errors other than write errors and write concern errors, and there were also write errors, write
+// concern errors, or further failures. It contains all of the errors that occurred.
+type ClientBulkWriteException struct {
+	BulkWriteException
+
+	// The errors other than write errors and write concern errors that occurred.
+	Errors []error
+}
+
+// Error implements the error interface.
+func (cbwe ClientBulkWriteException) Error() string {
+	var buf bytes.Buffer
+	fmt.Fprint(&buf, cbwe.BulkWriteException.Error())
+	fmt.Fprintf(&buf, ", errors: %v", cbwe.Errors)
+	return buf.String()
+}
+
 // returnResult is used to determine if a function calling processWriteError should return
 // the result or return nil. Since the processWriteError function is used by many different
 // methods, both *One and *Many, we need a way to differentiate if the method should return
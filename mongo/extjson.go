@@ -0,0 +1,91 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// DefaultImportBatchSize is the number of documents inserted by each InsertMany call made by ImportExtJSON if the
+// batch size is not positive.
+const DefaultImportBatchSize = 1000
+
+// ExportExtJSON writes every remaining document of cur to w and returns the number of documents written. Documents
+// are written as they are iterated, so only the current batch of the cursor is held in memory. The cursor and the
+// stream writer are not closed.
+func ExportExtJSON(ctx context.Context, cur *Cursor, w *bsonrw.ExtJSONStreamWriter) (int64, error) {
+	if cur == nil {
+		return 0, errors.New("cursor must not be nil")
+	}
+	if w == nil {
+		return 0, errors.New("stream writer must not be nil")
+	}
+
+	var n int64
+	for cur.Next(ctx) {
+		if err := w.WriteDocument(cur.Current); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, replaceErrors(cur.Err())
+}
+
+// ImportExtJSON reads every document from r and inserts them into coll. The documents are inserted with InsertMany in
+// batches of batchSize documents, so at most one batch is held in memory. If batchSize is not positive,
+// DefaultImportBatchSize is used. The opts parameter is passed to every InsertMany call.
+//
+// The number of documents in the batches that were inserted successfully is returned. If an error occurs while
+// inserting a batch, some of the documents of that batch may also have been inserted.
+func ImportExtJSON(ctx context.Context, coll *Collection, r *bsonrw.ExtJSONStreamReader, batchSize int,
+	opts ...*options.InsertManyOptions) (int64, error) {
+
+	if coll == nil {
+		return 0, errors.New("collection must not be nil")
+	}
+	if r == nil {
+		return 0, errors.New("stream reader must not be nil")
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultImportBatchSize
+	}
+
+	var n int64
+	var buf []byte
+	batch := make([]interface{}, 0, batchSize)
+	for {
+		var err error
+		start := len(buf)
+		buf, err = r.ReadDocument(buf)
+		if err != nil && err != io.EOF {
+			return n, err
+		}
+		if err == nil {
+			batch = append(batch, bson.Raw(buf[start:]))
+		}
+
+		if len(batch) == batchSize || (err == io.EOF && len(batch) > 0) {
+			if _, ierr := coll.InsertMany(ctx, batch, opts...); ierr != nil {
+				return n, ierr
+			}
+			n += int64(len(batch))
+			// The documents of the batch are no longer referenced, so their memory can be reused.
+			batch = batch[:0]
+			buf = buf[:0]
+		}
+
+		if err == io.EOF {
+			return n, nil
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+)
+
+func TestExportExtJSON(t *testing.T) {
+	t.Run("writes all documents", func(t *testing.T) {
+		cursor, err := newCursor(newTestBatchCursor(2, 2), nil)
+		assert.Nil(t, err, "newCursor error: %v", err)
+
+		var buf bytes.Buffer
+		sw, err := bsonrw.NewExtJSONStreamWriter(&buf, bsonrw.ExtJSONCanonical, bsonrw.ExtJSONArray)
+		assert.Nil(t, err, "NewExtJSONStreamWriter error: %v", err)
+		n, err := ExportExtJSON(context.Background(), cursor, sw)
+		assert.Nil(t, err, "ExportExtJSON error: %v", err)
+		assert.Equal(t, int64(4), n, "expected 4 documents, got %v", n)
+		assert.Nil(t, sw.Close(), "Close error")
+
+		want := `[{"foo":{"$numberInt":"0"}},{"foo":{"$numberInt":"1"}},{"foo":{"$numberInt":"2"}},` +
+			`{"foo":{"$numberInt":"3"}}]` + "\n"
+		assert.Equal(t, want, buf.String(), "expected output %v, got %v", want, buf.String())
+	})
+	t.Run("nil arguments", func(t *testing.T) {
+		_, err := ExportExtJSON(context.Background(), nil, nil)
+		assert.NotNil(t, err, "expected error for nil cursor, got nil")
+
+		_, err = ImportExtJSON(context.Background(), nil, bsonrw.NewExtJSONStreamReader(strings.NewReader(""), false), 0)
+		assert.NotNil(t, err, "expected error for nil collection, got nil")
+	})
+}
@@ -11,11 +11,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
+	"sync"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/internal"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
@@ -44,9 +47,12 @@ type Bucket struct {
 	wc        *writeconcern.WriteConcern
 	rc        *readconcern.ReadConcern
 	rp        *readpref.ReadPref
+	timeout   *time.Duration
 
+	prefetchChunks int32
+
+	firstWriteMu   sync.Mutex // guards firstWriteDone
 	firstWriteDone bool
-	readBuf        []byte
 	writeBuf       []byte
 
 	readDeadline  time.Time
@@ -55,8 +61,11 @@ type Bucket struct {
 
 // Upload contains options to upload a file to a bucket.
 type Upload struct {
-	chunkSize int32
-	metadata  bsonx.Doc
+	chunkSize   int32
+	metadata    bsonx.Doc
+	hashAlg     string
+	hash        hash.Hash // nil if no digest is computed
+	maxInFlight int32
 }
 
 // NewBucket creates a GridFS bucket.
@@ -68,6 +77,7 @@ func NewBucket(db *mongo.Database, opts ...*options.BucketOptions) (*Bucket, err
 		wc:        db.WriteConcern(),
 		rc:        db.ReadConcern(),
 		rp:        db.ReadPreference(),
+		timeout:   db.Timeout(),
 	}
 
 	bo := options.MergeBucketOptions(opts...)
@@ -86,12 +96,20 @@ func NewBucket(db *mongo.Database, opts ...*options.BucketOptions) (*Bucket, err
 	if bo.ReadPreference != nil {
 		b.rp = bo.ReadPreference
 	}
+	if bo.Timeout != nil {
+		b.timeout = bo.Timeout
+	}
+	if bo.PrefetchChunks != nil {
+		b.prefetchChunks = *bo.PrefetchChunks
+	}
 
 	var collOpts = options.Collection().SetWriteConcern(b.wc).SetReadConcern(b.rc).SetReadPreference(b.rp)
+	if b.timeout != nil {
+		collOpts.SetTimeout(*b.timeout)
+	}
 
 	b.chunksColl = db.Collection(b.name+".chunks", collOpts)
 	b.filesColl = db.Collection(b.name+".files", collOpts)
-	b.readBuf = make([]byte, b.chunkSize)
 	b.writeBuf = make([]byte, b.chunkSize)
 
 	return b, nil
@@ -114,23 +132,40 @@ func (b *Bucket) OpenUploadStream(filename string, opts ...*options.UploadOption
 	return b.OpenUploadStreamWithID(primitive.NewObjectID(), filename, opts...)
 }
 
+// OpenUploadStreamContext is like OpenUploadStream, but uses ctx for the operations needed to open the stream and for
+// all operations done by the stream instead of the bucket's write deadline.
+func (b *Bucket) OpenUploadStreamContext(ctx context.Context, filename string, opts ...*options.UploadOptions) (*UploadStream, error) {
+	return b.OpenUploadStreamWithIDContext(ctx, primitive.NewObjectID(), filename, opts...)
+}
+
 // OpenUploadStreamWithID creates a new upload stream for a file given the file ID and filename.
 func (b *Bucket) OpenUploadStreamWithID(fileID interface{}, filename string, opts ...*options.UploadOptions) (*UploadStream, error) {
-	ctx, cancel := deadlineContext(b.writeDeadline)
+	ctx, cancel := b.deadlineContext(b.writeDeadline)
 	if cancel != nil {
 		defer cancel()
 	}
 
-	if err := b.checkFirstWrite(ctx); err != nil {
+	us, err := b.openUploadStream(ctx, fileID, filename, opts...)
+	if err != nil {
 		return nil, err
 	}
+	us.writeDeadline = b.streamDeadline(b.writeDeadline)
+	return us, nil
+}
 
-	upload, err := b.parseUploadOptions(opts...)
+// OpenUploadStreamWithIDContext is like OpenUploadStreamWithID, but uses ctx for the operations needed to open the
+// stream and for all operations done by the stream instead of the bucket's write deadline.
+func (b *Bucket) OpenUploadStreamWithIDContext(ctx context.Context, fileID interface{}, filename string, opts ...*options.UploadOptions) (*UploadStream, error) {
+	opCtx, cancel := b.timeoutContext(ctx)
+	defer cancel()
+
+	us, err := b.openUploadStream(opCtx, fileID, filename, opts...)
 	if err != nil {
 		return nil, err
 	}
-
-	return newUploadStream(upload, fileID, filename, b.chunksColl, b.filesColl), nil
+	us.ctx = ctx
+	us.writeDeadline = b.contextStreamDeadline(ctx)
+	return us, nil
 }
 
 // UploadFromStream creates a fileID and uploads a file given a source stream.
@@ -143,6 +178,14 @@ func (b *Bucket) UploadFromStream(filename string, source io.Reader, opts ...*op
 	return fileID, err
 }
 
+// UploadFromStreamContext is like UploadFromStream, but uses ctx instead of the bucket's write deadline. It can be
+// called concurrently with other operations on this bucket.
+func (b *Bucket) UploadFromStreamContext(ctx context.Context, filename string, source io.Reader, opts ...*options.UploadOptions) (primitive.ObjectID, error) {
+	fileID := primitive.NewObjectID()
+	err := b.UploadFromStreamWithIDContext(ctx, fileID, filename, source, opts...)
+	return fileID, err
+}
+
 // UploadFromStreamWithID uploads a file given a source stream.
 //
 // If this upload requires a custom write deadline to be set on the bucket, it cannot be done concurrently with other
@@ -153,43 +196,56 @@ func (b *Bucket) UploadFromStreamWithID(fileID interface{}, filename string, sou
 		return err
 	}
 
-	err = us.SetWriteDeadline(b.writeDeadline)
+	return b.uploadFromStream(us, source)
+}
+
+// UploadFromStreamWithIDContext is like UploadFromStreamWithID, but uses ctx instead of the bucket's write deadline.
+// It can be called concurrently with other operations on this bucket.
+func (b *Bucket) UploadFromStreamWithIDContext(ctx context.Context, fileID interface{}, filename string, source io.Reader, opts ...*options.UploadOptions) error {
+	us, err := b.OpenUploadStreamWithIDContext(ctx, fileID, filename, opts...)
 	if err != nil {
-		_ = us.Close()
 		return err
 	}
 
-	for {
-		n, err := source.Read(b.readBuf)
-		if err != nil && err != io.EOF {
-			_ = us.Abort() // upload considered aborted if source stream returns an error
-			return err
-		}
-
-		if n > 0 {
-			_, err := us.Write(b.readBuf[:n])
-			if err != nil {
-				return err
-			}
-		}
+	return b.uploadFromStream(us, source)
+}
 
-		if n == 0 || err == io.EOF {
-			break
-		}
+// OpenDownloadStream creates a stream from which the contents of the file can be read.
+func (b *Bucket) OpenDownloadStream(fileID interface{}) (*DownloadStream, error) {
+	ctx, cancel := b.deadlineContext(b.readDeadline)
+	if cancel != nil {
+		defer cancel()
 	}
 
-	return us.Close()
+	filter, err := idFilter(fileID)
+	if err != nil {
+		return nil, err
+	}
+	ds, err := b.openDownloadStream(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	ds.readDeadline = b.streamDeadline(b.readDeadline)
+	return ds, nil
 }
 
-// OpenDownloadStream creates a stream from which the contents of the file can be read.
-func (b *Bucket) OpenDownloadStream(fileID interface{}) (*DownloadStream, error) {
-	id, err := convertFileID(fileID)
+// OpenDownloadStreamContext is like OpenDownloadStream, but uses ctx for the operations needed to open the stream and
+// for all operations done by the stream instead of the bucket's read deadline.
+func (b *Bucket) OpenDownloadStreamContext(ctx context.Context, fileID interface{}) (*DownloadStream, error) {
+	opCtx, cancel := b.timeoutContext(ctx)
+	defer cancel()
+
+	filter, err := idFilter(fileID)
+	if err != nil {
+		return nil, err
+	}
+	ds, err := b.openDownloadStream(opCtx, filter)
 	if err != nil {
 		return nil, err
 	}
-	return b.openDownloadStream(bsonx.Doc{
-		{"_id", id},
-	})
+	ds.ctx = ctx
+	ds.readDeadline = b.contextStreamDeadline(ctx)
+	return ds, nil
 }
 
 // DownloadToStream downloads the file with the specified fileID and writes it to the provided io.Writer.
@@ -206,24 +262,47 @@ func (b *Bucket) DownloadToStream(fileID interface{}, stream io.Writer) (int64,
 	return b.downloadToStream(ds, stream)
 }
 
+// DownloadToStreamContext is like DownloadToStream, but uses ctx instead of the bucket's read deadline. It can be
+// called concurrently with other operations on this bucket.
+func (b *Bucket) DownloadToStreamContext(ctx context.Context, fileID interface{}, stream io.Writer) (int64, error) {
+	ds, err := b.OpenDownloadStreamContext(ctx, fileID)
+	if err != nil {
+		return 0, err
+	}
+
+	return b.downloadToStream(ds, stream)
+}
+
 // OpenDownloadStreamByName opens a download stream for the file with the given filename.
 func (b *Bucket) OpenDownloadStreamByName(filename string, opts ...*options.NameOptions) (*DownloadStream, error) {
-	var numSkip int32 = -1
-	var sortOrder int32 = 1
-
-	nameOpts := options.MergeNameOptions(opts...)
-	if nameOpts.Revision != nil {
-		numSkip = *nameOpts.Revision
+	ctx, cancel := b.deadlineContext(b.readDeadline)
+	if cancel != nil {
+		defer cancel()
 	}
 
-	if numSkip < 0 {
-		sortOrder = -1
-		numSkip = (-1 * numSkip) - 1
+	filter, findOpts := nameFilter(filename, opts...)
+	ds, err := b.openDownloadStream(ctx, filter, findOpts)
+	if err != nil {
+		return nil, err
 	}
+	ds.readDeadline = b.streamDeadline(b.readDeadline)
+	return ds, nil
+}
 
-	findOpts := options.Find().SetSkip(int64(numSkip)).SetSort(bsonx.Doc{{"uploadDate", bsonx.Int32(sortOrder)}})
+// OpenDownloadStreamByNameContext is like OpenDownloadStreamByName, but uses ctx for the operations needed to open the
+// stream and for all operations done by the stream instead of the bucket's read deadline.
+func (b *Bucket) OpenDownloadStreamByNameContext(ctx context.Context, filename string, opts ...*options.NameOptions) (*DownloadStream, error) {
+	opCtx, cancel := b.timeoutContext(ctx)
+	defer cancel()
 
-	return b.openDownloadStream(bsonx.Doc{{"filename", bsonx.String(filename)}}, findOpts)
+	filter, findOpts := nameFilter(filename, opts...)
+	ds, err := b.openDownloadStream(opCtx, filter, findOpts)
+	if err != nil {
+		return nil, err
+	}
+	ds.ctx = ctx
+	ds.readDeadline = b.contextStreamDeadline(ctx)
+	return ds, nil
 }
 
 // DownloadToStreamByName downloads the file with the given name to the given io.Writer.
@@ -239,18 +318,38 @@ func (b *Bucket) DownloadToStreamByName(filename string, stream io.Writer, opts
 	return b.downloadToStream(ds, stream)
 }
 
+// DownloadToStreamByNameContext is like DownloadToStreamByName, but uses ctx instead of the bucket's read deadline. It
+// can be called concurrently with other operations on this bucket.
+func (b *Bucket) DownloadToStreamByNameContext(ctx context.Context, filename string, stream io.Writer, opts ...*options.NameOptions) (int64, error) {
+	ds, err := b.OpenDownloadStreamByNameContext(ctx, filename, opts...)
+	if err != nil {
+		return 0, err
+	}
+
+	return b.downloadToStream(ds, stream)
+}
+
 // Delete deletes all chunks and metadata associated with the file with the given file ID.
 //
 // If this operation requires a custom write deadline to be set on the bucket, it cannot be done concurrently with other
 // write operations operations on this bucket that also require a custom deadline.
 func (b *Bucket) Delete(fileID interface{}) error {
-	// delete document in files collection and then chunks to minimize race conditions
-
-	ctx, cancel := deadlineContext(b.writeDeadline)
+	ctx, cancel := b.deadlineContext(b.writeDeadline)
 	if cancel != nil {
 		defer cancel()
 	}
 
+	return b.DeleteContext(ctx, fileID)
+}
+
+// DeleteContext is like Delete, but uses ctx instead of the bucket's write deadline. If ctx does not have a deadline,
+// the bucket's timeout is applied.
+func (b *Bucket) DeleteContext(ctx context.Context, fileID interface{}) error {
+	// delete document in files collection and then chunks to minimize race conditions
+
+	ctx, cancel := b.timeoutContext(ctx)
+	defer cancel()
+
 	id, err := convertFileID(fileID)
 	if err != nil {
 		return err
@@ -272,11 +371,20 @@ func (b *Bucket) Delete(fileID interface{}) error {
 // If this download requires a custom read deadline to be set on the bucket, it cannot be done concurrently with other
 // read operations operations on this bucket that also require a custom deadline.
 func (b *Bucket) Find(filter interface{}, opts ...*options.GridFSFindOptions) (*mongo.Cursor, error) {
-	ctx, cancel := deadlineContext(b.readDeadline)
+	ctx, cancel := b.deadlineContext(b.readDeadline)
 	if cancel != nil {
 		defer cancel()
 	}
 
+	return b.FindContext(ctx, filter, opts...)
+}
+
+// FindContext is like Find, but uses ctx instead of the bucket's read deadline. If ctx does not have a deadline, the
+// bucket's timeout is applied to the find command.
+func (b *Bucket) FindContext(ctx context.Context, filter interface{}, opts ...*options.GridFSFindOptions) (*mongo.Cursor, error) {
+	ctx, cancel := b.timeoutContext(ctx)
+	defer cancel()
+
 	gfsOpts := options.MergeGridFSFindOptions(opts...)
 	find := options.Find()
 	if gfsOpts.AllowDiskUse != nil {
@@ -309,11 +417,20 @@ func (b *Bucket) Find(filter interface{}, opts ...*options.GridFSFindOptions) (*
 // If this operation requires a custom write deadline to be set on the bucket, it cannot be done concurrently with other
 // write operations operations on this bucket that also require a custom deadline
 func (b *Bucket) Rename(fileID interface{}, newFilename string) error {
-	ctx, cancel := deadlineContext(b.writeDeadline)
+	ctx, cancel := b.deadlineContext(b.writeDeadline)
 	if cancel != nil {
 		defer cancel()
 	}
 
+	return b.RenameContext(ctx, fileID, newFilename)
+}
+
+// RenameContext is like Rename, but uses ctx instead of the bucket's write deadline. If ctx does not have a deadline,
+// the bucket's timeout is applied.
+func (b *Bucket) RenameContext(ctx context.Context, fileID interface{}, newFilename string) error {
+	ctx, cancel := b.timeoutContext(ctx)
+	defer cancel()
+
 	id, err := convertFileID(fileID)
 	if err != nil {
 		return err
@@ -338,11 +455,20 @@ func (b *Bucket) Rename(fileID interface{}, newFilename string) error {
 // If this operation requires a custom write deadline to be set on the bucket, it cannot be done concurrently with other
 // write operations operations on this bucket that also require a custom deadline
 func (b *Bucket) Drop() error {
-	ctx, cancel := deadlineContext(b.writeDeadline)
+	ctx, cancel := b.deadlineContext(b.writeDeadline)
 	if cancel != nil {
 		defer cancel()
 	}
 
+	return b.DropContext(ctx)
+}
+
+// DropContext is like Drop, but uses ctx instead of the bucket's write deadline. If ctx does not have a deadline, the
+// bucket's timeout is applied.
+func (b *Bucket) DropContext(ctx context.Context) error {
+	ctx, cancel := b.timeoutContext(ctx)
+	defer cancel()
+
 	err := b.filesColl.Drop(ctx)
 	if err != nil {
 		return err
@@ -361,12 +487,74 @@ func (b *Bucket) GetChunksCollection() *mongo.Collection {
 	return b.chunksColl
 }
 
-func (b *Bucket) openDownloadStream(filter interface{}, opts ...*options.FindOptions) (*DownloadStream, error) {
-	ctx, cancel := deadlineContext(b.readDeadline)
-	if cancel != nil {
-		defer cancel()
+func (b *Bucket) openUploadStream(ctx context.Context, fileID interface{}, filename string, opts ...*options.UploadOptions) (*UploadStream, error) {
+	if err := b.checkFirstWrite(ctx); err != nil {
+		return nil, err
 	}
 
+	upload, err := b.parseUploadOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newUploadStream(upload, fileID, filename, b.chunksColl, b.filesColl), nil
+}
+
+func (b *Bucket) uploadFromStream(us *UploadStream, source io.Reader) error {
+	// Each upload uses its own buffer so uploads can run concurrently.
+	buf := make([]byte, b.chunkSize)
+	for {
+		n, err := source.Read(buf)
+		if err != nil && err != io.EOF {
+			_ = us.Abort() // upload considered aborted if source stream returns an error
+			return err
+		}
+
+		if n > 0 {
+			_, err := us.Write(buf[:n])
+			if err != nil {
+				return err
+			}
+		}
+
+		if n == 0 || err == io.EOF {
+			break
+		}
+	}
+
+	return us.Close()
+}
+
+// idFilter returns a filter for the files collection document with the given file ID.
+func idFilter(fileID interface{}) (bsonx.Doc, error) {
+	id, err := convertFileID(fileID)
+	if err != nil {
+		return nil, err
+	}
+	return bsonx.Doc{{"_id", id}}, nil
+}
+
+// nameFilter returns a filter and find options for the revision of the file with the given filename that is selected
+// by opts.
+func nameFilter(filename string, opts ...*options.NameOptions) (bsonx.Doc, *options.FindOptions) {
+	var numSkip int32 = -1
+	var sortOrder int32 = 1
+
+	nameOpts := options.MergeNameOptions(opts...)
+	if nameOpts.Revision != nil {
+		numSkip = *nameOpts.Revision
+	}
+
+	if numSkip < 0 {
+		sortOrder = -1
+		numSkip = (-1 * numSkip) - 1
+	}
+
+	findOpts := options.Find().SetSkip(int64(numSkip)).SetSort(bsonx.Doc{{"uploadDate", bsonx.Int32(sortOrder)}})
+	return bsonx.Doc{{"filename", bsonx.String(filename)}}, findOpts
+}
+
+func (b *Bucket) openDownloadStream(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (*DownloadStream, error) {
 	cursor, err := b.findFile(ctx, filter, opts...)
 	if err != nil {
 		return nil, err
@@ -380,32 +568,74 @@ func (b *Bucket) openDownloadStream(filter interface{}, opts ...*options.FindOpt
 		return nil, fmt.Errorf("error decoding files collection document: %v", err)
 	}
 
+	// Chunk offsets are computed from the chunk size the file was uploaded with, which can differ from the bucket's.
+	chunkSize := foundFile.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = b.chunkSize
+	}
+
 	if foundFile.Length == 0 {
-		return newDownloadStream(nil, b.chunkSize, &foundFile), nil
+		return newDownloadStream(nil, chunkSize, &foundFile), nil
 	}
 
+	id, err := convertFileID(foundFile.ID)
+	if err != nil {
+		return nil, err
+	}
 	chunksCursor, err := b.findChunks(ctx, foundFile.ID)
 	if err != nil {
 		return nil, err
 	}
-	return newDownloadStream(chunksCursor, b.chunkSize, &foundFile), nil
+	ds := newDownloadStream(chunksCursor, chunkSize, &foundFile)
+	ds.chunksColl = b.chunksColl
+	ds.fileID = id
+	ds.prefetchChunks = b.prefetchChunks
+	return ds, nil
+}
+
+func deadlineContext(parent context.Context, deadline time.Time) (context.Context, context.CancelFunc) {
+	if deadline.Equal(time.Time{}) {
+		return parent, nil
+	}
+
+	return context.WithDeadline(parent, deadline)
 }
 
-func deadlineContext(deadline time.Time) (context.Context, context.CancelFunc) {
+// deadlineContext is like the package-level deadlineContext, but falls back to the bucket's timeout if no deadline is
+// set.
+func (b *Bucket) deadlineContext(deadline time.Time) (context.Context, context.CancelFunc) {
 	if deadline.Equal(time.Time{}) {
-		return context.Background(), nil
+		return internal.MakeTimeoutContext(context.Background(), b.timeout)
 	}
 
 	return context.WithDeadline(context.Background(), deadline)
 }
 
-func (b *Bucket) downloadToStream(ds *DownloadStream, stream io.Writer) (int64, error) {
-	err := ds.SetReadDeadline(b.readDeadline)
-	if err != nil {
-		_ = ds.Close()
-		return 0, err
+// timeoutContext applies the bucket's timeout to ctx if ctx does not have a deadline. The returned CancelFunc is never
+// nil.
+func (b *Bucket) timeoutContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return internal.MakeTimeoutContext(ctx, b.timeout)
+}
+
+// contextStreamDeadline returns the deadline for a stream opened with ctx. The deadline of ctx applies to the stream,
+// so the bucket's timeout is only used if ctx does not have a deadline.
+func (b *Bucket) contextStreamDeadline(ctx context.Context) time.Time {
+	if _, ok := ctx.Deadline(); ok {
+		return time.Time{}
+	}
+	return b.streamDeadline(time.Time{})
+}
+
+// streamDeadline returns the deadline for a newly opened stream. If no deadline is set, the stream must finish within
+// the bucket's timeout of being opened.
+func (b *Bucket) streamDeadline(deadline time.Time) time.Time {
+	if deadline.Equal(time.Time{}) && b.timeout != nil && *b.timeout > 0 {
+		return time.Now().Add(*b.timeout)
 	}
+	return deadline
+}
 
+func (b *Bucket) downloadToStream(ds *DownloadStream, stream io.Writer) (int64, error) {
 	copied, err := io.Copy(stream, ds)
 	if err != nil {
 		_ = ds.Close()
@@ -453,6 +683,16 @@ func (b *Bucket) findChunks(ctx context.Context, fileID interface{}) (*mongo.Cur
 	return chunksCursor, nil
 }
 
+// findChunkRange finds the chunks with indexes first through last for the file with the given ID, which must have
+// been converted with convertFileID. The chunks are sorted by index.
+func findChunkRange(ctx context.Context, chunks *mongo.Collection, id bsonx.Val, first, last int32) (*mongo.Cursor, error) {
+	filter := bsonx.Doc{
+		{"files_id", id},
+		{"n", bsonx.Document(bsonx.Doc{{"$gte", bsonx.Int32(first)}, {"$lte", bsonx.Int32(last)}})},
+	}
+	return chunks.Find(ctx, filter, options.Find().SetSort(bsonx.Doc{{"n", bsonx.Int32(1)}}))
+}
+
 // returns true if the 2 index documents are equal
 func numericalIndexDocsEqual(expected, actual bsoncore.Document) (bool, error) {
 	if bytes.Equal(expected, actual) {
@@ -577,6 +817,9 @@ func (b *Bucket) createIndexes(ctx context.Context) error {
 }
 
 func (b *Bucket) checkFirstWrite(ctx context.Context) error {
+	b.firstWriteMu.Lock()
+	defer b.firstWriteMu.Unlock()
+
 	if !b.firstWriteDone {
 		// before the first write operation, must determine if files collection is empty
 		// if so, create indexes if they do not already exist
@@ -614,6 +857,17 @@ func (b *Bucket) parseUploadOptions(opts ...*options.UploadOptions) (*Upload, er
 		upload.metadata = doc
 	}
 
+	if uo.MaxInFlightBatches != nil {
+		upload.maxInFlight = *uo.MaxInFlightBatches
+	}
+
+	alg, h, err := newUploadHash(uo)
+	if err != nil {
+		return nil, err
+	}
+	upload.hashAlg = alg
+	upload.hash = h
+
 	return upload, nil
 }
 
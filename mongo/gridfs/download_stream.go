@@ -7,14 +7,18 @@
 package gridfs
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"hash"
 	"io"
 	"math"
+	"runtime"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/bsonx"
 )
 
 // ErrWrongIndex is used when the chunk retrieved from the server does not have the expected index.
@@ -23,9 +27,17 @@ var ErrWrongIndex = errors.New("chunk index does not match expected index")
 // ErrWrongSize is used when the chunk retrieved from the server does not have the expected size.
 var ErrWrongSize = errors.New("chunk size does not match expected size")
 
+// ErrMissingChunk is used when a chunk that is needed to read a file is not in the chunks collection.
+var ErrMissingChunk = errors.New("chunk is missing from the chunks collection")
+
+// ErrInvalidSeek is used when a Seek or ReadAt call would move to a negative offset or uses an invalid whence value.
+var ErrInvalidSeek = errors.New("invalid seek offset or whence")
+
 var errNoMoreChunks = errors.New("no more chunks remaining")
 
-// DownloadStream is a io.Reader that can be used to download a file from a GridFS bucket.
+// DownloadStream is a io.Reader that can be used to download a file from a GridFS bucket. It also implements
+// io.Seeker and io.ReaderAt, which fetch the chunks containing the requested bytes without reading the chunks before
+// them.
 type DownloadStream struct {
 	numChunks     int32
 	chunkSize     int32
@@ -37,7 +49,27 @@ type DownloadStream struct {
 	bufferEnd     int
 	expectedChunk int32 // index of next expected chunk
 	readDeadline  time.Time
+	ctx           context.Context // parent of the contexts used for server operations
 	fileLen       int64
+	pos           int64 // offset in the file of the next byte returned by Read
+	reposition    bool  // the cursor must be reopened at pos before the next Read
+
+	// The chunks collection and the converted file ID, used to find chunks when seeking. These are nil for empty
+	// files.
+	chunksColl *mongo.Collection
+	fileID     bsonx.Val
+
+	// The digest of the bytes returned by Read, which is compared to the stored digest when the end of the file is
+	// reached. The hash is only updated while the file is read sequentially from the start; hashed is the number of
+	// bytes read sequentially. hash is nil if the file doesn't have a digest or its hash algorithm is unsupported.
+	hash         hash.Hash
+	hashed       int64
+	hashAlg      string
+	expectedHash []byte
+	id           interface{}
+
+	prefetchChunks int32       // number of chunks to read ahead, or 0 to read chunks when they are needed
+	prefetch       *prefetcher // nil until the first chunk is read from the cursor
 
 	// The pointer returned by GetFile. This should not be used in the actual DownloadStream code outside of the
 	// newDownloadStream constructor because the values can be mutated by the user after calling GetFile. Instead,
@@ -67,6 +99,13 @@ type File struct {
 	// Metadata is additional data that was specified when creating this file. This field can be unmarshalled into a
 	// custom type using the bson.Unmarshal family of functions.
 	Metadata bson.Raw
+
+	// HashAlgorithm is the name of the hash algorithm that was used to compute Hash. It is empty if the file was
+	// uploaded without a hash algorithm.
+	HashAlgorithm string
+
+	// Hash is the digest of the file contents that was computed when the file was uploaded.
+	Hash []byte
 }
 
 var _ bson.Unmarshaler = (*File)(nil)
@@ -80,6 +119,10 @@ type unmarshalFile struct {
 	UploadDate time.Time   `bson:"uploadDate"`
 	Name       string      `bson:"filename"`
 	Metadata   bson.Raw    `bson:"metadata"`
+	Hash       *struct {
+		Algorithm string `bson:"algorithm"`
+		Digest    []byte `bson:"digest"`
+	} `bson:"hash"`
 }
 
 // UnmarshalBSON implements the bson.Unmarshaler interface.
@@ -95,58 +138,153 @@ func (f *File) UnmarshalBSON(data []byte) error {
 	f.UploadDate = temp.UploadDate
 	f.Name = temp.Name
 	f.Metadata = temp.Metadata
+	if temp.Hash != nil {
+		f.HashAlgorithm = temp.Hash.Algorithm
+		f.Hash = temp.Hash.Digest
+	}
 	return nil
 }
 
 func newDownloadStream(cursor *mongo.Cursor, chunkSize int32, file *File) *DownloadStream {
 	numChunks := int32(math.Ceil(float64(file.Length) / float64(chunkSize)))
 
+	var h hash.Hash
+	if newHash, ok := hashFuncs[file.HashAlgorithm]; ok && file.Hash != nil && file.Length > 0 {
+		h = newHash()
+	}
+
 	return &DownloadStream{
-		numChunks: numChunks,
-		chunkSize: chunkSize,
-		cursor:    cursor,
-		buffer:    make([]byte, chunkSize),
-		done:      cursor == nil,
-		fileLen:   file.Length,
-		file:      file,
+		numChunks:    numChunks,
+		chunkSize:    chunkSize,
+		cursor:       cursor,
+		buffer:       make([]byte, chunkSize),
+		done:         cursor == nil,
+		ctx:          context.Background(),
+		fileLen:      file.Length,
+		file:         file,
+		hash:         h,
+		hashAlg:      file.HashAlgorithm,
+		expectedHash: file.Hash,
+		id:           file.ID,
 	}
 }
 
-// Close closes this download stream.
+// Close closes this download stream. If the bucket prefetches chunks, Close must be called to stop the goroutine that
+// reads the chunks, even if the stream has not been read to the end of the file.
 func (ds *DownloadStream) Close() error {
 	if ds.closed {
 		return ErrStreamClosed
 	}
 
 	ds.closed = true
-	return nil
+	return ds.closeCursor()
 }
 
-// SetReadDeadline sets the read deadline for this download stream.
+func (ds *DownloadStream) closeCursor() error {
+	if ds.prefetch != nil {
+		ds.prefetch.stop()
+		ds.prefetch = nil
+		runtime.SetFinalizer(ds, nil)
+	}
+	if ds.cursor == nil {
+		return nil
+	}
+
+	ctx, cancel := deadlineContext(ds.ctx, ds.readDeadline)
+	if cancel != nil {
+		defer cancel()
+	}
+
+	cursor := ds.cursor
+	ds.cursor = nil
+	return cursor.Close(ctx)
+}
+
+// SetReadDeadline sets the read deadline for this download stream. If chunks are being prefetched, the chunks that
+// have been prefetched are discarded and the next Read fetches them again using the new deadline.
 func (ds *DownloadStream) SetReadDeadline(t time.Time) error {
 	if ds.closed {
 		return ErrStreamClosed
 	}
 
+	if ds.prefetch != nil && !t.Equal(ds.readDeadline) {
+		// The prefetcher's context has the old deadline and it may have advanced the cursor past the buffered
+		// chunk, so the cursor is reopened at the current offset by the next Read.
+		ds.prefetch.stop()
+		ds.prefetch = nil
+		runtime.SetFinalizer(ds, nil)
+		ds.reposition = ds.pos < ds.fileLen
+	}
 	ds.readDeadline = t
 	return nil
 }
 
 // Read reads the file from the server and writes it to a destination byte slice.
+//
+// If the file was uploaded with a hash algorithm and has been read sequentially from the start, the digest of the
+// contents is compared to the stored digest when the end of the file is reached. If they do not match, or the stored
+// digest uses an unsupported hash algorithm, Read returns a *CorruptionError. Seeking back to the start of the file
+// restarts the digest.
 func (ds *DownloadStream) Read(p []byte) (int, error) {
 	if ds.closed {
 		return 0, ErrStreamClosed
 	}
 
-	if ds.done {
+	if ds.done || ds.pos >= ds.fileLen {
 		return 0, io.EOF
 	}
 
-	ctx, cancel := deadlineContext(ds.readDeadline)
+	ctx, cancel := deadlineContext(ds.ctx, ds.readDeadline)
 	if cancel != nil {
 		defer cancel()
 	}
 
+	if ds.reposition {
+		if err := ds.reopenCursor(ctx); err != nil {
+			if err == errNoMoreChunks {
+				return 0, io.EOF
+			}
+			return 0, err
+		}
+	}
+
+	start := ds.pos
+	n, err := ds.read(ctx, p)
+	if err == nil || err == io.EOF {
+		if hashErr := ds.updateHash(start, p[:n]); hashErr != nil {
+			return n, hashErr
+		}
+	}
+	return n, err
+}
+
+// updateHash writes the bytes read at offset start to the hash if the file has been read sequentially so far and
+// returns a CorruptionError if the end of the file is reached and the digest does not match the stored digest or
+// cannot be computed.
+func (ds *DownloadStream) updateHash(start int64, p []byte) error {
+	if ds.expectedHash == nil || ds.hashed != start || len(p) == 0 {
+		return nil
+	}
+
+	if ds.hash != nil {
+		_, _ = ds.hash.Write(p)
+	}
+	ds.hashed += int64(len(p))
+	if ds.hashed != ds.fileLen {
+		return nil
+	}
+	if ds.hash == nil {
+		return unsupportedHash(ds.id, ds.hashAlg)
+	}
+
+	if sum := ds.hash.Sum(nil); !bytes.Equal(sum, ds.expectedHash) {
+		return hashMismatch(ds.id, ds.hashAlg, ds.expectedHash, sum)
+	}
+	return nil
+}
+
+// read copies bytes starting at ds.pos into p.
+func (ds *DownloadStream) read(ctx context.Context, p []byte) (int, error) {
 	bytesCopied := 0
 	var err error
 	for bytesCopied < len(p) {
@@ -169,56 +307,181 @@ func (ds *DownloadStream) Read(p []byte) (int, error) {
 
 		bytesCopied += copied
 		ds.bufferStart += copied
+		ds.pos += int64(copied)
 	}
 
 	return len(p), nil
 }
 
-// Skip skips a given number of bytes in the file.
+// Skip skips a given number of bytes in the file. It returns the number of bytes skipped, which is less than skip
+// if the end of the file is reached. Skipped chunks are not downloaded.
 func (ds *DownloadStream) Skip(skip int64) (int64, error) {
 	if ds.closed {
 		return 0, ErrStreamClosed
 	}
 
-	if ds.done {
+	if ds.done || skip <= 0 {
 		return 0, nil
 	}
 
-	ctx, cancel := deadlineContext(ds.readDeadline)
-	if cancel != nil {
-		defer cancel()
+	if remaining := ds.fileLen - ds.pos; skip > remaining {
+		skip = remaining
+	}
+	if skip <= 0 {
+		return 0, nil
 	}
 
-	var skipped int64
-	var err error
+	ds.seekTo(ds.pos + skip)
+	return skip, nil
+}
 
-	for skipped < skip {
-		if ds.bufferStart == 0 {
-			err = ds.fillBuffer(ctx)
-			if err != nil {
-				if err == errNoMoreChunks {
-					return skipped, nil
-				}
+// Seek sets the offset for the next Read or Skip to offset, interpreted according to whence: io.SeekStart means
+// relative to the start of the file, io.SeekCurrent means relative to the current offset, and io.SeekEnd means
+// relative to the end of the file. Seek returns the new offset relative to the start of the file. Seeking past the
+// end of the file is allowed, in which case the next Read returns io.EOF.
+//
+// Seek does not communicate with the server. If the new offset is outside of the chunk that is currently buffered,
+// the next Read finds the chunks starting at the chunk that contains the new offset.
+func (ds *DownloadStream) Seek(offset int64, whence int) (int64, error) {
+	if ds.closed {
+		return 0, ErrStreamClosed
+	}
 
-				return skipped, err
-			}
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = ds.pos + offset
+	case io.SeekEnd:
+		pos = ds.fileLen + offset
+	default:
+		return 0, ErrInvalidSeek
+	}
+	if pos < 0 {
+		return 0, ErrInvalidSeek
+	}
+
+	ds.seekTo(pos)
+	return pos, nil
+}
+
+// seekTo moves the stream to the offset pos. If pos is in the chunk that is currently buffered, the buffer is reused.
+// Otherwise, the cursor is reopened by the next Read.
+func (ds *DownloadStream) seekTo(pos int64) {
+	if pos == ds.pos {
+		return
+	}
+	ds.pos = pos
+	ds.done = false
+	if pos == 0 {
+		// Reading from the start again computes the digest again.
+		if ds.hash != nil {
+			ds.hash.Reset()
 		}
+		ds.hashed = 0
+	}
 
-		// try to skip whole chunk if possible
-		toSkip := 0
-		if skip-skipped < int64(len(ds.buffer)) {
-			// can skip whole chunk
-			toSkip = len(ds.buffer)
-		} else {
-			// can only skip part of buffer
-			toSkip = int(skip - skipped)
+	if !ds.reposition && ds.bufferEnd > 0 {
+		chunkStart := int64(ds.expectedChunk-1) * int64(ds.chunkSize)
+		if pos >= chunkStart && pos <= chunkStart+int64(ds.bufferEnd) {
+			ds.bufferStart = int(pos - chunkStart)
+			return
 		}
+	}
+
+	ds.reposition = ds.chunksColl != nil && pos < ds.fileLen
+}
+
+// reopenCursor replaces the cursor with one that starts at the chunk containing ds.pos and fills the buffer with that
+// chunk.
+func (ds *DownloadStream) reopenCursor(ctx context.Context) error {
+	if err := ds.closeCursor(); err != nil {
+		return err
+	}
 
-		skipped += int64(toSkip)
-		ds.bufferStart = (ds.bufferStart + toSkip) % (int(ds.chunkSize))
+	chunk := int32(ds.pos / int64(ds.chunkSize))
+	cursor, err := findChunkRange(ctx, ds.chunksColl, ds.fileID, chunk, ds.numChunks-1)
+	if err != nil {
+		return err
 	}
+	ds.cursor = cursor
+	ds.expectedChunk = chunk
+	ds.bufferStart = 0
+	ds.bufferEnd = 0
+	ds.reposition = false
 
-	return skip, nil
+	if err = ds.fillBuffer(ctx); err != nil {
+		if err == errNoMoreChunks {
+			// The chunk containing ds.pos must exist because ds.pos is before the end of the file.
+			return ErrMissingChunk
+		}
+		return err
+	}
+	ds.bufferStart = int(ds.pos - int64(chunk)*int64(ds.chunkSize))
+	return nil
+}
+
+// ReadAt reads len(p) bytes starting at offset off in the file into p. It implements io.ReaderAt: if fewer than
+// len(p) bytes are read because the end of the file is reached, the error is io.EOF. ReadAt only finds the chunks that
+// contain the requested bytes and does not use or change the offset used by Read, Skip, and Seek.
+func (ds *DownloadStream) ReadAt(p []byte, off int64) (int, error) {
+	if ds.closed {
+		return 0, ErrStreamClosed
+	}
+	if off < 0 {
+		return 0, ErrInvalidSeek
+	}
+	if off >= ds.fileLen {
+		return 0, io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	end := off + int64(len(p))
+	if end > ds.fileLen {
+		end = ds.fileLen
+	}
+
+	ctx, cancel := deadlineContext(ds.ctx, ds.readDeadline)
+	if cancel != nil {
+		defer cancel()
+	}
+
+	first := int32(off / int64(ds.chunkSize))
+	last := int32((end - 1) / int64(ds.chunkSize))
+	cursor, err := findChunkRange(ctx, ds.chunksColl, ds.fileID, first, last)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var n int
+	expected := first
+	for expected <= last && cursor.Next(ctx) {
+		data, err := ds.chunkData(cursor.Current, expected)
+		if err != nil {
+			return n, err
+		}
+
+		chunkStart := int64(expected) * int64(ds.chunkSize)
+		n += copy(p[n:end-off], data[off+int64(n)-chunkStart:])
+		expected++
+	}
+	if err = cursor.Err(); err != nil {
+		return n, err
+	}
+	if expected <= last {
+		return n, ErrMissingChunk
+	}
+
+	if end-off < int64(len(p)) {
+		return n, io.EOF
+	}
+	return n, nil
 }
 
 // GetFile returns a File object representing the file being downloaded.
@@ -227,45 +490,157 @@ func (ds *DownloadStream) GetFile() *File {
 }
 
 func (ds *DownloadStream) fillBuffer(ctx context.Context) error {
-	if !ds.cursor.Next(ctx) {
+	chunk, err := ds.nextChunk(ctx)
+	if err != nil {
 		ds.done = true
-		return errNoMoreChunks
+		return err
 	}
 
-	chunkIndex, err := ds.cursor.Current.LookupErr("n")
+	dataBytes, err := ds.chunkData(chunk, ds.expectedChunk)
 	if err != nil {
 		return err
 	}
 
-	if chunkIndex.Int32() != ds.expectedChunk {
-		return ErrWrongIndex
+	ds.expectedChunk++
+	ds.bufferStart = 0
+	ds.bufferEnd = copy(ds.buffer, dataBytes)
+
+	return nil
+}
+
+// nextChunk returns the next chunk document from the cursor, or errNoMoreChunks if the cursor is exhausted. If
+// prefetching is enabled, the chunk is taken from the prefetcher, which is started on the first call.
+func (ds *DownloadStream) nextChunk(ctx context.Context) (bson.Raw, error) {
+	if ds.prefetchChunks <= 0 {
+		if !ds.cursor.Next(ctx) {
+			if err := ds.cursor.Err(); err != nil {
+				return nil, err
+			}
+			return nil, errNoMoreChunks
+		}
+		return ds.cursor.Current, nil
+	}
+
+	if ds.prefetch == nil {
+		ds.prefetch = startPrefetch(ds.ctx, ds.readDeadline, ds.cursor, ds.prefetchChunks)
+		// The goroutine does not reference the stream, so a stream that is abandoned without calling Close can be
+		// garbage collected. The finalizer then stops the goroutine, which would otherwise block forever.
+		runtime.SetFinalizer(ds, (*DownloadStream).stopAbandonedPrefetch)
+	}
+	select {
+	case chunk, ok := <-ds.prefetch.chunks:
+		if !ok {
+			return nil, errNoMoreChunks
+		}
+		return chunk.doc, chunk.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
+}
 
-	ds.expectedChunk++
-	data, err := ds.cursor.Current.LookupErr("data")
+// prefetcher reads chunk documents from a cursor in a background goroutine and buffers them until they are needed.
+type prefetcher struct {
+	chunks chan prefetchedChunk // closed when the cursor is exhausted or the prefetcher is stopped
+	cancel context.CancelFunc
+	done   chan struct{} // closed when the goroutine returns
+}
+
+type prefetchedChunk struct {
+	doc bson.Raw
+	err error
+}
+
+// startPrefetch starts reading up to n chunks ahead from cursor. The cursor must not be used by the caller until the
+// prefetcher is stopped.
+func startPrefetch(parent context.Context, deadline time.Time, cursor *mongo.Cursor, n int32) *prefetcher {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if deadline.IsZero() {
+		ctx, cancel = context.WithCancel(parent)
+	} else {
+		ctx, cancel = context.WithDeadline(parent, deadline)
+	}
+
+	p := &prefetcher{
+		chunks: make(chan prefetchedChunk, n),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(p.done)
+		defer close(p.chunks)
+
+		for cursor.Next(ctx) {
+			// Current is only valid until the next call to Next.
+			doc := append(bson.Raw(nil), cursor.Current...)
+			select {
+			case p.chunks <- prefetchedChunk{doc: doc}:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := cursor.Err(); err != nil {
+			select {
+			case p.chunks <- prefetchedChunk{err: err}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+	return p
+}
+
+// stop stops the goroutine and waits for it to return, after which the cursor can be used again.
+func (p *prefetcher) stop() {
+	p.cancel()
+	<-p.done
+}
+
+// stopAbandonedPrefetch is the finalizer of a stream with a running prefetcher. It stops the prefetcher and closes the
+// cursor without blocking the finalizer goroutine.
+func (ds *DownloadStream) stopAbandonedPrefetch() {
+	p, cursor := ds.prefetch, ds.cursor
+	if p == nil {
+		return
+	}
+	go func() {
+		p.stop()
+		if cursor != nil {
+			_ = cursor.Close(context.Background())
+		}
+	}()
+}
+
+// chunkData returns the data of the chunk document chunk after checking that it has the index n and the expected
+// size.
+func (ds *DownloadStream) chunkData(chunk bson.Raw, n int32) ([]byte, error) {
+	chunkIndex, err := chunk.LookupErr("n")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, dataBytes := data.Binary()
-	copied := copy(ds.buffer, dataBytes)
+	index, ok := chunkIndex.Int32OK()
+	if !ok || index != n {
+		return nil, ErrWrongIndex
+	}
+
+	data, err := chunk.LookupErr("data")
+	if err != nil {
+		return nil, err
+	}
 
-	bytesLen := int32(len(dataBytes))
-	if ds.expectedChunk == ds.numChunks {
+	_, dataBytes := data.Binary()
+	bytesLen := int64(len(dataBytes))
+	if n == ds.numChunks-1 {
 		// final chunk can be fewer than ds.chunkSize bytes
-		bytesDownloaded := int64(ds.chunkSize) * (int64(ds.expectedChunk) - int64(1))
-		bytesRemaining := ds.fileLen - int64(bytesDownloaded)
+		bytesRemaining := ds.fileLen - int64(ds.chunkSize)*int64(n)
 
-		if int64(bytesLen) != bytesRemaining {
-			return ErrWrongSize
+		if bytesLen != bytesRemaining {
+			return nil, ErrWrongSize
 		}
-	} else if bytesLen != ds.chunkSize {
+	} else if bytesLen != int64(ds.chunkSize) {
 		// all intermediate chunks must have size ds.chunkSize
-		return ErrWrongSize
+		return nil, ErrWrongSize
 	}
 
-	ds.bufferStart = 0
-	ds.bufferEnd = copied
-
-	return nil
+	return dataBytes, nil
 }
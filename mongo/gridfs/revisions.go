@@ -0,0 +1,166 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package gridfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrNegativeKeep is returned by DeleteRevisions if the number of revisions to keep is negative.
+var ErrNegativeKeep = errors.New("number of revisions to keep must not be negative")
+
+// UpdateMetadata replaces the metadata of the file with the given file ID. If metadata is nil, the "metadata" field
+// is removed from the files collection document.
+//
+// If this operation requires a custom write deadline to be set on the bucket, it cannot be done concurrently with other
+// write operations operations on this bucket that also require a custom deadline.
+func (b *Bucket) UpdateMetadata(fileID interface{}, metadata interface{}) error {
+	ctx, cancel := b.deadlineContext(b.writeDeadline)
+	if cancel != nil {
+		defer cancel()
+	}
+
+	return b.UpdateMetadataContext(ctx, fileID, metadata)
+}
+
+// UpdateMetadataContext is like UpdateMetadata, but uses ctx instead of the bucket's write deadline. If ctx does not
+// have a deadline, the bucket's timeout is applied.
+func (b *Bucket) UpdateMetadataContext(ctx context.Context, fileID interface{}, metadata interface{}) error {
+	ctx, cancel := b.timeoutContext(ctx)
+	defer cancel()
+
+	id, err := convertFileID(fileID)
+	if err != nil {
+		return err
+	}
+
+	update := bson.D{{"$unset", bson.D{{"metadata", ""}}}}
+	if metadata != nil {
+		doc, err := bson.Marshal(metadata)
+		if err != nil {
+			return err
+		}
+		update = bson.D{{"$set", bson.D{{"metadata", bson.Raw(doc)}}}}
+	}
+
+	res, err := b.filesColl.UpdateOne(ctx, bson.D{{"_id", id}}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrFileNotFound
+	}
+	return nil
+}
+
+// ListRevisions returns the revisions of the file with the given filename, ordered from the original stored file to
+// the most recent revision. The index of a revision in the result is the revision number used by
+// NameOptions.Revision. If no file has the given filename, an empty slice is returned.
+//
+// If this operation requires a custom read deadline to be set on the bucket, it cannot be done concurrently with other
+// read operations operations on this bucket that also require a custom deadline.
+func (b *Bucket) ListRevisions(filename string) ([]*File, error) {
+	ctx, cancel := b.deadlineContext(b.readDeadline)
+	if cancel != nil {
+		defer cancel()
+	}
+
+	return b.ListRevisionsContext(ctx, filename)
+}
+
+// ListRevisionsContext is like ListRevisions, but uses ctx instead of the bucket's read deadline. If ctx does not have
+// a deadline, the bucket's timeout is applied.
+func (b *Bucket) ListRevisionsContext(ctx context.Context, filename string) ([]*File, error) {
+	ctx, cancel := b.timeoutContext(ctx)
+	defer cancel()
+
+	cursor, err := b.filesColl.Find(ctx, bson.D{{"filename", filename}},
+		options.Find().SetSort(bson.D{{"uploadDate", 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	revisions := make([]*File, 0)
+	for cursor.Next(ctx) {
+		var file File
+		if err = cursor.Decode(&file); err != nil {
+			return nil, fmt.Errorf("error decoding files collection document: %v", err)
+		}
+		revisions = append(revisions, &file)
+	}
+	return revisions, cursor.Err()
+}
+
+// DeleteRevisions deletes all but the keepLatest most recent revisions of the file with the given filename, including
+// their chunks. It returns the number of revisions that were deleted. If keepLatest is 0, every revision is deleted.
+//
+// If this operation requires a custom write deadline to be set on the bucket, it cannot be done concurrently with other
+// write operations operations on this bucket that also require a custom deadline.
+func (b *Bucket) DeleteRevisions(filename string, keepLatest int) (int64, error) {
+	ctx, cancel := b.deadlineContext(b.writeDeadline)
+	if cancel != nil {
+		defer cancel()
+	}
+
+	return b.DeleteRevisionsContext(ctx, filename, keepLatest)
+}
+
+// DeleteRevisionsContext is like DeleteRevisions, but uses ctx instead of the bucket's write deadline. If ctx does not
+// have a deadline, the bucket's timeout is applied.
+func (b *Bucket) DeleteRevisionsContext(ctx context.Context, filename string, keepLatest int) (int64, error) {
+	if keepLatest < 0 {
+		return 0, ErrNegativeKeep
+	}
+
+	ctx, cancel := b.timeoutContext(ctx)
+	defer cancel()
+
+	findOpts := options.Find().
+		SetSort(bson.D{{"uploadDate", -1}}).
+		SetSkip(int64(keepLatest)).
+		SetProjection(bson.D{{"_id", 1}})
+	cursor, err := b.filesColl.Find(ctx, bson.D{{"filename", filename}}, findOpts)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var ids bson.A
+	for cursor.Next(ctx) {
+		id, err := cursor.Current.LookupErr("_id")
+		if err != nil {
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	if err = cursor.Err(); err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	// delete documents in files collection and then chunks to minimize race conditions
+	res, err := b.filesColl.DeleteMany(ctx, bson.D{{"_id", bson.D{{"$in", ids}}}})
+	if err != nil {
+		return 0, err
+	}
+	if _, err = b.chunksColl.DeleteMany(ctx, bson.D{{"files_id", bson.D{{"$in", ids}}}}); err != nil {
+		return res.DeletedCount, err
+	}
+	return res.DeletedCount, nil
+}
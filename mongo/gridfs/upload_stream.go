@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"math"
+	"sync"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -42,11 +43,19 @@ type UploadStream struct {
 	bufferIndex   int
 	fileLen       int64
 	writeDeadline time.Time
+	ctx           context.Context // parent of the contexts used for server operations
+
+	// Concurrent chunk inserts. inFlight has a slot for each batch that can be inserted concurrently and is nil if
+	// batches are inserted synchronously. insertErr is the first error returned by a concurrent insert.
+	inFlight  chan struct{}
+	inserts   sync.WaitGroup
+	errMu     sync.Mutex
+	insertErr error
 }
 
 // NewUploadStream creates a new upload stream.
 func newUploadStream(upload *Upload, fileID interface{}, filename string, chunks, files *mongo.Collection) *UploadStream {
-	return &UploadStream{
+	us := &UploadStream{
 		Upload: upload,
 		FileID: fileID,
 
@@ -54,7 +63,12 @@ func newUploadStream(upload *Upload, fileID interface{}, filename string, chunks
 		filename:   filename,
 		filesColl:  files,
 		buffer:     make([]byte, UploadBufferSize),
+		ctx:        context.Background(),
 	}
+	if upload.maxInFlight > 1 {
+		us.inFlight = make(chan struct{}, upload.maxInFlight)
+	}
+	return us
 }
 
 // Close writes file metadata to the files collection and cleans up any resources associated with the UploadStream.
@@ -63,7 +77,7 @@ func (us *UploadStream) Close() error {
 		return ErrStreamClosed
 	}
 
-	ctx, cancel := deadlineContext(us.writeDeadline)
+	ctx, cancel := deadlineContext(us.ctx, us.writeDeadline)
 	if cancel != nil {
 		defer cancel()
 	}
@@ -74,6 +88,11 @@ func (us *UploadStream) Close() error {
 		}
 	}
 
+	// The files collection document must not be visible before all chunks are inserted.
+	if err := us.waitForInserts(); err != nil {
+		return err
+	}
+
 	if err := us.createFilesCollDoc(ctx); err != nil {
 		return err
 	}
@@ -101,7 +120,7 @@ func (us *UploadStream) Write(p []byte) (int, error) {
 
 	var ctx context.Context
 
-	ctx, cancel := deadlineContext(us.writeDeadline)
+	ctx, cancel := deadlineContext(us.ctx, us.writeDeadline)
 	if cancel != nil {
 		defer cancel()
 	}
@@ -132,11 +151,14 @@ func (us *UploadStream) Abort() error {
 		return ErrStreamClosed
 	}
 
-	ctx, cancel := deadlineContext(us.writeDeadline)
+	ctx, cancel := deadlineContext(us.ctx, us.writeDeadline)
 	if cancel != nil {
 		defer cancel()
 	}
 
+	// Chunks that are still being inserted must be deleted too.
+	_ = us.waitForInserts()
+
 	id, err := convertFileID(us.FileID)
 	if err != nil {
 		return err
@@ -163,6 +185,12 @@ func (us *UploadStream) uploadChunks(ctx context.Context, uploadPartial bool) er
 
 	docs := make([]interface{}, int(numChunks))
 
+	data := us.buffer[:us.bufferIndex]
+	if us.inFlight != nil {
+		// The buffer is reused before a concurrent insert finishes.
+		data = append([]byte(nil), data...)
+	}
+
 	id, err := convertFileID(us.FileID)
 	if err != nil {
 		return err
@@ -177,7 +205,10 @@ func (us *UploadStream) uploadChunks(ctx context.Context, uploadPartial bool) er
 			}
 			endIndex = us.bufferIndex
 		}
-		chunkData := us.buffer[i:endIndex]
+		chunkData := data[i:endIndex]
+		if us.hash != nil {
+			_, _ = us.hash.Write(chunkData)
+		}
 		docs[us.chunkIndex-begChunkIndex] = bsonx.Doc{
 			{"_id", bsonx.ObjectID(primitive.NewObjectID())},
 			{"files_id", id},
@@ -188,7 +219,11 @@ func (us *UploadStream) uploadChunks(ctx context.Context, uploadPartial bool) er
 		us.fileLen += int64(len(chunkData))
 	}
 
-	_, err = us.chunksColl.InsertMany(ctx, docs)
+	if us.inFlight != nil {
+		err = us.insertConcurrently(ctx, docs)
+	} else {
+		_, err = us.chunksColl.InsertMany(ctx, docs)
+	}
 	if err != nil {
 		return err
 	}
@@ -202,6 +237,53 @@ func (us *UploadStream) uploadChunks(ctx context.Context, uploadPartial bool) er
 	return nil
 }
 
+// insertConcurrently inserts docs in a new goroutine once fewer than the maximum number of batches are in flight.
+// Errors from earlier inserts are returned instead of starting a new insert.
+func (us *UploadStream) insertConcurrently(ctx context.Context, docs []interface{}) error {
+	if err := us.getInsertErr(); err != nil {
+		return err
+	}
+
+	select {
+	case us.inFlight <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	// The insert can outlive the context of the Write call that started it, so it uses its own context.
+	deadline := us.writeDeadline
+	us.inserts.Add(1)
+	go func() {
+		defer us.inserts.Done()
+		defer func() { <-us.inFlight }()
+
+		ctx, cancel := deadlineContext(us.ctx, deadline)
+		if cancel != nil {
+			defer cancel()
+		}
+		if _, err := us.chunksColl.InsertMany(ctx, docs); err != nil {
+			us.errMu.Lock()
+			if us.insertErr == nil {
+				us.insertErr = err
+			}
+			us.errMu.Unlock()
+		}
+	}()
+	return nil
+}
+
+// waitForInserts waits for all concurrent inserts to finish and returns the first error returned by one of them.
+func (us *UploadStream) waitForInserts() error {
+	us.inserts.Wait()
+	return us.getInsertErr()
+}
+
+func (us *UploadStream) getInsertErr() error {
+	us.errMu.Lock()
+	defer us.errMu.Unlock()
+	return us.insertErr
+}
+
 func (us *UploadStream) createFilesCollDoc(ctx context.Context) error {
 	id, err := convertFileID(us.FileID)
 	if err != nil {
@@ -218,6 +300,12 @@ func (us *UploadStream) createFilesCollDoc(ctx context.Context) error {
 	if us.metadata != nil {
 		doc = append(doc, bsonx.Elem{"metadata", bsonx.Document(us.metadata)})
 	}
+	if us.hash != nil {
+		doc = append(doc, bsonx.Elem{"hash", bsonx.Document(bsonx.Doc{
+			{"algorithm", bsonx.String(us.hashAlg)},
+			{"digest", bsonx.Binary(0x00, us.hash.Sum(nil))},
+		})})
+	}
 
 	_, err = us.filesColl.InsertOne(ctx, doc)
 	if err != nil {
@@ -0,0 +1,247 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package gridfs
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"math"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// hashFuncs maps the names of the supported hash algorithms to their constructors.
+var hashFuncs = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// CorruptionError is returned by DownloadStream when the digest of a file does not match the stored digest or the
+// stored digest uses an unsupported hash algorithm. Verify returns a CorruptionError for every problem it finds,
+// including missing chunks and chunks with the wrong size. DownloadStream reports missing chunks and chunks with the
+// wrong size with ErrMissingChunk and ErrWrongSize.
+type CorruptionError struct {
+	// FileID is the ID of the file. For orphaned chunks, it is the files_id value of the chunks.
+	FileID interface{}
+
+	// Reason describes the problem.
+	Reason string
+}
+
+func (e *CorruptionError) Error() string {
+	return fmt.Sprintf("file %v is corrupt: %s", e.FileID, e.Reason)
+}
+
+// hashMismatch returns a CorruptionError for a file whose digest does not match the stored digest.
+func hashMismatch(fileID interface{}, alg string, expected, actual []byte) *CorruptionError {
+	return &CorruptionError{
+		FileID: fileID,
+		Reason: fmt.Sprintf("%s digest %x does not match stored digest %x", alg, actual, expected),
+	}
+}
+
+// unsupportedHash returns a CorruptionError for a file whose digest was computed with an unsupported hash algorithm and
+// so cannot be verified.
+func unsupportedHash(fileID interface{}, alg string) *CorruptionError {
+	return &CorruptionError{
+		FileID: fileID,
+		Reason: fmt.Sprintf("stored digest uses unsupported hash algorithm %q", alg),
+	}
+}
+
+// Verify checks every file in the bucket against its chunks. The chunk count and the size of each chunk must match
+// the length and chunkSize of the files collection document and, if the file was uploaded with a hash algorithm, the
+// digest of the chunks must match the stored digest. A file with a stored digest that uses an unsupported hash
+// algorithm is reported as corrupt. Chunks that do not belong to any file are reported as orphaned.
+// A CorruptionError is returned for every problem found.
+//
+// Verify reads the contents of every file in the bucket.
+//
+// If this operation requires a custom read deadline to be set on the bucket, it cannot be done concurrently with other
+// read operations operations on this bucket that also require a custom deadline.
+func (b *Bucket) Verify() ([]*CorruptionError, error) {
+	ctx, cancel := b.deadlineContext(b.readDeadline)
+	if cancel != nil {
+		defer cancel()
+	}
+
+	return b.VerifyContext(ctx)
+}
+
+// VerifyContext is like Verify, but uses ctx instead of the bucket's read deadline. If ctx does not have a deadline,
+// the bucket's timeout is applied.
+func (b *Bucket) VerifyContext(ctx context.Context) ([]*CorruptionError, error) {
+	ctx, cancel := b.timeoutContext(ctx)
+	defer cancel()
+
+	files, err := b.filesColl.Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = files.Close(ctx)
+	}()
+
+	var problems []*CorruptionError
+	fileIDs := make(map[string]bool)
+	for files.Next(ctx) {
+		id, err := files.Current.LookupErr("_id")
+		if err != nil {
+			return nil, err
+		}
+		fileIDs[idKey(id)] = true
+
+		var file File
+		if err = files.Decode(&file); err != nil {
+			return nil, fmt.Errorf("error decoding files collection document: %v", err)
+		}
+		fileProblems, err := b.verifyFile(ctx, &file)
+		if err != nil {
+			return nil, err
+		}
+		problems = append(problems, fileProblems...)
+	}
+	if err = files.Err(); err != nil {
+		return nil, err
+	}
+
+	groups, err := b.chunksColl.Aggregate(ctx, mongo.Pipeline{{{"$group", bson.D{{"_id", "$files_id"}}}}})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = groups.Close(ctx)
+	}()
+
+	for groups.Next(ctx) {
+		id := groups.Current.Lookup("_id")
+		if fileIDs[idKey(id)] {
+			continue
+		}
+		problems = append(problems, &CorruptionError{FileID: id, Reason: "orphaned chunks without a files collection document"})
+	}
+	return problems, groups.Err()
+}
+
+// verifyFile checks the chunks of a single file.
+func (b *Bucket) verifyFile(ctx context.Context, file *File) ([]*CorruptionError, error) {
+	var problems []*CorruptionError
+	report := func(format string, args ...interface{}) {
+		problems = append(problems, &CorruptionError{FileID: file.ID, Reason: fmt.Sprintf(format, args...)})
+	}
+
+	if file.Length > 0 && file.ChunkSize <= 0 {
+		report("invalid chunkSize %d", file.ChunkSize)
+		return problems, nil
+	}
+
+	var numChunks int32
+	if file.Length > 0 {
+		numChunks = int32(math.Ceil(float64(file.Length) / float64(file.ChunkSize)))
+	}
+
+	var h hash.Hash
+	if file.Hash != nil {
+		newHash, ok := hashFuncs[file.HashAlgorithm]
+		if !ok {
+			problems = append(problems, unsupportedHash(file.ID, file.HashAlgorithm))
+		} else {
+			h = newHash()
+		}
+	}
+
+	chunks, err := b.findChunks(ctx, file.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = chunks.Close(ctx)
+	}()
+
+	var expected int32
+	for chunks.Next(ctx) {
+		n, ok := chunks.Current.Lookup("n").Int32OK()
+		if !ok {
+			report("chunk without a valid index")
+			continue
+		}
+		switch {
+		case n >= numChunks:
+			report("unexpected chunk %d, the file has %d chunks", n, numChunks)
+			continue
+		case n < expected:
+			report("duplicate chunk %d", n)
+			continue
+		case n > expected:
+			report("missing chunks %d through %d", expected, n-1)
+			h = nil
+		}
+		expected = n + 1
+
+		_, data, ok := chunks.Current.Lookup("data").BinaryOK()
+		if !ok {
+			report("chunk %d has no binary data", n)
+			h = nil
+			continue
+		}
+		size := int64(file.ChunkSize)
+		if n == numChunks-1 {
+			size = file.Length - int64(file.ChunkSize)*int64(n)
+		}
+		if int64(len(data)) != size {
+			report("chunk %d has %d bytes, expected %d", n, len(data), size)
+			h = nil
+			continue
+		}
+		if h != nil {
+			_, _ = h.Write(data)
+		}
+	}
+	if err = chunks.Err(); err != nil {
+		return nil, err
+	}
+
+	if expected < numChunks {
+		report("missing chunks %d through %d", expected, numChunks-1)
+		return problems, nil
+	}
+	if h != nil {
+		if sum := h.Sum(nil); !bytes.Equal(sum, file.Hash) {
+			problems = append(problems, hashMismatch(file.ID, file.HashAlgorithm, file.Hash, sum))
+		}
+	}
+	return problems, nil
+}
+
+// idKey returns a map key for a BSON value that is equal for values with the same type and bytes.
+func idKey(id bson.RawValue) string {
+	return string(id.Type) + string(id.Value)
+}
+
+// newUploadHash returns the hash for the algorithm named by opts, or nil if no algorithm is set.
+func newUploadHash(opts *options.UploadOptions) (string, hash.Hash, error) {
+	if opts.HashAlgorithm == nil {
+		return "", nil, nil
+	}
+
+	alg := *opts.HashAlgorithm
+	newHash, ok := hashFuncs[alg]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported hash algorithm %q", alg)
+	}
+	return alg, newHash(), nil
+}
@@ -95,14 +95,18 @@ func (iv IndexView) List(ctx context.Context, opts ...*options.ListIndexesOption
 		Session(sess).CommandMonitor(iv.coll.client.monitor).
 		ServerSelector(selector).ClusterClock(iv.coll.client.clock).
 		Database(iv.coll.db.name).Collection(iv.coll.name).
-		Deployment(iv.coll.client.deployment)
+		Deployment(iv.coll.client.deployment).ServerAPI(iv.coll.client.serverAPI).Timeout(iv.coll.timeout)
 
-	var cursorOpts driver.CursorOptions
+	cursorOpts := driver.CursorOptions{Timeout: iv.coll.timeout, ServerAPI: iv.coll.client.serverAPI}
 	lio := options.MergeListIndexesOptions(opts...)
 	if lio.BatchSize != nil {
 		op = op.BatchSize(*lio.BatchSize)
 		cursorOpts.BatchSize = *lio.BatchSize
 	}
+	if lio.Timeout != nil {
+		op = op.Timeout(lio.Timeout)
+		cursorOpts.Timeout = lio.Timeout
+	}
 	if lio.MaxTime != nil {
 		op = op.MaxTimeMS(int64(*lio.MaxTime / time.Millisecond))
 	}
@@ -233,11 +237,15 @@ func (iv IndexView) CreateMany(ctx context.Context, models []IndexModel, opts ..
 	op := operation.NewCreateIndexes(indexes).
 		Session(sess).WriteConcern(wc).ClusterClock(iv.coll.client.clock).
 		Database(iv.coll.db.name).Collection(iv.coll.name).CommandMonitor(iv.coll.client.monitor).
-		Deployment(iv.coll.client.deployment).ServerSelector(selector)
+		Deployment(iv.coll.client.deployment).ServerSelector(selector).ServerAPI(iv.coll.client.serverAPI).
+		Timeout(iv.coll.timeout)
 
 	if option.MaxTime != nil {
 		op.MaxTimeMS(int64(*option.MaxTime / time.Millisecond))
 	}
+	if option.Timeout != nil {
+		op.Timeout(option.Timeout)
+	}
 	if option.CommitQuorum != nil {
 		commitQuorum, err := transformValue(iv.coll.registry, option.CommitQuorum)
 		if err != nil {
@@ -376,10 +384,13 @@ func (iv IndexView) drop(ctx context.Context, name string, opts ...*options.Drop
 		Session(sess).WriteConcern(wc).CommandMonitor(iv.coll.client.monitor).
 		ServerSelector(selector).ClusterClock(iv.coll.client.clock).
 		Database(iv.coll.db.name).Collection(iv.coll.name).
-		Deployment(iv.coll.client.deployment)
+		Deployment(iv.coll.client.deployment).ServerAPI(iv.coll.client.serverAPI).Timeout(iv.coll.timeout)
 	if dio.MaxTime != nil {
 		op.MaxTimeMS(int64(*dio.MaxTime / time.Millisecond))
 	}
+	if dio.Timeout != nil {
+		op.Timeout(dio.Timeout)
+	}
 
 	err = op.Execute(ctx)
 	if err != nil {
@@ -261,6 +261,46 @@ func TestClient(t *testing.T) {
 			_ = client.Disconnect(mtest.Background)
 		})
 	})
+	mt.Run("bulk write", func(mt *mtest.T) {
+		foo := mt.CreateCollection(mtest.Collection{Name: "foo"}, false)
+		bar := mt.CreateCollection(mtest.Collection{Name: "bar"}, false)
+		_, err := bar.InsertOne(mtest.Background, bson.D{{"_id", 1}})
+		assert.Nil(mt, err, "InsertOne error: %v", err)
+
+		models := []*mongo.ClientWriteModel{
+			mongo.NewClientWriteModel().SetNamespace(mt.DB.Name(), "foo").
+				SetModel(mongo.NewInsertOneModel().SetDocument(bson.D{{"x", 1}})),
+			mongo.NewClientWriteModel().SetNamespace(mt.DB.Name(), "bar").
+				SetModel(mongo.NewInsertOneModel().SetDocument(bson.D{{"_id", 1}})),
+			mongo.NewClientWriteModel().SetNamespace(mt.DB.Name(), "bar").
+				SetModel(mongo.NewUpdateOneModel().SetFilter(bson.D{{"_id", 2}}).
+					SetUpdate(bson.D{{"$set", bson.D{{"x", 2}}}}).SetUpsert(true)),
+			mongo.NewClientWriteModel().SetNamespace(mt.DB.Name(), "foo").
+				SetModel(mongo.NewDeleteManyModel().SetFilter(bson.D{{"x", 3}})),
+		}
+
+		res, err := mt.Client.BulkWrite(mtest.Background, models, options.BulkWrite().SetOrdered(false))
+		bwe, ok := err.(mongo.BulkWriteException)
+		assert.True(mt, ok, "expected error of type %T, got %v", mongo.BulkWriteException{}, err)
+		assert.Equal(mt, 1, len(bwe.WriteErrors), "expected 1 write error, got %v", bwe.WriteErrors)
+		assert.Equal(mt, 1, bwe.WriteErrors[0].Index, "expected write error at index 1, got %v",
+			bwe.WriteErrors[0].Index)
+		assert.Equal(mt, models[1].Model, bwe.WriteErrors[0].Request, "expected request %v, got %v", models[1].Model,
+			bwe.WriteErrors[0].Request)
+
+		assert.Equal(mt, int64(1), res.InsertedCount, "expected 1 inserted document, got %v", res.InsertedCount)
+		assert.Equal(mt, int64(1), res.UpsertedCount, "expected 1 upserted document, got %v", res.UpsertedCount)
+		assert.Equal(mt, 1, len(res.InsertedIDs), "expected 1 inserted ID, got %v", res.InsertedIDs)
+		assert.NotNil(mt, res.InsertedIDs[0], "expected inserted ID at index 0")
+		assert.Equal(mt, int32(2), res.UpsertedIDs[2], "expected upserted ID 2 at index 2, got %v", res.UpsertedIDs)
+
+		count, err := foo.CountDocuments(mtest.Background, bson.D{})
+		assert.Nil(mt, err, "CountDocuments error: %v", err)
+		assert.Equal(mt, int64(1), count, "expected 1 document in foo, got %v", count)
+		count, err = bar.CountDocuments(mtest.Background, bson.D{})
+		assert.Nil(mt, err, "CountDocuments error: %v", err)
+		assert.Equal(mt, int64(2), count, "expected 2 documents in bar, got %v", count)
+	})
 	mt.RunOpts("disconnect", noClientOpts, func(mt *mtest.T) {
 		mt.Run("nil context", func(mt *mtest.T) {
 			err := mt.Client.Disconnect(nil)
@@ -0,0 +1,56 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package integration
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestExtJSONImportExport(t *testing.T) {
+	mt := mtest.New(t, noClientOpts)
+	defer mt.Close()
+
+	mt.Run("round trip", func(mt *mtest.T) {
+		var data string
+		for i := 0; i < 25; i++ {
+			data += fmt.Sprintf(`{"_id":%d,"x":"doc %d"}`+"\n", i, i)
+		}
+
+		sr := bsonrw.NewExtJSONStreamReader(strings.NewReader(data), false)
+		n, err := mongo.ImportExtJSON(mtest.Background, mt.Coll, sr, 10)
+		assert.Nil(mt, err, "ImportExtJSON error: %v", err)
+		assert.Equal(mt, int64(25), n, "expected 25 documents to be imported, got %v", n)
+
+		cursor, err := mt.Coll.Find(mtest.Background, bson.D{}, options.Find().SetSort(bson.D{{"_id", 1}}))
+		assert.Nil(mt, err, "Find error: %v", err)
+		var output bytes.Buffer
+		sw, err := bsonrw.NewExtJSONStreamWriter(&output, bsonrw.ExtJSONRelaxed, bsonrw.ExtJSONLines)
+		assert.Nil(mt, err, "NewExtJSONStreamWriter error: %v", err)
+		n, err = mongo.ExportExtJSON(mtest.Background, cursor, sw)
+		assert.Nil(mt, err, "ExportExtJSON error: %v", err)
+		assert.Equal(mt, int64(25), n, "expected 25 documents to be exported, got %v", n)
+		assert.Equal(mt, data, output.String(), "expected exported data %v, got %v", data, output.String())
+	})
+	mt.Run("insert error", func(mt *mtest.T) {
+		data := `[{"_id":1},{"_id":2},{"_id":2}]`
+		sr := bsonrw.NewExtJSONStreamReader(strings.NewReader(data), false)
+		n, err := mongo.ImportExtJSON(mtest.Background, mt.Coll, sr, 2)
+		_, ok := err.(mongo.BulkWriteException)
+		assert.True(mt, ok, "expected error type %T, got %T", mongo.BulkWriteException{}, err)
+		assert.Equal(mt, int64(2), n, "expected 2 documents to be imported, got %v", n)
+	})
+}
@@ -9,6 +9,8 @@ package integration
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"io"
 	"math/rand"
 	"runtime"
 	"testing"
@@ -248,6 +250,343 @@ func TestGridFS(x *testing.T) {
 				})
 			}
 		})
+		mt.RunOpts("seek and read at", noClientOpts, func(mt *mtest.T) {
+			// Tests for the DownloadStream.Seek and DownloadStream.ReadAt methods.
+
+			fileData := make([]byte, 10)
+			for i := range fileData {
+				fileData[i] = byte(i)
+			}
+			bucket, err := gridfs.NewBucket(mt.DB, options.GridFSBucket().SetChunkSizeBytes(4))
+			assert.Nil(mt, err, "NewBucket error: %v", err)
+			defer func() { _ = bucket.Drop() }()
+
+			fileID, err := bucket.UploadFromStream("seek-test-file", bytes.NewReader(fileData))
+			assert.Nil(mt, err, "UploadFromStream error: %v", err)
+
+			mt.Run("Seek", func(mt *mtest.T) {
+				ds, err := bucket.OpenDownloadStream(fileID)
+				assert.Nil(mt, err, "OpenDownloadStream error: %v", err)
+				defer func() { _ = ds.Close() }()
+
+				seekTests := []struct {
+					offset   int64
+					whence   int
+					expected []byte
+				}{
+					{5, io.SeekStart, fileData[5:8]},
+					{-7, io.SeekCurrent, fileData[1:4]},
+					{2, io.SeekCurrent, fileData[6:9]},
+					{-2, io.SeekEnd, fileData[8:]},
+					{0, io.SeekStart, fileData[:3]},
+				}
+				for _, tc := range seekTests {
+					_, err = ds.Seek(tc.offset, tc.whence)
+					assert.Nil(mt, err, "Seek error: %v", err)
+					got := make([]byte, len(tc.expected))
+					_, err = io.ReadFull(ds, got)
+					assert.Nil(mt, err, "ReadFull error: %v", err)
+					assert.Equal(mt, tc.expected, got, "expected data %v, got %v", tc.expected, got)
+				}
+
+				pos, err := ds.Seek(5, io.SeekEnd)
+				assert.Nil(mt, err, "Seek error: %v", err)
+				assert.Equal(mt, int64(15), pos, "expected position 15, got %v", pos)
+				n, err := ds.Read(make([]byte, 1))
+				assert.Equal(mt, io.EOF, err, "expected error %v, got %v", io.EOF, err)
+				assert.Equal(mt, 0, n, "expected 0 bytes read, got %v", n)
+
+				_, err = ds.Seek(-1, io.SeekStart)
+				assert.Equal(mt, gridfs.ErrInvalidSeek, err, "expected error %v, got %v", gridfs.ErrInvalidSeek, err)
+			})
+			mt.Run("ReadAt", func(mt *mtest.T) {
+				ds, err := bucket.OpenDownloadStream(fileID)
+				assert.Nil(mt, err, "OpenDownloadStream error: %v", err)
+				defer func() { _ = ds.Close() }()
+
+				got := make([]byte, 6)
+				n, err := ds.ReadAt(got, 3)
+				assert.Nil(mt, err, "ReadAt error: %v", err)
+				assert.Equal(mt, fileData[3:9], got[:n], "expected data %v, got %v", fileData[3:9], got[:n])
+
+				n, err = ds.ReadAt(got, 7)
+				assert.Equal(mt, io.EOF, err, "expected error %v, got %v", io.EOF, err)
+				assert.Equal(mt, fileData[7:], got[:n], "expected data %v, got %v", fileData[7:], got[:n])
+
+				// ReadAt does not change the offset used by Read.
+				all := make([]byte, len(fileData))
+				_, err = io.ReadFull(ds, all)
+				assert.Nil(mt, err, "ReadFull error: %v", err)
+				assert.Equal(mt, fileData, all, "expected data %v, got %v", fileData, all)
+			})
+		})
+	})
+
+	mt.RunOpts("context variants", noClientOpts, func(mt *mtest.T) {
+		// Tests for the Bucket methods that take a context.Context.
+
+		bucket, err := gridfs.NewBucket(mt.DB, options.GridFSBucket().SetChunkSizeBytes(4))
+		assert.Nil(mt, err, "NewBucket error: %v", err)
+		defer func() { _ = bucket.DropContext(mtest.Background) }()
+
+		mt.Run("round trip", func(mt *mtest.T) {
+			fileData := []byte("context variants test data")
+			fileID, err := bucket.UploadFromStreamContext(mtest.Background, "ctx-file", bytes.NewReader(fileData))
+			assert.Nil(mt, err, "UploadFromStreamContext error: %v", err)
+
+			var buf bytes.Buffer
+			_, err = bucket.DownloadToStreamContext(mtest.Background, fileID, &buf)
+			assert.Nil(mt, err, "DownloadToStreamContext error: %v", err)
+			assert.Equal(mt, fileData, buf.Bytes(), "expected data %v, got %v", fileData, buf.Bytes())
+
+			err = bucket.RenameContext(mtest.Background, fileID, "ctx-file-renamed")
+			assert.Nil(mt, err, "RenameContext error: %v", err)
+			buf.Reset()
+			_, err = bucket.DownloadToStreamByNameContext(mtest.Background, "ctx-file-renamed", &buf)
+			assert.Nil(mt, err, "DownloadToStreamByNameContext error: %v", err)
+			assert.Equal(mt, fileData, buf.Bytes(), "expected data %v, got %v", fileData, buf.Bytes())
+
+			cursor, err := bucket.FindContext(mtest.Background, bson.D{{"_id", fileID}})
+			assert.Nil(mt, err, "FindContext error: %v", err)
+			var files []bson.Raw
+			err = cursor.All(mtest.Background, &files)
+			assert.Nil(mt, err, "All error: %v", err)
+			assert.Equal(mt, 1, len(files), "expected 1 file, got %v", len(files))
+
+			err = bucket.DeleteContext(mtest.Background, fileID)
+			assert.Nil(mt, err, "DeleteContext error: %v", err)
+			err = bucket.DeleteContext(mtest.Background, fileID)
+			assert.Equal(mt, gridfs.ErrFileNotFound, err, "expected error %v, got %v", gridfs.ErrFileNotFound, err)
+		})
+		mt.Run("canceled context", func(mt *mtest.T) {
+			fileID, err := bucket.UploadFromStreamContext(mtest.Background, "canceled", bytes.NewReader([]byte{1, 2, 3}))
+			assert.Nil(mt, err, "UploadFromStreamContext error: %v", err)
+
+			ctx, cancel := context.WithCancel(mtest.Background)
+			ds, err := bucket.OpenDownloadStreamContext(ctx, fileID)
+			assert.Nil(mt, err, "OpenDownloadStreamContext error: %v", err)
+			cancel()
+
+			// The stream uses the context it was opened with for the chunks it reads after being opened.
+			_, err = ds.ReadAt(make([]byte, 1), 0)
+			assert.NotNil(mt, err, "expected ReadAt error, got nil")
+			_, err = bucket.OpenDownloadStreamContext(ctx, fileID)
+			assert.NotNil(mt, err, "expected OpenDownloadStreamContext error, got nil")
+			err = bucket.DeleteContext(ctx, fileID)
+			assert.NotNil(mt, err, "expected DeleteContext error, got nil")
+		})
+	})
+
+	mt.RunOpts("integrity", noClientOpts, func(mt *mtest.T) {
+		// Tests for uploads with a hash algorithm and for Bucket.Verify.
+
+		fileData := []byte("integrity test data")
+		uploadOpts := options.GridFSUpload().SetHashAlgorithm("sha256")
+
+		mt.Run("digest is stored and verified", func(mt *mtest.T) {
+			bucket, err := gridfs.NewBucket(mt.DB, options.GridFSBucket().SetChunkSizeBytes(4))
+			assert.Nil(mt, err, "NewBucket error: %v", err)
+			defer func() { _ = bucket.Drop() }()
+
+			fileID, err := bucket.UploadFromStream("hashed", bytes.NewReader(fileData), uploadOpts)
+			assert.Nil(mt, err, "UploadFromStream error: %v", err)
+
+			ds, err := bucket.OpenDownloadStream(fileID)
+			assert.Nil(mt, err, "OpenDownloadStream error: %v", err)
+			sum := sha256.Sum256(fileData)
+			file := ds.GetFile()
+			assert.Equal(mt, "sha256", file.HashAlgorithm, "expected algorithm sha256, got %v", file.HashAlgorithm)
+			assert.Equal(mt, sum[:], file.Hash, "expected digest %x, got %x", sum, file.Hash)
+			_ = ds.Close()
+
+			var buf bytes.Buffer
+			_, err = bucket.DownloadToStream(fileID, &buf)
+			assert.Nil(mt, err, "DownloadToStream error: %v", err)
+
+			// Corrupt a chunk without changing its size.
+			_, err = bucket.GetChunksCollection().UpdateOne(mtest.Background,
+				bson.D{{"files_id", fileID}, {"n", 1}},
+				bson.D{{"$set", bson.D{{"data", []byte("XXXX")}}}})
+			assert.Nil(mt, err, "UpdateOne error: %v", err)
+
+			_, err = bucket.DownloadToStream(fileID, &buf)
+			_, ok := err.(*gridfs.CorruptionError)
+			assert.True(mt, ok, "expected error type %T, got %v", &gridfs.CorruptionError{}, err)
+
+			problems, err := bucket.Verify()
+			assert.Nil(mt, err, "Verify error: %v", err)
+			assert.Equal(mt, 1, len(problems), "expected 1 problem, got %v", problems)
+		})
+		mt.Run("unsupported algorithm", func(mt *mtest.T) {
+			bucket, err := gridfs.NewBucket(mt.DB)
+			assert.Nil(mt, err, "NewBucket error: %v", err)
+			defer func() { _ = bucket.Drop() }()
+
+			_, err = bucket.OpenUploadStream("file", options.GridFSUpload().SetHashAlgorithm("crc32"))
+			assert.NotNil(mt, err, "expected OpenUploadStream error, got nil")
+		})
+		mt.Run("unsupported stored algorithm", func(mt *mtest.T) {
+			bucket, err := gridfs.NewBucket(mt.DB, options.GridFSBucket().SetChunkSizeBytes(4))
+			assert.Nil(mt, err, "NewBucket error: %v", err)
+			defer func() { _ = bucket.Drop() }()
+
+			fileID, err := bucket.UploadFromStream("hashed", bytes.NewReader(fileData), uploadOpts)
+			assert.Nil(mt, err, "UploadFromStream error: %v", err)
+			_, err = bucket.GetFilesCollection().UpdateOne(mtest.Background,
+				bson.D{{"_id", fileID}},
+				bson.D{{"$set", bson.D{{"hash.algorithm", "crc32"}}}})
+			assert.Nil(mt, err, "UpdateOne error: %v", err)
+
+			var buf bytes.Buffer
+			_, err = bucket.DownloadToStream(fileID, &buf)
+			_, ok := err.(*gridfs.CorruptionError)
+			assert.True(mt, ok, "expected error type %T, got %v", &gridfs.CorruptionError{}, err)
+
+			problems, err := bucket.Verify()
+			assert.Nil(mt, err, "Verify error: %v", err)
+			assert.Equal(mt, 1, len(problems), "expected 1 problem, got %v", problems)
+		})
+		mt.Run("Verify", func(mt *mtest.T) {
+			bucket, err := gridfs.NewBucket(mt.DB, options.GridFSBucket().SetChunkSizeBytes(4))
+			assert.Nil(mt, err, "NewBucket error: %v", err)
+			defer func() { _ = bucket.Drop() }()
+
+			intactID, err := bucket.UploadFromStream("intact", bytes.NewReader(fileData))
+			assert.Nil(mt, err, "UploadFromStream error: %v", err)
+			problems, err := bucket.Verify()
+			assert.Nil(mt, err, "Verify error: %v", err)
+			assert.Equal(mt, 0, len(problems), "expected no problems, got %v", problems)
+
+			missingID, err := bucket.UploadFromStream("missing chunk", bytes.NewReader(fileData))
+			assert.Nil(mt, err, "UploadFromStream error: %v", err)
+			_, err = bucket.GetChunksCollection().DeleteOne(mtest.Background, bson.D{{"files_id", missingID}, {"n", 2}})
+			assert.Nil(mt, err, "DeleteOne error: %v", err)
+
+			orphanID, err := bucket.UploadFromStream("orphan", bytes.NewReader(fileData))
+			assert.Nil(mt, err, "UploadFromStream error: %v", err)
+			_, err = bucket.GetFilesCollection().DeleteOne(mtest.Background, bson.D{{"_id", orphanID}})
+			assert.Nil(mt, err, "DeleteOne error: %v", err)
+
+			problems, err = bucket.Verify()
+			assert.Nil(mt, err, "Verify error: %v", err)
+			assert.Equal(mt, 2, len(problems), "expected 2 problems, got %v", problems)
+			for _, problem := range problems {
+				assert.NotEqual(mt, intactID, problem.FileID, "unexpected problem for intact file: %v", problem)
+			}
+		})
+	})
+
+	mt.RunOpts("concurrent upload and prefetch", noClientOpts, func(mt *mtest.T) {
+		// Tests for the MaxInFlightBatches upload option and the PrefetchChunks bucket option.
+
+		fileData := make([]byte, 2*gridfs.UploadBufferSize+100)
+		_, _ = rand.Read(fileData)
+
+		bucket, err := gridfs.NewBucket(mt.DB, options.GridFSBucket().SetPrefetchChunks(8))
+		assert.Nil(mt, err, "NewBucket error: %v", err)
+		defer func() { _ = bucket.Drop() }()
+
+		uploadOpts := options.GridFSUpload().SetMaxInFlightBatches(3)
+		fileID, err := bucket.UploadFromStream("concurrent", bytes.NewReader(fileData), uploadOpts)
+		assert.Nil(mt, err, "UploadFromStream error: %v", err)
+
+		var buf bytes.Buffer
+		n, err := bucket.DownloadToStream(fileID, &buf)
+		assert.Nil(mt, err, "DownloadToStream error: %v", err)
+		assert.Equal(mt, int64(len(fileData)), n, "expected %v bytes, got %v", len(fileData), n)
+		assert.True(mt, bytes.Equal(fileData, buf.Bytes()), "downloaded data does not match uploaded data")
+
+		// Seeking stops the prefetcher and starts a new one at the new offset.
+		ds, err := bucket.OpenDownloadStream(fileID)
+		assert.Nil(mt, err, "OpenDownloadStream error: %v", err)
+		defer func() { _ = ds.Close() }()
+
+		got := make([]byte, 10)
+		_, err = io.ReadFull(ds, got)
+		assert.Nil(mt, err, "ReadFull error: %v", err)
+		offset := int64(gridfs.UploadBufferSize + 5)
+		_, err = ds.Seek(offset, io.SeekStart)
+		assert.Nil(mt, err, "Seek error: %v", err)
+		_, err = io.ReadFull(ds, got)
+		assert.Nil(mt, err, "ReadFull error: %v", err)
+		expected := fileData[offset : offset+10]
+		assert.Equal(mt, expected, got, "expected data %v, got %v", expected, got)
+
+		// Changing the read deadline restarts the prefetcher, so an expired deadline makes the next Read fail and a
+		// later deadline lets the stream continue from the same offset.
+		err = ds.SetReadDeadline(time.Now().Add(-time.Second))
+		assert.Nil(mt, err, "SetReadDeadline error: %v", err)
+		_, err = io.ReadFull(ds, got)
+		assert.NotNil(mt, err, "expected ReadFull error with an expired deadline, got nil")
+		err = ds.SetReadDeadline(time.Now().Add(time.Minute))
+		assert.Nil(mt, err, "SetReadDeadline error: %v", err)
+		_, err = io.ReadFull(ds, got)
+		assert.Nil(mt, err, "ReadFull error: %v", err)
+		expected = fileData[offset+10 : offset+20]
+		assert.Equal(mt, expected, got, "expected data %v, got %v", expected, got)
+	})
+
+	mt.RunOpts("revisions", noClientOpts, func(mt *mtest.T) {
+		// Tests for UpdateMetadata, ListRevisions, and DeleteRevisions.
+
+		bucket, err := gridfs.NewBucket(mt.DB, options.GridFSBucket().SetChunkSizeBytes(4))
+		assert.Nil(mt, err, "NewBucket error: %v", err)
+		defer func() { _ = bucket.Drop() }()
+
+		fileName := "revisions-test-file"
+		var ids []interface{}
+		for i := 0; i < 3; i++ {
+			id, err := bucket.UploadFromStream(fileName, bytes.NewReader([]byte{byte(i), 1, 2, 3, 4}))
+			assert.Nil(mt, err, "UploadFromStream error: %v", err)
+			ids = append(ids, id)
+			// Revisions are ordered by uploadDate, which has millisecond precision.
+			time.Sleep(5 * time.Millisecond)
+		}
+
+		mt.Run("UpdateMetadata", func(mt *mtest.T) {
+			err := bucket.UpdateMetadata(ids[0], bson.D{{"k", "v"}})
+			assert.Nil(mt, err, "UpdateMetadata error: %v", err)
+			revisions, err := bucket.ListRevisions(fileName)
+			assert.Nil(mt, err, "ListRevisions error: %v", err)
+			expected, _ := bson.Marshal(bson.D{{"k", "v"}})
+			assert.Equal(mt, bson.Raw(expected), revisions[0].Metadata,
+				"expected metadata %v, got %v", bson.Raw(expected), revisions[0].Metadata)
+
+			err = bucket.UpdateMetadata(ids[0], nil)
+			assert.Nil(mt, err, "UpdateMetadata error: %v", err)
+			revisions, err = bucket.ListRevisions(fileName)
+			assert.Nil(mt, err, "ListRevisions error: %v", err)
+			assert.Nil(mt, revisions[0].Metadata, "expected no metadata, got %v", revisions[0].Metadata)
+
+			err = bucket.UpdateMetadata("missing", bson.D{})
+			assert.Equal(mt, gridfs.ErrFileNotFound, err, "expected error %v, got %v", gridfs.ErrFileNotFound, err)
+		})
+		mt.Run("ListRevisions", func(mt *mtest.T) {
+			revisions, err := bucket.ListRevisions(fileName)
+			assert.Nil(mt, err, "ListRevisions error: %v", err)
+			assert.Equal(mt, len(ids), len(revisions), "expected %v revisions, got %v", len(ids), len(revisions))
+			for i, revision := range revisions {
+				assert.Equal(mt, ids[i], revision.ID, "expected revision %v to have ID %v, got %v", i, ids[i], revision.ID)
+			}
+
+			revisions, err = bucket.ListRevisions("missing")
+			assert.Nil(mt, err, "ListRevisions error: %v", err)
+			assert.Equal(mt, 0, len(revisions), "expected no revisions, got %v", revisions)
+		})
+		mt.Run("DeleteRevisions", func(mt *mtest.T) {
+			_, err := bucket.DeleteRevisions(fileName, -1)
+			assert.Equal(mt, gridfs.ErrNegativeKeep, err, "expected error %v, got %v", gridfs.ErrNegativeKeep, err)
+
+			deleted, err := bucket.DeleteRevisions(fileName, 1)
+			assert.Nil(mt, err, "DeleteRevisions error: %v", err)
+			assert.Equal(mt, int64(2), deleted, "expected 2 revisions deleted, got %v", deleted)
+
+			revisions, err := bucket.ListRevisions(fileName)
+			assert.Nil(mt, err, "ListRevisions error: %v", err)
+			assert.Equal(mt, 1, len(revisions), "expected 1 revision, got %v", len(revisions))
+			assert.Equal(mt, ids[2], revisions[0].ID, "expected latest revision %v, got %v", ids[2], revisions[0].ID)
+			assertGridFSCollectionState(mt, bucket.GetChunksCollection(), "fs.chunks", 2)
+		})
 	})
 
 	mt.RunOpts("bucket collection accessors", noClientOpts, func(mt *mtest.T) {
@@ -287,6 +287,44 @@ func TestSessions(t *testing.T) {
 		}
 	})
 
+	snapshotOpts := mtest.NewOptions().MinServerVersion("5.0").Topologies(mtest.ReplicaSet)
+	mt.RunOpts("snapshot reads", snapshotOpts, func(mt *mtest.T) {
+		_, err := mt.Coll.InsertOne(mtest.Background, bson.D{{"x", 1}})
+		assert.Nil(mt, err, "InsertOne error: %v", err)
+
+		sess, err := mt.Client.StartSession(options.Session().SetSnapshot(true))
+		assert.Nil(mt, err, "StartSession error: %v", err)
+		defer sess.EndSession(mtest.Background)
+
+		err = mongo.WithSession(mtest.Background, sess, func(sc mongo.SessionContext) error {
+			mt.ClearEvents()
+			cursor, err := mt.Coll.Find(sc, bson.D{})
+			if err != nil {
+				return err
+			}
+			_ = cursor.Close(sc)
+			rc := mt.GetStartedEvent().Command.Lookup("readConcern").Document()
+			assert.Equal(mt, "snapshot", rc.Lookup("level").StringValue(), "expected snapshot read concern, got %v", rc)
+			_, err = rc.LookupErr("atClusterTime")
+			assert.NotNil(mt, err, "expected no atClusterTime in the first read, got %v", rc)
+			cursorTime := mt.GetSucceededEvent().Reply.Lookup("cursor", "atClusterTime")
+
+			// Later reads use the time of the snapshot from the first read.
+			if _, err := mt.Coll.Distinct(sc, "x", bson.D{}); err != nil {
+				return err
+			}
+			rc = mt.GetStartedEvent().Command.Lookup("readConcern").Document()
+			atClusterTime, err := rc.LookupErr("atClusterTime")
+			assert.Nil(mt, err, "expected atClusterTime in read concern %v", rc)
+			assert.True(mt, atClusterTime.Equal(cursorTime), "expected atClusterTime %v, got %v", cursorTime, atClusterTime)
+			return nil
+		})
+		assert.Nil(mt, err, "WithSession error: %v", err)
+
+		err = sess.StartTransaction()
+		assert.NotNil(mt, err, "expected StartTransaction error, got nil")
+	})
+
 	mt.Run("imperative API", func(mt *mtest.T) {
 		mt.Run("round trip Session object", func(mt *mtest.T) {
 			// Rountrip a Session object through NewSessionContext/ContextFromSession and assert that it is correctly
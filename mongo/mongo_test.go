@@ -136,7 +136,7 @@ func TestMongoHelpers(t *testing.T) {
 			{
 				"Pipeline/error",
 				Pipeline{{{"hello", func() {}}}}, bsonx.Arr{},
-				MarshalError{Value: primitive.D{}, Err: errors.New("no encoder found for func()")},
+				MarshalError{Value: primitive.D{}, Err: errors.New("error encoding key hello (Go func()): no encoder found for func()")},
 			},
 			{
 				"Pipeline/success",
@@ -44,6 +44,11 @@ type AggregateOptions struct {
 	// as a document. The hint does not apply to $lookup and $graphLookup aggregation stages. The default value is nil,
 	// which means that no hint will be sent.
 	Hint interface{}
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Database or Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // Aggregate creates a new AggregateOptions instance.
@@ -99,6 +104,12 @@ func (ao *AggregateOptions) SetHint(h interface{}) *AggregateOptions {
 	return ao
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (ao *AggregateOptions) SetTimeout(d time.Duration) *AggregateOptions {
+	ao.Timeout = &d
+	return ao
+}
+
 // MergeAggregateOptions combines the given AggregateOptions instances into a single AggregateOptions in a last-one-wins
 // fashion.
 func MergeAggregateOptions(opts ...*AggregateOptions) *AggregateOptions {
@@ -131,6 +142,9 @@ func MergeAggregateOptions(opts ...*AggregateOptions) *AggregateOptions {
 		if ao.Hint != nil {
 			aggOpts.Hint = ao.Hint
 		}
+		if ao.Timeout != nil {
+			aggOpts.Timeout = ao.Timeout
+		}
 	}
 
 	return aggOpts
@@ -6,6 +6,8 @@
 
 package options
 
+import "time"
+
 // DefaultOrdered is the default value for the Ordered option in BulkWriteOptions.
 var DefaultOrdered = true
 
@@ -19,6 +21,11 @@ type BulkWriteOptions struct {
 
 	// If true, no writes will be executed after one fails. The default value is true.
 	Ordered *bool
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // BulkWrite creates a new *BulkWriteOptions instance.
@@ -40,6 +47,12 @@ func (b *BulkWriteOptions) SetBypassDocumentValidation(bypass bool) *BulkWriteOp
 	return b
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (b *BulkWriteOptions) SetTimeout(d time.Duration) *BulkWriteOptions {
+	b.Timeout = &d
+	return b
+}
+
 // MergeBulkWriteOptions combines the given BulkWriteOptions instances into a single BulkWriteOptions in a last-one-wins
 // fashion.
 func MergeBulkWriteOptions(opts ...*BulkWriteOptions) *BulkWriteOptions {
@@ -54,6 +67,9 @@ func MergeBulkWriteOptions(opts ...*BulkWriteOptions) *BulkWriteOptions {
 		if opt.BypassDocumentValidation != nil {
 			b.BypassDocumentValidation = opt.BypassDocumentValidation
 		}
+		if opt.Timeout != nil {
+			b.Timeout = opt.Timeout
+		}
 	}
 
 	return b
@@ -46,6 +46,11 @@ type ChangeStreamOptions struct {
 	// corresponding to an oplog entry immediately after the specified token will be returned. If this is specified,
 	// ResumeAfter and StartAtOperationTime must not be set. This option is only valid for MongoDB versions >= 4.1.1.
 	StartAfter interface{}
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Client, Database, or Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // ChangeStream creates a new ChangeStreamOptions instance.
@@ -97,6 +102,12 @@ func (cso *ChangeStreamOptions) SetStartAfter(sa interface{}) *ChangeStreamOptio
 	return cso
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (cso *ChangeStreamOptions) SetTimeout(d time.Duration) *ChangeStreamOptions {
+	cso.Timeout = &d
+	return cso
+}
+
 // MergeChangeStreamOptions combines the given ChangeStreamOptions instances into a single ChangeStreamOptions in a
 // last-one-wins fashion.
 func MergeChangeStreamOptions(opts ...*ChangeStreamOptions) *ChangeStreamOptions {
@@ -126,6 +137,9 @@ func MergeChangeStreamOptions(opts ...*ChangeStreamOptions) *ChangeStreamOptions
 		if cso.StartAfter != nil {
 			csOpts.StartAfter = cso.StartAfter
 		}
+		if cso.Timeout != nil {
+			csOpts.Timeout = cso.Timeout
+		}
 	}
 
 	return csOpts
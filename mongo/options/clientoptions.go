@@ -102,20 +102,25 @@ type ClientOptions struct {
 	DisableOCSPEndpointCheck *bool
 	HeartbeatInterval        *time.Duration
 	Hosts                    []string
+	LoadBalanced             *bool
 	LocalThreshold           *time.Duration
+	LoggerOptions            *LoggerOptions
 	MaxConnIdleTime          *time.Duration
 	MaxPoolSize              *uint64
 	MinPoolSize              *uint64
 	PoolMonitor              *event.PoolMonitor
 	Monitor                  *event.CommandMonitor
+	ServerMonitor            *event.ServerMonitor
 	ReadConcern              *readconcern.ReadConcern
 	ReadPreference           *readpref.ReadPref
 	Registry                 *bsoncodec.Registry
 	ReplicaSet               *string
 	RetryReads               *bool
 	RetryWrites              *bool
+	ServerAPIOptions         *ServerAPIOptions
 	ServerSelectionTimeout   *time.Duration
 	SocketTimeout            *time.Duration
+	Timeout                  *time.Duration
 	TLSConfig                *tls.Config
 	WriteConcern             *writeconcern.WriteConcern
 	ZlibLevel                *int
@@ -158,6 +163,30 @@ func (c *ClientOptions) validateAndSetError() {
 			return
 		}
 	}
+
+	// Load balanced mode requires a single host and is incompatible with replica set names and direct connections.
+	if c.LoadBalanced != nil && *c.LoadBalanced {
+		if len(c.Hosts) > 1 {
+			c.err = errors.New("loadBalanced cannot be set to true if multiple hosts are specified")
+			return
+		}
+		if c.ReplicaSet != nil {
+			c.err = errors.New("loadBalanced cannot be set to true if a replica set name is specified")
+			return
+		}
+		if c.Direct != nil && *c.Direct {
+			c.err = errors.New("loadBalanced cannot be set to true if the direct connection option is specified")
+			return
+		}
+	}
+
+	// The declared API version must be one that the driver supports.
+	if c.ServerAPIOptions != nil {
+		if err := c.ServerAPIOptions.ServerAPIVersion.Validate(); err != nil {
+			c.err = err
+			return
+		}
+	}
 }
 
 // GetURI returns the original URI used to configure the ClientOptions instance. If ApplyURI was not called during
@@ -240,6 +269,10 @@ func (c *ClientOptions) ApplyURI(uri string) *ClientOptions {
 
 	c.Hosts = cs.Hosts
 
+	if cs.LoadBalancedSet {
+		c.LoadBalanced = &cs.LoadBalanced
+	}
+
 	if cs.LocalThresholdSet {
 		c.LocalThreshold = &cs.LocalThreshold
 	}
@@ -304,6 +337,10 @@ func (c *ClientOptions) ApplyURI(uri string) *ClientOptions {
 		c.SocketTimeout = &cs.SocketTimeout
 	}
 
+	if cs.TimeoutSet {
+		c.Timeout = &cs.Timeout
+	}
+
 	if cs.SSL {
 		tlsConfig := new(tls.Config)
 
@@ -471,6 +508,16 @@ func (c *ClientOptions) SetHosts(s []string) *ClientOptions {
 	return c
 }
 
+// SetLoadBalanced specifies whether or not the application is connecting to one or more mongos instances through a
+// load balancer. If true, the driver does not monitor the deployment, and cursors and transactions are pinned to the
+// connection that started them. This can also be set through the "loadBalanced" URI option (e.g. "loadBalanced=true").
+// This option cannot be set to true if multiple hosts are specified, a replica set name is specified, or a direct
+// connection is requested. The default value is false.
+func (c *ClientOptions) SetLoadBalanced(lb bool) *ClientOptions {
+	c.LoadBalanced = &lb
+	return c
+}
+
 // SetLocalThreshold specifies the width of the 'latency window': when choosing between multiple suitable servers for an
 // operation, this is the acceptable non-negative delta between shortest and longest average round-trip times. A server
 // within the latency window is selected randomly. This can also be set through the "localThresholdMS" URI option (e.g.
@@ -480,6 +527,14 @@ func (c *ClientOptions) SetLocalThreshold(d time.Duration) *ClientOptions {
 	return c
 }
 
+// SetLoggerOptions specifies options for structured driver logging. See the LoggerOptions documentation for the
+// components that can be logged. Logging can also be enabled through the MONGODB_LOG_* environment variables. The
+// default is nil, meaning that only the environment is used.
+func (c *ClientOptions) SetLoggerOptions(lo *LoggerOptions) *ClientOptions {
+	c.LoggerOptions = lo
+	return c
+}
+
 // SetMaxConnIdleTime specifies the maximum amount of time that a connection will remain idle in a connection pool
 // before it is removed from the pool and closed. This can also be set through the "maxIdleTimeMS" URI option (e.g.
 // "maxIdleTimeMS=10000"). The default is 0, meaning a connection can remain unused indefinitely.
@@ -511,6 +566,14 @@ func (c *ClientOptions) SetPoolMonitor(m *event.PoolMonitor) *ClientOptions {
 	return c
 }
 
+// SetServerMonitor specifies an SDAM monitor to receive server and topology events, including server heartbeats. See
+// the event.ServerMonitor documentation for more information about the structure of the monitor and events that can
+// be received.
+func (c *ClientOptions) SetServerMonitor(m *event.ServerMonitor) *ClientOptions {
+	c.ServerMonitor = m
+	return c
+}
+
 // SetMonitor specifies a CommandMonitor to receive command events. See the event.CommandMonitor documentation for more
 // information about the structure of the monitor and events that can be received.
 func (c *ClientOptions) SetMonitor(m *event.CommandMonitor) *ClientOptions {
@@ -593,6 +656,14 @@ func (c *ClientOptions) SetRetryReads(b bool) *ClientOptions {
 	return c
 }
 
+// SetServerAPIOptions specifies a Stable API version to declare on every command sent by the Client, including the
+// connection handshake and heartbeats. Pinning an API version ensures that server upgrades will not change the
+// behavior of the commands the application uses. The default is nil, meaning no API version is declared.
+func (c *ClientOptions) SetServerAPIOptions(opts *ServerAPIOptions) *ClientOptions {
+	c.ServerAPIOptions = opts
+	return c
+}
+
 // SetServerSelectionTimeout specifies how long the driver will wait to find an available, suitable server to execute an
 // operation. This can also be set through the "serverSelectionTimeoutMS" URI option (e.g.
 // "serverSelectionTimeoutMS=30000"). The default value is 30 seconds.
@@ -609,6 +680,20 @@ func (c *ClientOptions) SetSocketTimeout(d time.Duration) *ClientOptions {
 	return c
 }
 
+// SetTimeout specifies a client-side timeout for every operation executed by the Client. The timeout bounds the entire
+// operation: server selection, connection checkout, retries, and every round trip to the server. A maxTimeMS value
+// derived from the time remaining is sent with each command so the server can stop work the client will no longer wait
+// for. Cursors, change streams, and GridFS streams created through the Client apply the same timeout to each of their
+// subsequent round trips. If the Context passed to an operation has a deadline, that deadline is used instead.
+//
+// The timeout can be overridden for a Database, Collection, or single operation through their respective options. This
+// can also be set through the "timeoutMS" URI option (e.g. "timeoutMS=5000"). The default is nil, meaning operations are
+// only bounded by the Context passed to them and the ServerSelectionTimeout and SocketTimeout options.
+func (c *ClientOptions) SetTimeout(d time.Duration) *ClientOptions {
+	c.Timeout = &d
+	return c
+}
+
 // SetTLSConfig specifies a tls.Config instance to use use to configure TLS on all connections created to the cluster.
 // This can also be set through the following URI options:
 //
@@ -732,9 +817,15 @@ func MergeClientOptions(opts ...*ClientOptions) *ClientOptions {
 		if len(opt.Hosts) > 0 {
 			c.Hosts = opt.Hosts
 		}
+		if opt.LoadBalanced != nil {
+			c.LoadBalanced = opt.LoadBalanced
+		}
 		if opt.LocalThreshold != nil {
 			c.LocalThreshold = opt.LocalThreshold
 		}
+		if opt.LoggerOptions != nil {
+			c.LoggerOptions = opt.LoggerOptions
+		}
 		if opt.MaxConnIdleTime != nil {
 			c.MaxConnIdleTime = opt.MaxConnIdleTime
 		}
@@ -750,6 +841,9 @@ func MergeClientOptions(opts ...*ClientOptions) *ClientOptions {
 		if opt.Monitor != nil {
 			c.Monitor = opt.Monitor
 		}
+		if opt.ServerMonitor != nil {
+			c.ServerMonitor = opt.ServerMonitor
+		}
 		if opt.ReadConcern != nil {
 			c.ReadConcern = opt.ReadConcern
 		}
@@ -768,6 +862,9 @@ func MergeClientOptions(opts ...*ClientOptions) *ClientOptions {
 		if opt.RetryReads != nil {
 			c.RetryReads = opt.RetryReads
 		}
+		if opt.ServerAPIOptions != nil {
+			c.ServerAPIOptions = opt.ServerAPIOptions
+		}
 		if opt.ServerSelectionTimeout != nil {
 			c.ServerSelectionTimeout = opt.ServerSelectionTimeout
 		}
@@ -777,6 +874,9 @@ func MergeClientOptions(opts ...*ClientOptions) *ClientOptions {
 		if opt.SocketTimeout != nil {
 			c.SocketTimeout = opt.SocketTimeout
 		}
+		if opt.Timeout != nil {
+			c.Timeout = opt.Timeout
+		}
 		if opt.TLSConfig != nil {
 			c.TLSConfig = opt.TLSConfig
 		}
@@ -66,19 +66,23 @@ func TestClientOptions(t *testing.T) {
 			{"HeartbeatInterval", (*ClientOptions).SetHeartbeatInterval, 5 * time.Second, "HeartbeatInterval", true},
 			{"Hosts", (*ClientOptions).SetHosts, []string{"localhost:27017", "localhost:27018", "localhost:27019"}, "Hosts", true},
 			{"LocalThreshold", (*ClientOptions).SetLocalThreshold, 5 * time.Second, "LocalThreshold", true},
+			{"LoggerOptions", (*ClientOptions).SetLoggerOptions, Logger().SetComponentLevel(LogComponentCommand, LogLevelDebug), "LoggerOptions", false},
 			{"MaxConnIdleTime", (*ClientOptions).SetMaxConnIdleTime, 5 * time.Second, "MaxConnIdleTime", true},
 			{"MaxPoolSize", (*ClientOptions).SetMaxPoolSize, uint64(250), "MaxPoolSize", true},
 			{"MinPoolSize", (*ClientOptions).SetMinPoolSize, uint64(10), "MinPoolSize", true},
 			{"PoolMonitor", (*ClientOptions).SetPoolMonitor, &event.PoolMonitor{}, "PoolMonitor", false},
 			{"Monitor", (*ClientOptions).SetMonitor, &event.CommandMonitor{}, "Monitor", false},
+			{"ServerMonitor", (*ClientOptions).SetServerMonitor, &event.ServerMonitor{}, "ServerMonitor", false},
 			{"ReadConcern", (*ClientOptions).SetReadConcern, readconcern.Majority(), "ReadConcern", false},
 			{"ReadPreference", (*ClientOptions).SetReadPreference, readpref.SecondaryPreferred(), "ReadPreference", false},
 			{"Registry", (*ClientOptions).SetRegistry, bson.NewRegistryBuilder().Build(), "Registry", false},
 			{"ReplicaSet", (*ClientOptions).SetReplicaSet, "example-replicaset", "ReplicaSet", true},
 			{"RetryWrites", (*ClientOptions).SetRetryWrites, true, "RetryWrites", true},
+			{"ServerAPIOptions", (*ClientOptions).SetServerAPIOptions, ServerAPI(ServerAPIVersion1).SetStrict(true), "ServerAPIOptions", false},
 			{"ServerSelectionTimeout", (*ClientOptions).SetServerSelectionTimeout, 5 * time.Second, "ServerSelectionTimeout", true},
 			{"Direct", (*ClientOptions).SetDirect, true, "Direct", true},
 			{"SocketTimeout", (*ClientOptions).SetSocketTimeout, 5 * time.Second, "SocketTimeout", true},
+			{"Timeout", (*ClientOptions).SetTimeout, 5 * time.Second, "Timeout", true},
 			{"TLSConfig", (*ClientOptions).SetTLSConfig, &tls.Config{}, "TLSConfig", false},
 			{"WriteConcern", (*ClientOptions).SetWriteConcern, writeconcern.New(writeconcern.WMajority()), "WriteConcern", false},
 			{"ZlibLevel", (*ClientOptions).SetZlibLevel, 6, "ZlibLevel", true},
@@ -367,6 +371,11 @@ func TestClientOptions(t *testing.T) {
 				"mongodb://localhost/?socketTimeoutMS=15000",
 				baseClient().SetSocketTimeout(15 * time.Second),
 			},
+			{
+				"Timeout",
+				"mongodb://localhost/?timeoutMS=15000",
+				baseClient().SetTimeout(15 * time.Second),
+			},
 			{
 				"TLS CACertificate",
 				"mongodb://localhost/?ssl=true&sslCertificateAuthorityFile=testdata/ca.pem",
@@ -551,6 +560,13 @@ func TestClientOptions(t *testing.T) {
 			assert.Equal(t, expectedErr.Error(), err.Error(), "expected error %v, got %v", expectedErr, err)
 		})
 	})
+	t.Run("server API version validation", func(t *testing.T) {
+		err := Client().SetServerAPIOptions(ServerAPI(ServerAPIVersion1)).Validate()
+		assert.Nil(t, err, "Validate error: %v", err)
+
+		err = Client().SetServerAPIOptions(ServerAPI("bad")).Validate()
+		assert.NotNil(t, err, "expected error, got nil")
+	})
 }
 
 func createCertPool(t *testing.T, paths ...string) *x509.CertPool {
@@ -7,6 +7,8 @@
 package options
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/bson/bsoncodec"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -30,6 +32,10 @@ type CollectionOptions struct {
 	// The BSON registry to marshal and unmarshal documents for operations executed on the Collection. The default value
 	// is nil, which means that the registry of the database used to configure the Collection will be used.
 	Registry *bsoncodec.Registry
+
+	// The client-side timeout for operations executed on the Collection. The default value is nil, which means that the
+	// timeout of the database used to configure the Collection will be used.
+	Timeout *time.Duration
 }
 
 // Collection creates a new CollectionOptions instance.
@@ -61,6 +67,12 @@ func (c *CollectionOptions) SetRegistry(r *bsoncodec.Registry) *CollectionOption
 	return c
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (c *CollectionOptions) SetTimeout(d time.Duration) *CollectionOptions {
+	c.Timeout = &d
+	return c
+}
+
 // MergeCollectionOptions combines the given CollectionOptions instances into a single *CollectionOptions in a
 // last-one-wins fashion.
 func MergeCollectionOptions(opts ...*CollectionOptions) *CollectionOptions {
@@ -82,6 +94,9 @@ func MergeCollectionOptions(opts ...*CollectionOptions) *CollectionOptions {
 		if opt.Registry != nil {
 			c.Registry = opt.Registry
 		}
+		if opt.Timeout != nil {
+			c.Timeout = opt.Timeout
+		}
 	}
 
 	return c
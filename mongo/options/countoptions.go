@@ -29,6 +29,11 @@ type CountOptions struct {
 
 	// The number of documents to skip before counting. The default value is 0.
 	Skip *int64
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // Count creates a new CountOptions instance.
@@ -66,6 +71,12 @@ func (co *CountOptions) SetSkip(i int64) *CountOptions {
 	return co
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (co *CountOptions) SetTimeout(d time.Duration) *CountOptions {
+	co.Timeout = &d
+	return co
+}
+
 // MergeCountOptions combines the given CountOptions instances into a single CountOptions in a last-one-wins fashion.
 func MergeCountOptions(opts ...*CountOptions) *CountOptions {
 	countOpts := Count()
@@ -88,6 +99,9 @@ func MergeCountOptions(opts ...*CountOptions) *CountOptions {
 		if co.Skip != nil {
 			countOpts.Skip = co.Skip
 		}
+		if co.Timeout != nil {
+			countOpts.Timeout = co.Timeout
+		}
 	}
 
 	return countOpts
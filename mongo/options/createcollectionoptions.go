@@ -6,6 +6,8 @@
 
 package options
 
+import "time"
+
 // DefaultIndexOptions represents the default options for a collection to apply on new indexes. This type can be used
 // when creating a new collection through the CreateCollectionOptions.SetDefaultIndexOptions method.
 type DefaultIndexOptions struct {
@@ -70,6 +72,11 @@ type CreateCollectionOptions struct {
 	// is only valid for MongoDB versions >= 3.2. The default value is nil, meaning no validator will be used for the
 	// collection.
 	Validator interface{}
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Database used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // CreateCollection creates a new CreateCollectionOptions instance.
@@ -131,6 +138,12 @@ func (c *CreateCollectionOptions) SetValidator(validator interface{}) *CreateCol
 	return c
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (c *CreateCollectionOptions) SetTimeout(d time.Duration) *CreateCollectionOptions {
+	c.Timeout = &d
+	return c
+}
+
 // MergeCreateCollectionOptions combines the given CreateCollectionOptions instances into a single
 // CreateCollectionOptions in a last-one-wins fashion.
 func MergeCreateCollectionOptions(opts ...*CreateCollectionOptions) *CreateCollectionOptions {
@@ -168,6 +181,9 @@ func MergeCreateCollectionOptions(opts ...*CreateCollectionOptions) *CreateColle
 		if opt.Validator != nil {
 			cc.Validator = opt.Validator
 		}
+		if opt.Timeout != nil {
+			cc.Timeout = opt.Timeout
+		}
 	}
 
 	return cc
@@ -6,6 +6,8 @@
 
 package options
 
+import "time"
+
 // DeleteOptions represents options that can be used to configure DeleteOne and DeleteMany operations.
 type DeleteOptions struct {
 	// Specifies a collation to use for string comparisons during the operation. This option is only valid for MongoDB
@@ -19,6 +21,11 @@ type DeleteOptions struct {
 	// is specified. The driver will return an error if this option is specified during an unacknowledged write
 	// operation. The default value is nil, which means that no hint will be sent.
 	Hint interface{}
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // Delete creates a new DeleteOptions instance.
@@ -38,6 +45,12 @@ func (do *DeleteOptions) SetHint(hint interface{}) *DeleteOptions {
 	return do
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (do *DeleteOptions) SetTimeout(d time.Duration) *DeleteOptions {
+	do.Timeout = &d
+	return do
+}
+
 // MergeDeleteOptions combines the given DeleteOptions instances into a single DeleteOptions in a last-one-wins fashion.
 func MergeDeleteOptions(opts ...*DeleteOptions) *DeleteOptions {
 	dOpts := Delete()
@@ -51,6 +64,9 @@ func MergeDeleteOptions(opts ...*DeleteOptions) *DeleteOptions {
 		if do.Hint != nil {
 			dOpts.Hint = do.Hint
 		}
+		if do.Timeout != nil {
+			dOpts.Timeout = do.Timeout
+		}
 	}
 
 	return dOpts
@@ -18,6 +18,11 @@ type DistinctOptions struct {
 	// The maximum amount of time that the query can run on the server. The default value is nil, meaning that there
 	// is no time limit for query execution.
 	MaxTime *time.Duration
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // Distinct creates a new DistinctOptions instance.
@@ -37,6 +42,12 @@ func (do *DistinctOptions) SetMaxTime(d time.Duration) *DistinctOptions {
 	return do
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (do *DistinctOptions) SetTimeout(d time.Duration) *DistinctOptions {
+	do.Timeout = &d
+	return do
+}
+
 // MergeDistinctOptions combines the given DistinctOptions instances into a single DistinctOptions in a last-one-wins
 // fashion.
 func MergeDistinctOptions(opts ...*DistinctOptions) *DistinctOptions {
@@ -51,6 +62,9 @@ func MergeDistinctOptions(opts ...*DistinctOptions) *DistinctOptions {
 		if do.MaxTime != nil {
 			distinctOpts.MaxTime = do.MaxTime
 		}
+		if do.Timeout != nil {
+			distinctOpts.Timeout = do.Timeout
+		}
 	}
 
 	return distinctOpts
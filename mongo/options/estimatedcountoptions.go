@@ -13,6 +13,11 @@ type EstimatedDocumentCountOptions struct {
 	// The maximum amount of time that the query can run on the server. The default value is nil, meaning that there
 	// is no time limit for query execution.
 	MaxTime *time.Duration
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // EstimatedDocumentCount creates a new EstimatedDocumentCountOptions instance.
@@ -26,6 +31,12 @@ func (eco *EstimatedDocumentCountOptions) SetMaxTime(d time.Duration) *Estimated
 	return eco
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (eco *EstimatedDocumentCountOptions) SetTimeout(d time.Duration) *EstimatedDocumentCountOptions {
+	eco.Timeout = &d
+	return eco
+}
+
 // MergeEstimatedDocumentCountOptions combines the given EstimatedDocumentCountOptions instances into a single
 // EstimatedDocumentCountOptions in a last-one-wins fashion.
 func MergeEstimatedDocumentCountOptions(opts ...*EstimatedDocumentCountOptions) *EstimatedDocumentCountOptions {
@@ -38,6 +49,9 @@ func MergeEstimatedDocumentCountOptions(opts ...*EstimatedDocumentCountOptions)
 		if opt.MaxTime != nil {
 			e.MaxTime = opt.MaxTime
 		}
+		if opt.Timeout != nil {
+			e.Timeout = opt.Timeout
+		}
 	}
 
 	return e
@@ -93,6 +93,11 @@ type FindOptions struct {
 
 	// A document specifying the order in which documents should be returned.
 	Sort interface{}
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // Find creates a new FindOptions instance.
@@ -220,6 +225,12 @@ func (f *FindOptions) SetSort(sort interface{}) *FindOptions {
 	return f
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (f *FindOptions) SetTimeout(d time.Duration) *FindOptions {
+	f.Timeout = &d
+	return f
+}
+
 // MergeFindOptions combines the given FindOptions instances into a single FindOptions in a last-one-wins fashion.
 func MergeFindOptions(opts ...*FindOptions) *FindOptions {
 	fo := Find()
@@ -287,6 +298,9 @@ func MergeFindOptions(opts ...*FindOptions) *FindOptions {
 		if opt.Sort != nil {
 			fo.Sort = opt.Sort
 		}
+		if opt.Timeout != nil {
+			fo.Timeout = opt.Timeout
+		}
 	}
 
 	return fo
@@ -364,6 +378,11 @@ type FindOneOptions struct {
 	// A document specifying the sort order to apply to the query. The first document in the sorted order will be
 	// returned.
 	Sort interface{}
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // FindOne creates a new FindOneOptions instance.
@@ -479,6 +498,12 @@ func (f *FindOneOptions) SetSort(sort interface{}) *FindOneOptions {
 	return f
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (f *FindOneOptions) SetTimeout(d time.Duration) *FindOneOptions {
+	f.Timeout = &d
+	return f
+}
+
 // MergeFindOneOptions combines the given FindOneOptions instances into a single FindOneOptions in a last-one-wins
 // fashion.
 func MergeFindOneOptions(opts ...*FindOneOptions) *FindOneOptions {
@@ -541,6 +566,9 @@ func MergeFindOneOptions(opts ...*FindOneOptions) *FindOneOptions {
 		if opt.Sort != nil {
 			fo.Sort = opt.Sort
 		}
+		if opt.Timeout != nil {
+			fo.Timeout = opt.Timeout
+		}
 	}
 
 	return fo
@@ -586,6 +614,11 @@ type FindOneAndReplaceOptions struct {
 	// The driver will return an error if this option is used with during an unacknowledged write operation. The default
 	// value is nil, which means that no hint will be sent.
 	Hint interface{}
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // FindOneAndReplace creates a new FindOneAndReplaceOptions instance.
@@ -641,6 +674,12 @@ func (f *FindOneAndReplaceOptions) SetHint(hint interface{}) *FindOneAndReplaceO
 	return f
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (f *FindOneAndReplaceOptions) SetTimeout(d time.Duration) *FindOneAndReplaceOptions {
+	f.Timeout = &d
+	return f
+}
+
 // MergeFindOneAndReplaceOptions combines the given FindOneAndReplaceOptions instances into a single
 // FindOneAndReplaceOptions in a last-one-wins fashion.
 func MergeFindOneAndReplaceOptions(opts ...*FindOneAndReplaceOptions) *FindOneAndReplaceOptions {
@@ -673,6 +712,9 @@ func MergeFindOneAndReplaceOptions(opts ...*FindOneAndReplaceOptions) *FindOneAn
 		if opt.Hint != nil {
 			fo.Hint = opt.Hint
 		}
+		if opt.Timeout != nil {
+			fo.Timeout = opt.Timeout
+		}
 	}
 
 	return fo
@@ -723,6 +765,11 @@ type FindOneAndUpdateOptions struct {
 	// The driver will return an error if this option is used with during an unacknowledged write operation. The default
 	// value is nil, which means that no hint will be sent.
 	Hint interface{}
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // FindOneAndUpdate creates a new FindOneAndUpdateOptions instance.
@@ -784,6 +831,12 @@ func (f *FindOneAndUpdateOptions) SetHint(hint interface{}) *FindOneAndUpdateOpt
 	return f
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (f *FindOneAndUpdateOptions) SetTimeout(d time.Duration) *FindOneAndUpdateOptions {
+	f.Timeout = &d
+	return f
+}
+
 // MergeFindOneAndUpdateOptions combines the given FindOneAndUpdateOptions instances into a single
 // FindOneAndUpdateOptions in a last-one-wins fashion.
 func MergeFindOneAndUpdateOptions(opts ...*FindOneAndUpdateOptions) *FindOneAndUpdateOptions {
@@ -819,6 +872,9 @@ func MergeFindOneAndUpdateOptions(opts ...*FindOneAndUpdateOptions) *FindOneAndU
 		if opt.Hint != nil {
 			fo.Hint = opt.Hint
 		}
+		if opt.Timeout != nil {
+			fo.Timeout = opt.Timeout
+		}
 	}
 
 	return fo
@@ -850,6 +906,11 @@ type FindOneAndDeleteOptions struct {
 	// The driver will return an error if this option is used with during an unacknowledged write operation. The default
 	// value is nil, which means that no hint will be sent.
 	Hint interface{}
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // FindOneAndDelete creates a new FindOneAndDeleteOptions instance.
@@ -887,6 +948,12 @@ func (f *FindOneAndDeleteOptions) SetHint(hint interface{}) *FindOneAndDeleteOpt
 	return f
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (f *FindOneAndDeleteOptions) SetTimeout(d time.Duration) *FindOneAndDeleteOptions {
+	f.Timeout = &d
+	return f
+}
+
 // MergeFindOneAndDeleteOptions combines the given FindOneAndDeleteOptions instances into a single
 // FindOneAndDeleteOptions in a last-one-wins fashion.
 func MergeFindOneAndDeleteOptions(opts ...*FindOneAndDeleteOptions) *FindOneAndDeleteOptions {
@@ -910,6 +977,9 @@ func MergeFindOneAndDeleteOptions(opts ...*FindOneAndDeleteOptions) *FindOneAndD
 		if opt.Hint != nil {
 			fo.Hint = opt.Hint
 		}
+		if opt.Timeout != nil {
+			fo.Timeout = opt.Timeout
+		}
 	}
 
 	return fo
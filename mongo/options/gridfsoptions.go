@@ -44,6 +44,17 @@ type BucketOptions struct {
 	// The read preference for the bucket. The default value is the read preference of the database from which the
 	// bucket is created.
 	ReadPreference *readpref.ReadPref
+
+	// The client-side timeout for bucket operations. Upload and download streams must finish within this timeout of
+	// being opened unless a deadline is set on the stream. The default value is the timeout of the database from which
+	// the bucket is created.
+	Timeout *time.Duration
+
+	// The number of chunks that a download stream reads ahead of the caller in a background goroutine. This hides the
+	// latency of fetching chunk batches from the server when files are read sequentially. The default value is 0,
+	// which means that chunks are only fetched when they are needed. If this is set, download streams must be closed
+	// to stop the goroutine.
+	PrefetchChunks *int32
 }
 
 // GridFSBucket creates a new BucketOptions instance.
@@ -84,6 +95,18 @@ func (b *BucketOptions) SetReadPreference(rp *readpref.ReadPref) *BucketOptions
 	return b
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (b *BucketOptions) SetTimeout(d time.Duration) *BucketOptions {
+	b.Timeout = &d
+	return b
+}
+
+// SetPrefetchChunks sets the value for the PrefetchChunks field.
+func (b *BucketOptions) SetPrefetchChunks(i int32) *BucketOptions {
+	b.PrefetchChunks = &i
+	return b
+}
+
 // MergeBucketOptions combines the given BucketOptions instances into a single BucketOptions in a last-one-wins fashion.
 func MergeBucketOptions(opts ...*BucketOptions) *BucketOptions {
 	b := GridFSBucket()
@@ -107,6 +130,12 @@ func MergeBucketOptions(opts ...*BucketOptions) *BucketOptions {
 		if opt.ReadPreference != nil {
 			b.ReadPreference = opt.ReadPreference
 		}
+		if opt.Timeout != nil {
+			b.Timeout = opt.Timeout
+		}
+		if opt.PrefetchChunks != nil {
+			b.PrefetchChunks = opt.PrefetchChunks
+		}
 	}
 
 	return b
@@ -124,6 +153,17 @@ type UploadOptions struct {
 
 	// The BSON registry to use for converting filters to BSON documents. The default value is bson.DefaultRegistry.
 	Registry *bsoncodec.Registry
+
+	// The name of the hash algorithm used to compute a digest of the file contents while it is uploaded. The digest
+	// is stored in the "hash" field of the document in the files collection and is verified when the file is
+	// downloaded. Valid values are "md5", "sha1", "sha256", and "sha512". The default value is nil, which means that
+	// no digest is computed.
+	HashAlgorithm *string
+
+	// The maximum number of batches of chunks that are inserted concurrently. A batch holds up to 16 MiB of chunk data
+	// and is copied while it is being inserted, so an upload can use this many extra batches of memory. The default
+	// value is 1, which means that each batch is inserted before Write returns.
+	MaxInFlightBatches *int32
 }
 
 // GridFSUpload creates a new UploadOptions instance.
@@ -143,6 +183,18 @@ func (u *UploadOptions) SetMetadata(doc interface{}) *UploadOptions {
 	return u
 }
 
+// SetHashAlgorithm sets the value for the HashAlgorithm field.
+func (u *UploadOptions) SetHashAlgorithm(alg string) *UploadOptions {
+	u.HashAlgorithm = &alg
+	return u
+}
+
+// SetMaxInFlightBatches sets the value for the MaxInFlightBatches field.
+func (u *UploadOptions) SetMaxInFlightBatches(i int32) *UploadOptions {
+	u.MaxInFlightBatches = &i
+	return u
+}
+
 // MergeUploadOptions combines the given UploadOptions instances into a single UploadOptions in a last-one-wins fashion.
 func MergeUploadOptions(opts ...*UploadOptions) *UploadOptions {
 	u := GridFSUpload()
@@ -160,6 +212,12 @@ func MergeUploadOptions(opts ...*UploadOptions) *UploadOptions {
 		if opt.Registry != nil {
 			u.Registry = opt.Registry
 		}
+		if opt.HashAlgorithm != nil {
+			u.HashAlgorithm = opt.HashAlgorithm
+		}
+		if opt.MaxInFlightBatches != nil {
+			u.MaxInFlightBatches = opt.MaxInFlightBatches
+		}
 	}
 
 	return u
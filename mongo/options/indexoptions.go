@@ -30,6 +30,11 @@ type CreateIndexesOptions struct {
 	// The maximum amount of time that the query can run on the server. The default value is nil, meaning that there
 	// is no time limit for query execution.
 	MaxTime *time.Duration
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // CreateIndexes creates a new CreateIndexesOptions instance.
@@ -67,6 +72,12 @@ func (c *CreateIndexesOptions) SetCommitQuorumVotingMembers() *CreateIndexesOpti
 	return c
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (c *CreateIndexesOptions) SetTimeout(d time.Duration) *CreateIndexesOptions {
+	c.Timeout = &d
+	return c
+}
+
 // MergeCreateIndexesOptions combines the given CreateIndexesOptions into a single CreateIndexesOptions in a last one
 // wins fashion.
 func MergeCreateIndexesOptions(opts ...*CreateIndexesOptions) *CreateIndexesOptions {
@@ -81,6 +92,9 @@ func MergeCreateIndexesOptions(opts ...*CreateIndexesOptions) *CreateIndexesOpti
 		if opt.CommitQuorum != nil {
 			c.CommitQuorum = opt.CommitQuorum
 		}
+		if opt.Timeout != nil {
+			c.Timeout = opt.Timeout
+		}
 	}
 
 	return c
@@ -92,6 +106,11 @@ type DropIndexesOptions struct {
 	// The maximum amount of time that the query can run on the server. The default value is nil, meaning that there
 	// is no time limit for query execution.
 	MaxTime *time.Duration
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // DropIndexes creates a new DropIndexesOptions instance.
@@ -105,6 +124,12 @@ func (d *DropIndexesOptions) SetMaxTime(duration time.Duration) *DropIndexesOpti
 	return d
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (d *DropIndexesOptions) SetTimeout(duration time.Duration) *DropIndexesOptions {
+	d.Timeout = &duration
+	return d
+}
+
 // MergeDropIndexesOptions combines the given DropIndexesOptions into a single DropIndexesOptions in a last-one-wins
 // fashion.
 func MergeDropIndexesOptions(opts ...*DropIndexesOptions) *DropIndexesOptions {
@@ -116,6 +141,9 @@ func MergeDropIndexesOptions(opts ...*DropIndexesOptions) *DropIndexesOptions {
 		if opt.MaxTime != nil {
 			c.MaxTime = opt.MaxTime
 		}
+		if opt.Timeout != nil {
+			c.Timeout = opt.Timeout
+		}
 	}
 
 	return c
@@ -129,6 +157,11 @@ type ListIndexesOptions struct {
 	// The maximum amount of time that the query can run on the server. The default value is nil, meaning that there
 	// is no time limit for query execution.
 	MaxTime *time.Duration
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // ListIndexes creates a new ListIndexesOptions instance.
@@ -148,6 +181,12 @@ func (l *ListIndexesOptions) SetMaxTime(d time.Duration) *ListIndexesOptions {
 	return l
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (l *ListIndexesOptions) SetTimeout(d time.Duration) *ListIndexesOptions {
+	l.Timeout = &d
+	return l
+}
+
 // MergeListIndexesOptions combines the given ListIndexesOptions instances into a single *ListIndexesOptions in a
 // last-one-wins fashion.
 func MergeListIndexesOptions(opts ...*ListIndexesOptions) *ListIndexesOptions {
@@ -162,6 +201,9 @@ func MergeListIndexesOptions(opts ...*ListIndexesOptions) *ListIndexesOptions {
 		if opt.MaxTime != nil {
 			c.MaxTime = opt.MaxTime
 		}
+		if opt.Timeout != nil {
+			c.Timeout = opt.Timeout
+		}
 	}
 
 	return c
@@ -6,6 +6,8 @@
 
 package options
 
+import "time"
+
 // InsertOneOptions represents options that can be used to configure an InsertOne operation.
 type InsertOneOptions struct {
 	// If true, writes executed as part of the operation will opt out of document-level validation on the server. This
@@ -13,6 +15,11 @@ type InsertOneOptions struct {
 	// false. See https://docs.mongodb.com/manual/core/schema-validation/ for more information about document
 	// validation.
 	BypassDocumentValidation *bool
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // InsertOne creates a new InsertOneOptions instance.
@@ -26,6 +33,12 @@ func (ioo *InsertOneOptions) SetBypassDocumentValidation(b bool) *InsertOneOptio
 	return ioo
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (ioo *InsertOneOptions) SetTimeout(d time.Duration) *InsertOneOptions {
+	ioo.Timeout = &d
+	return ioo
+}
+
 // MergeInsertOneOptions combines the given InsertOneOptions instances into a single InsertOneOptions in a last-one-wins
 // fashion.
 func MergeInsertOneOptions(opts ...*InsertOneOptions) *InsertOneOptions {
@@ -37,6 +50,9 @@ func MergeInsertOneOptions(opts ...*InsertOneOptions) *InsertOneOptions {
 		if ioo.BypassDocumentValidation != nil {
 			ioOpts.BypassDocumentValidation = ioo.BypassDocumentValidation
 		}
+		if ioo.Timeout != nil {
+			ioOpts.Timeout = ioo.Timeout
+		}
 	}
 
 	return ioOpts
@@ -52,6 +68,11 @@ type InsertManyOptions struct {
 
 	// If true, no writes will be executed after one fails. The default value is true.
 	Ordered *bool
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // InsertMany creates a new InsertManyOptions instance.
@@ -73,6 +94,12 @@ func (imo *InsertManyOptions) SetOrdered(b bool) *InsertManyOptions {
 	return imo
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (imo *InsertManyOptions) SetTimeout(d time.Duration) *InsertManyOptions {
+	imo.Timeout = &d
+	return imo
+}
+
 // MergeInsertManyOptions combines the givent InsertManyOptions instances into a single InsertManyOptions in a last one
 // wins fashion.
 func MergeInsertManyOptions(opts ...*InsertManyOptions) *InsertManyOptions {
@@ -87,6 +114,9 @@ func MergeInsertManyOptions(opts ...*InsertManyOptions) *InsertManyOptions {
 		if imo.Ordered != nil {
 			imOpts.Ordered = imo.Ordered
 		}
+		if imo.Timeout != nil {
+			imOpts.Timeout = imo.Timeout
+		}
 	}
 
 	return imOpts
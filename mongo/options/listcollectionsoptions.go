@@ -6,10 +6,17 @@
 
 package options
 
+import "time"
+
 // ListCollectionsOptions represents options that can be used to configure a ListCollections operation.
 type ListCollectionsOptions struct {
 	// If true, each collection document will only contain a field for the collection name. The default value is false.
 	NameOnly *bool
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Database used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // ListCollections creates a new ListCollectionsOptions instance.
@@ -23,6 +30,12 @@ func (lc *ListCollectionsOptions) SetNameOnly(b bool) *ListCollectionsOptions {
 	return lc
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (lc *ListCollectionsOptions) SetTimeout(d time.Duration) *ListCollectionsOptions {
+	lc.Timeout = &d
+	return lc
+}
+
 // MergeListCollectionsOptions combines the given ListCollectionsOptions instances into a single *ListCollectionsOptions
 // in a last-one-wins fashion.
 func MergeListCollectionsOptions(opts ...*ListCollectionsOptions) *ListCollectionsOptions {
@@ -34,6 +47,9 @@ func MergeListCollectionsOptions(opts ...*ListCollectionsOptions) *ListCollectio
 		if opt.NameOnly != nil {
 			lc.NameOnly = opt.NameOnly
 		}
+		if opt.Timeout != nil {
+			lc.Timeout = opt.Timeout
+		}
 	}
 
 	return lc
@@ -6,6 +6,8 @@
 
 package options
 
+import "time"
+
 // ListDatabasesOptions represents options that can be used to configure a ListDatabases operation.
 type ListDatabasesOptions struct {
 	// If true, only the Name field of the returned DatabaseSpecification objects will be populated. The default value
@@ -16,6 +18,11 @@ type ListDatabasesOptions struct {
 	// the behavior of this option, see https://docs.mongodb.com/manual/reference/privilege-actions/#find. The default
 	// value is true.
 	AuthorizedDatabases *bool
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Client used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // ListDatabases creates a new ListDatabasesOptions instance.
@@ -35,6 +42,12 @@ func (ld *ListDatabasesOptions) SetAuthorizedDatabases(b bool) *ListDatabasesOpt
 	return ld
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (ld *ListDatabasesOptions) SetTimeout(d time.Duration) *ListDatabasesOptions {
+	ld.Timeout = &d
+	return ld
+}
+
 // MergeListDatabasesOptions combines the given ListDatabasesOptions instances into a single *ListDatabasesOptions in a
 // last-one-wins fashion.
 func MergeListDatabasesOptions(opts ...*ListDatabasesOptions) *ListDatabasesOptions {
@@ -49,6 +62,9 @@ func MergeListDatabasesOptions(opts ...*ListDatabasesOptions) *ListDatabasesOpti
 		if opt.AuthorizedDatabases != nil {
 			ld.AuthorizedDatabases = opt.AuthorizedDatabases
 		}
+		if opt.Timeout != nil {
+			ld.Timeout = opt.Timeout
+		}
 	}
 
 	return ld
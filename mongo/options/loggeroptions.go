@@ -0,0 +1,102 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+import (
+	"go.mongodb.org/mongo-driver/internal/logger"
+)
+
+// LogLevel is the severity of a log message.
+type LogLevel int
+
+// These constants are the log levels that can be set for a component.
+const (
+	// LogLevelInfo enables logging of informational messages.
+	LogLevelInfo LogLevel = LogLevel(logger.LevelInfo)
+
+	// LogLevelDebug enables logging of debug messages, which includes all informational messages.
+	LogLevelDebug LogLevel = LogLevel(logger.LevelDebug)
+)
+
+// LogComponent is a part of the driver that produces log messages.
+type LogComponent int
+
+// These constants are the driver components that can be logged.
+const (
+	// LogComponentAll enables logging for all components.
+	LogComponentAll LogComponent = LogComponent(logger.ComponentAll)
+
+	// LogComponentCommand enables logging of command started, succeeded, and failed messages.
+	LogComponentCommand LogComponent = LogComponent(logger.ComponentCommand)
+
+	// LogComponentTopology enables logging of topology changes and server heartbeats.
+	LogComponentTopology LogComponent = LogComponent(logger.ComponentTopology)
+
+	// LogComponentServerSelection enables logging of server selection decisions.
+	LogComponentServerSelection LogComponent = LogComponent(logger.ComponentServerSelection)
+
+	// LogComponentConnection enables logging of connection pool lifecycle messages.
+	LogComponentConnection LogComponent = LogComponent(logger.ComponentConnection)
+)
+
+// LogSink is the interface that must be implemented to receive driver log messages. It is compatible with the
+// github.com/go-logr/logr LogSink interface.
+type LogSink interface {
+	// Info logs a non-error message with the given key/value pairs. The level is the verbosity of the message, where
+	// higher values are more verbose.
+	Info(level int, message string, keysAndValues ...interface{})
+
+	// Error logs an error with the given message and key/value pairs.
+	Error(err error, message string, keysAndValues ...interface{})
+}
+
+// LoggerOptions represents options used to configure driver logging. Logging can also be configured with the
+// MONGODB_LOG_ALL, MONGODB_LOG_COMMAND, MONGODB_LOG_TOPOLOGY, MONGODB_LOG_SERVER_SELECTION, MONGODB_LOG_CONNECTION,
+// MONGODB_LOG_MAX_DOCUMENT_LENGTH, and MONGODB_LOG_PATH environment variables. Options that are set take precedence
+// over the environment.
+type LoggerOptions struct {
+	// The level for each component. Setting LogComponentAll applies the level to every component, but levels set for
+	// individual components take precedence. Components that are not set here or in the environment are not logged.
+	ComponentLevels map[LogComponent]LogLevel
+
+	// The sink that log messages are written to. The default value is nil, which means that messages are written as
+	// JSON to the file named by the MONGODB_LOG_PATH environment variable, or to standard error if it is unset.
+	Sink LogSink
+
+	// The maximum length of a stringified document, such as a command or a reply, in a log message. Longer documents
+	// are truncated and end with "...". The default value is 0, which means that the value of the
+	// MONGODB_LOG_MAX_DOCUMENT_LENGTH environment variable is used, or 1000 if it is unset.
+	MaxDocumentLength uint
+}
+
+// Logger creates a new LoggerOptions instance.
+func Logger() *LoggerOptions {
+	return &LoggerOptions{
+		ComponentLevels: make(map[LogComponent]LogLevel),
+	}
+}
+
+// SetComponentLevel sets the log level for the given component.
+func (opts *LoggerOptions) SetComponentLevel(component LogComponent, level LogLevel) *LoggerOptions {
+	if opts.ComponentLevels == nil {
+		opts.ComponentLevels = make(map[LogComponent]LogLevel)
+	}
+	opts.ComponentLevels[component] = level
+	return opts
+}
+
+// SetSink sets the value for the Sink field.
+func (opts *LoggerOptions) SetSink(sink LogSink) *LoggerOptions {
+	opts.Sink = sink
+	return opts
+}
+
+// SetMaxDocumentLength sets the value for the MaxDocumentLength field.
+func (opts *LoggerOptions) SetMaxDocumentLength(maxDocumentLength uint) *LoggerOptions {
+	opts.MaxDocumentLength = maxDocumentLength
+	return opts
+}
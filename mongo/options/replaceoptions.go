@@ -6,6 +6,8 @@
 
 package options
 
+import "time"
+
 // ReplaceOptions represents options that can be used to configure a ReplaceOne operation.
 type ReplaceOptions struct {
 	// If true, writes executed as part of the operation will opt out of document-level validation on the server. This
@@ -29,6 +31,11 @@ type ReplaceOptions struct {
 	// If true, a new document will be inserted if the filter does not match any documents in the collection. The
 	// default value is false.
 	Upsert *bool
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // Replace creates a new ReplaceOptions instance.
@@ -60,6 +67,12 @@ func (ro *ReplaceOptions) SetUpsert(b bool) *ReplaceOptions {
 	return ro
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (ro *ReplaceOptions) SetTimeout(d time.Duration) *ReplaceOptions {
+	ro.Timeout = &d
+	return ro
+}
+
 // MergeReplaceOptions combines the given ReplaceOptions instances into a single ReplaceOptions in a last-one-wins
 // fashion.
 func MergeReplaceOptions(opts ...*ReplaceOptions) *ReplaceOptions {
@@ -80,6 +93,9 @@ func MergeReplaceOptions(opts ...*ReplaceOptions) *ReplaceOptions {
 		if ro.Upsert != nil {
 			rOpts.Upsert = ro.Upsert
 		}
+		if ro.Timeout != nil {
+			rOpts.Timeout = ro.Timeout
+		}
 	}
 
 	return rOpts
@@ -6,13 +6,22 @@
 
 package options
 
-import "go.mongodb.org/mongo-driver/mongo/readpref"
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
 
 // RunCmdOptions represents options that can be used to configure a RunCommand operation.
 type RunCmdOptions struct {
 	// The read preference to use for the operation. The default value is nil, which means that the primary read
 	// preference will be used.
 	ReadPreference *readpref.ReadPref
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Database used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // RunCmd creates a new RunCmdOptions instance.
@@ -26,6 +35,12 @@ func (rc *RunCmdOptions) SetReadPreference(rp *readpref.ReadPref) *RunCmdOptions
 	return rc
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (rc *RunCmdOptions) SetTimeout(d time.Duration) *RunCmdOptions {
+	rc.Timeout = &d
+	return rc
+}
+
 // MergeRunCmdOptions combines the given RunCmdOptions instances into one *RunCmdOptions in a last-one-wins fashion.
 func MergeRunCmdOptions(opts ...*RunCmdOptions) *RunCmdOptions {
 	rc := RunCmd()
@@ -36,6 +51,9 @@ func MergeRunCmdOptions(opts ...*RunCmdOptions) *RunCmdOptions {
 		if opt.ReadPreference != nil {
 			rc.ReadPreference = opt.ReadPreference
 		}
+		if opt.Timeout != nil {
+			rc.Timeout = opt.Timeout
+		}
 	}
 
 	return rc
@@ -0,0 +1,61 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+import (
+	"fmt"
+)
+
+// ServerAPIVersion represents an API version that can be used in ServerAPIOptions.
+type ServerAPIVersion string
+
+const (
+	// ServerAPIVersion1 is the first API version.
+	ServerAPIVersion1 ServerAPIVersion = "1"
+)
+
+// Validate determines if the provided ServerAPIVersion is currently supported by the driver.
+func (sav ServerAPIVersion) Validate() error {
+	switch sav {
+	case ServerAPIVersion1:
+		return nil
+	}
+	return fmt.Errorf("api version %q not supported; this driver version only supports API version %q", sav, ServerAPIVersion1)
+}
+
+// ServerAPIOptions represents options used to configure the Stable API version that is declared on every command sent
+// to the server. Declaring an API version guarantees that the behavior of the commands used by the application will
+// not change when the server is upgraded.
+type ServerAPIOptions struct {
+	// The API version to declare. This option is required.
+	ServerAPIVersion ServerAPIVersion
+
+	// If true, the server will return an error for any command or command option that is not part of the declared API
+	// version. The default value is nil, meaning the server's default of false will be used.
+	Strict *bool
+
+	// If true, the server will return an error for any command or command option that is deprecated in the declared
+	// API version. The default value is nil, meaning the server's default of false will be used.
+	DeprecationErrors *bool
+}
+
+// ServerAPI creates a new ServerAPIOptions configured with the provided API version.
+func ServerAPI(serverAPIVersion ServerAPIVersion) *ServerAPIOptions {
+	return &ServerAPIOptions{ServerAPIVersion: serverAPIVersion}
+}
+
+// SetStrict sets the value for the Strict field.
+func (s *ServerAPIOptions) SetStrict(strict bool) *ServerAPIOptions {
+	s.Strict = &strict
+	return s
+}
+
+// SetDeprecationErrors sets the value for the DeprecationErrors field.
+func (s *ServerAPIOptions) SetDeprecationErrors(deprecationErrors bool) *ServerAPIOptions {
+	s.DeprecationErrors = &deprecationErrors
+	return s
+}
@@ -19,7 +19,8 @@ var DefaultCausalConsistency = true
 
 // SessionOptions represents options that can be used to configure a Session.
 type SessionOptions struct {
-	// If true, causal consistency will be enabled for the session. The default value is true. See
+	// If true, causal consistency will be enabled for the session. This cannot be set to true if Snapshot is true.
+	// The default value is true unless Snapshot is true. See
 	// https://docs.mongodb.com/manual/core/read-isolation-consistency-recency/#sessions for more information.
 	CausalConsistency *bool
 
@@ -38,13 +39,17 @@ type SessionOptions struct {
 	// The default maximum amount of time that a CommitTransaction operation executed in the session can run on the
 	// server. The default value is nil, which means that that there is no time limit for execution.
 	DefaultMaxCommitTime *time.Duration
+
+	// If true, all reads in the session will read from the same snapshot of the data, which is the snapshot at the
+	// time of the first read. The reads are performed with a "snapshot" read concern and are not causally consistent.
+	// Transactions cannot be started in the session. This option is only supported for MongoDB versions >= 5.0. The
+	// default value is false.
+	Snapshot *bool
 }
 
 // Session creates a new SessionOptions instance.
 func Session() *SessionOptions {
-	return &SessionOptions{
-		CausalConsistency: &DefaultCausalConsistency,
-	}
+	return &SessionOptions{}
 }
 
 // SetCausalConsistency sets the value for the CausalConsistency field.
@@ -77,6 +82,12 @@ func (s *SessionOptions) SetDefaultMaxCommitTime(mct *time.Duration) *SessionOpt
 	return s
 }
 
+// SetSnapshot sets the value for the Snapshot field.
+func (s *SessionOptions) SetSnapshot(b bool) *SessionOptions {
+	s.Snapshot = &b
+	return s
+}
+
 // MergeSessionOptions combines the given SessionOptions instances into a single SessionOptions in a last-one-wins
 // fashion.
 func MergeSessionOptions(opts ...*SessionOptions) *SessionOptions {
@@ -100,6 +111,15 @@ func MergeSessionOptions(opts ...*SessionOptions) *SessionOptions {
 		if opt.DefaultMaxCommitTime != nil {
 			s.DefaultMaxCommitTime = opt.DefaultMaxCommitTime
 		}
+		if opt.Snapshot != nil {
+			s.Snapshot = opt.Snapshot
+		}
+	}
+	// Snapshot sessions are not causally consistent, so the default only applies to other sessions. A value that was
+	// set explicitly is kept and conflicts with Snapshot.
+	if s.CausalConsistency == nil && (s.Snapshot == nil || !*s.Snapshot) {
+		cc := DefaultCausalConsistency
+		s.CausalConsistency = &cc
 	}
 
 	return s
@@ -0,0 +1,34 @@
+package options
+
+import (
+	"testing"
+)
+
+func TestMergeSessionOptions(t *testing.T) {
+	t.Run("default causal consistency", func(t *testing.T) {
+		s := MergeSessionOptions(Session())
+		if s.CausalConsistency == nil || !*s.CausalConsistency {
+			t.Fatalf("expected causal consistency to default to true, got %v", s.CausalConsistency)
+		}
+	})
+	t.Run("snapshot drops default causal consistency", func(t *testing.T) {
+		s := MergeSessionOptions(Session().SetSnapshot(true))
+		if s.CausalConsistency != nil {
+			t.Fatalf("expected causal consistency to be unset, got %v", *s.CausalConsistency)
+		}
+	})
+	t.Run("snapshot keeps explicit default causal consistency", func(t *testing.T) {
+		opts := Session().SetSnapshot(true)
+		opts.CausalConsistency = &DefaultCausalConsistency
+		s := MergeSessionOptions(opts)
+		if s.CausalConsistency == nil || !*s.CausalConsistency {
+			t.Fatalf("expected explicit causal consistency to be kept, got %v", s.CausalConsistency)
+		}
+	})
+	t.Run("snapshot keeps explicit causal consistency", func(t *testing.T) {
+		s := MergeSessionOptions(Session().SetCausalConsistency(true).SetSnapshot(true))
+		if s.CausalConsistency == nil || !*s.CausalConsistency {
+			t.Fatalf("expected explicit causal consistency to be kept, got %v", s.CausalConsistency)
+		}
+	})
+}
@@ -6,6 +6,8 @@
 
 package options
 
+import "time"
+
 // UpdateOptions represents options that can be used to configure UpdateOne and UpdateMany operations.
 type UpdateOptions struct {
 	// A set of filters specifying to which array elements an update should apply. This option is only valid for MongoDB
@@ -34,6 +36,11 @@ type UpdateOptions struct {
 	// If true, a new document will be inserted if the filter does not match any documents in the collection. The
 	// default value is false.
 	Upsert *bool
+
+	// The client-side timeout for the operation. The timeout bounds the entire operation, including server selection,
+	// connection checkout, retries, and all round trips to the server. The default value is nil, which means that the
+	// timeout of the Collection used to run the operation will be used.
+	Timeout *time.Duration
 }
 
 // Update creates a new UpdateOptions instance.
@@ -71,6 +78,12 @@ func (uo *UpdateOptions) SetUpsert(b bool) *UpdateOptions {
 	return uo
 }
 
+// SetTimeout sets the value for the Timeout field.
+func (uo *UpdateOptions) SetTimeout(d time.Duration) *UpdateOptions {
+	uo.Timeout = &d
+	return uo
+}
+
 // MergeUpdateOptions combines the given UpdateOptions instances into a single UpdateOptions in a last-one-wins fashion.
 func MergeUpdateOptions(opts ...*UpdateOptions) *UpdateOptions {
 	uOpts := Update()
@@ -93,6 +106,9 @@ func MergeUpdateOptions(opts ...*UpdateOptions) *UpdateOptions {
 		if uo.Upsert != nil {
 			uOpts.Upsert = uo.Upsert
 		}
+		if uo.Timeout != nil {
+			uOpts.Timeout = uo.Timeout
+		}
 	}
 
 	return uOpts
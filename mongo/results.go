@@ -34,6 +34,31 @@ type BulkWriteResult struct {
 	UpsertedIDs map[int64]interface{}
 }
 
+// ClientBulkWriteResult is the result type returned by a Client.BulkWrite operation.
+type ClientBulkWriteResult struct {
+	// The number of documents inserted.
+	InsertedCount int64
+
+	// The number of documents matched by filters in update and replace operations.
+	MatchedCount int64
+
+	// The number of documents modified by update and replace operations.
+	ModifiedCount int64
+
+	// The number of documents deleted.
+	DeletedCount int64
+
+	// The number of documents upserted by update and replace operations.
+	UpsertedCount int64
+
+	// A map of operation index to the _id of each inserted document. Values generated by the driver will be of type
+	// primitive.ObjectID.
+	InsertedIDs map[int64]interface{}
+
+	// A map of operation index to the _id of each upserted document.
+	UpsertedIDs map[int64]interface{}
+}
+
 // InsertOneResult is the result type returned by an InsertOne operation.
 type InsertOneResult struct {
 	// The _id of the inserted document. A value generated by the driver will be of type primitive.ObjectID.
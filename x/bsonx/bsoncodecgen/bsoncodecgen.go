@@ -0,0 +1,716 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package bsoncodecgen generates bsoncodec.ValueEncoder and bsoncodec.ValueDecoder implementations for Go struct
+// types. The generated codecs produce the same BSON as the default bsoncodec.StructCodec, but read and write fields of
+// common types directly against a bsonrw.ValueReader or bsonrw.ValueWriter instead of going through reflection.
+//
+// Fields of type bool, int, int32, int64, float64, string, and primitive.ObjectID are handled directly. All other
+// fields, and values of the handled types that need a conversion while decoding, are delegated to the codecs in the
+// registry through bsoncodec.EncodeStructField and bsoncodec.DecodeStructField. The directly handled fields assume
+// the default codecs are registered for those types.
+package bsoncodecgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+)
+
+const primitivePath = "go.mongodb.org/mongo-driver/bson/primitive"
+
+// fieldKind is the kind of a field that the generated code handles without reflection. The zero value means the field
+// is delegated to the registry.
+type fieldKind int
+
+const (
+	kindOther fieldKind = iota
+	kindBool
+	kindInt
+	kindInt32
+	kindInt64
+	kindFloat64
+	kindString
+	kindObjectID
+)
+
+var builtinKinds = map[string]fieldKind{
+	"bool":    kindBool,
+	"int":     kindInt,
+	"int32":   kindInt32,
+	"int64":   kindInt64,
+	"float64": kindFloat64,
+	"string":  kindString,
+}
+
+// Package is a parsed Go package whose struct types can be used to generate codecs.
+type Package struct {
+	Name string
+
+	structs map[string]*structType
+}
+
+type structType struct {
+	name    string
+	fields  *ast.FieldList
+	imports map[string]string // local name to import path
+}
+
+// field is a single BSON element of a struct, possibly reached through inlined structs.
+type field struct {
+	name      string
+	goPath    []string
+	index     []int
+	ptrTypes  []string // for each inlined step, the pointed-to type name, or "" if the step is not a pointer
+	kind      fieldKind
+	omitEmpty bool
+	minSize   bool
+	truncate  bool
+}
+
+// Struct is the description of a struct type that codecs are generated from.
+type Struct struct {
+	Name string
+
+	fields    []field
+	inlineMap *field
+}
+
+// ParseDir parses the non-test Go files in dir.
+func ParseDir(dir string) (*Package, error) {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool { return !strings.HasSuffix(fi.Name(), "_test.go") }
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected exactly one package in %s, found %d", dir, len(pkgs))
+	}
+
+	var pkg *ast.Package
+	for _, p := range pkgs {
+		pkg = p
+	}
+	return newPackage(pkg.Name, pkg.Files)
+}
+
+// ParseSource parses a single Go source file.
+func ParseSource(filename string, src interface{}) (*Package, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, 0)
+	if err != nil {
+		return nil, err
+	}
+	return newPackage(file.Name.Name, map[string]*ast.File{filename: file})
+}
+
+func newPackage(name string, files map[string]*ast.File) (*Package, error) {
+	p := &Package{Name: name, structs: make(map[string]*structType)}
+	for _, file := range files {
+		imports := make(map[string]string)
+		for _, spec := range file.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				return nil, err
+			}
+			local := path[strings.LastIndex(path, "/")+1:]
+			if spec.Name != nil {
+				local = spec.Name.Name
+			}
+			imports[local] = path
+		}
+
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					// Record the name so that it is not mistaken for a builtin type.
+					p.structs[ts.Name.Name] = nil
+					continue
+				}
+				p.structs[ts.Name.Name] = &structType{name: ts.Name.Name, fields: st.Fields, imports: imports}
+			}
+		}
+	}
+	return p, nil
+}
+
+// Struct returns the description of the struct type with the given name.
+func (p *Package) Struct(name string) (*Struct, error) {
+	st := p.structs[name]
+	if st == nil {
+		return nil, fmt.Errorf("struct type %s not found in package %s", name, p.Name)
+	}
+
+	s := &Struct{Name: name}
+	fields, inlineMap, err := p.describe(st, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.fields = fields
+	s.inlineMap = inlineMap
+	return s, nil
+}
+
+// describe returns the fields of st in the order the StructCodec encodes them. The seen slice contains the struct
+// types that are currently being inlined and is used to reject recursive inlining.
+func (p *Package) describe(st *structType, seen []string) ([]field, *field, error) {
+	for _, name := range seen {
+		if name == st.name {
+			return nil, nil, fmt.Errorf("(struct %s) recursive inline of %s", seen[0], st.name)
+		}
+	}
+	seen = append(seen, st.name)
+
+	var fields []field
+	var inlineMap *field
+	names := make(map[string]struct{})
+	idx := -1
+	for _, af := range st.fields.List {
+		goNames := make([]string, 0, len(af.Names))
+		for _, n := range af.Names {
+			goNames = append(goNames, n.Name)
+		}
+		if len(goNames) == 0 {
+			goNames = append(goNames, embeddedName(af.Type))
+		}
+
+		var tag reflect.StructTag
+		if af.Tag != nil {
+			raw, err := strconv.Unquote(af.Tag.Value)
+			if err != nil {
+				return nil, nil, err
+			}
+			tag = reflect.StructTag(raw)
+		}
+
+		for _, goName := range goNames {
+			idx++
+			if !isExported(goName) {
+				continue
+			}
+			stags, err := bsoncodec.DefaultStructTagParser(reflect.StructField{Name: goName, Tag: tag})
+			if err != nil {
+				return nil, nil, err
+			}
+			if stags.Skip {
+				continue
+			}
+
+			if stags.Inline {
+				inlined, isMap, err := p.inline(st, goName, af.Type, idx, seen)
+				if err != nil {
+					return nil, nil, err
+				}
+				if isMap {
+					if inlineMap != nil {
+						return nil, nil, fmt.Errorf("(struct %s) multiple inline maps", st.name)
+					}
+					inlineMap = &field{goPath: []string{goName}, index: []int{idx}}
+					continue
+				}
+				for _, f := range inlined {
+					if _, exists := names[f.name]; exists {
+						return nil, nil, fmt.Errorf("(struct %s) duplicated key %s", st.name, f.name)
+					}
+					names[f.name] = struct{}{}
+					fields = append(fields, f)
+				}
+				continue
+			}
+
+			if _, exists := names[stags.Name]; exists {
+				return nil, nil, fmt.Errorf("(struct %s) duplicated key %s", st.name, stags.Name)
+			}
+			names[stags.Name] = struct{}{}
+			fields = append(fields, field{
+				name:      stags.Name,
+				goPath:    []string{goName},
+				index:     []int{idx},
+				kind:      p.kind(st, af.Type),
+				omitEmpty: stags.OmitEmpty,
+				minSize:   stags.MinSize,
+				truncate:  stags.Truncate,
+			})
+		}
+	}
+	return fields, inlineMap, nil
+}
+
+// inline returns the fields of the inlined struct field goName with type expr, or reports that the field is an inline
+// map.
+func (p *Package) inline(st *structType, goName string, expr ast.Expr, idx int, seen []string) ([]field, bool, error) {
+	var ptrType string
+	switch t := expr.(type) {
+	case *ast.MapType:
+		if key, ok := t.Key.(*ast.Ident); !ok || key.Name != "string" || p.declared(key.Name) {
+			return nil, false, fmt.Errorf("(struct %s) inline map must have a string keys", st.name)
+		}
+		return nil, true, nil
+	case *ast.StarExpr:
+		expr = t.X
+		ident, ok := expr.(*ast.Ident)
+		if !ok {
+			break
+		}
+		ptrType = ident.Name
+	}
+
+	ident, ok := expr.(*ast.Ident)
+	if !ok || p.structs[ident.Name] == nil {
+		return nil, false, fmt.Errorf(
+			"(struct %s) cannot inline field %s: only structs, struct pointers, and maps declared in package %s are supported",
+			st.name, goName, p.Name)
+	}
+
+	inlined, _, err := p.describe(p.structs[ident.Name], seen)
+	if err != nil {
+		return nil, false, err
+	}
+	for i := range inlined {
+		f := &inlined[i]
+		f.goPath = append([]string{goName}, f.goPath...)
+		f.index = append([]int{idx}, f.index...)
+		f.ptrTypes = append([]string{ptrType}, f.ptrTypes...)
+	}
+	return inlined, false, nil
+}
+
+// kind returns the fieldKind for a field of type expr declared in st.
+func (p *Package) kind(st *structType, expr ast.Expr) fieldKind {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if p.declared(t.Name) {
+			return kindOther
+		}
+		return builtinKinds[t.Name]
+	case *ast.SelectorExpr:
+		pkg, ok := t.X.(*ast.Ident)
+		if ok && st.imports[pkg.Name] == primitivePath && t.Sel.Name == "ObjectID" {
+			return kindObjectID
+		}
+	}
+	return kindOther
+}
+
+func (p *Package) declared(name string) bool {
+	_, ok := p.structs[name]
+	return ok
+}
+
+// embeddedName returns the field name of an embedded field with type expr.
+func embeddedName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	}
+	return ""
+}
+
+func isExported(name string) bool {
+	r, _ := utf8.DecodeRuneInString(name)
+	return unicode.IsUpper(r)
+}
+
+// Generate writes a Go source file in package p containing codecs for the given structs and a function named
+// registerFunc that registers them with a *bsoncodec.RegistryBuilder.
+func (p *Package) Generate(w io.Writer, registerFunc string, structs ...*Struct) error {
+	sorted := make([]*Struct, len(structs))
+	copy(sorted, structs)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	g := &generator{}
+	g.printf("// Code generated by bsoncodecgen. DO NOT EDIT.\n\n")
+	g.printf("package %s\n\n", p.Name)
+	g.printf("import (\n")
+	g.printf("\"fmt\"\n\"reflect\"\n\"strings\"\n\n")
+	g.printf("\"go.mongodb.org/mongo-driver/bson/bsoncodec\"\n")
+	g.printf("\"go.mongodb.org/mongo-driver/bson/bsonrw\"\n")
+	g.printf("\"go.mongodb.org/mongo-driver/bson/bsontype\"\n")
+	g.printf(")\n\n")
+
+	g.printf("// %s registers the generated BSON codecs with rb.\n", registerFunc)
+	g.printf("func %s(rb *bsoncodec.RegistryBuilder) *bsoncodec.RegistryBuilder {\n", registerFunc)
+	for _, s := range sorted {
+		g.printf("rb.RegisterTypeEncoder(%s, %s{})\n", s.typeVar(), s.codecName())
+		g.printf("rb.RegisterTypeDecoder(%s, %s{})\n", s.typeVar(), s.codecName())
+	}
+	g.printf("return rb\n}\n")
+
+	for _, s := range sorted {
+		g.printf("\n")
+		s.generate(g)
+	}
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not format generated code: %v\n%s", err, g.buf.Bytes())
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+type generator struct {
+	buf bytes.Buffer
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (s *Struct) codecName() string {
+	r, size := utf8.DecodeRuneInString(s.Name)
+	return string(unicode.ToLower(r)) + s.Name[size:] + "BSONCodec"
+}
+
+func (s *Struct) typeVar() string { return s.codecName() + "Type" }
+
+func (s *Struct) generate(g *generator) {
+	codec := s.codecName()
+	g.printf("var %s = reflect.TypeOf(%s{})\n\n", s.typeVar(), s.Name)
+	g.printf("// %s is the generated BSON codec for %s.\n", codec, s.Name)
+	g.printf("type %s struct{}\n\n", codec)
+
+	s.generateEncode(g)
+	g.printf("\n")
+	s.generateDecode(g)
+	if s.inlineMap != nil {
+		g.printf("\n")
+		g.printf("func (%s) hasField(key string) bool {\n", codec)
+		if len(s.fields) > 0 {
+			g.printf("switch key {\ncase ")
+			for i, f := range s.fields {
+				if i > 0 {
+					g.printf(", ")
+				}
+				g.printf("%q", f.name)
+			}
+			g.printf(":\nreturn true\n}\n")
+		}
+		g.printf("return false\n}\n")
+	}
+}
+
+func (s *Struct) generateEncode(g *generator) {
+	codec := s.codecName()
+	g.printf("// EncodeValue implements the bsoncodec.ValueEncoder interface.\n")
+	g.printf("func (c %s) EncodeValue(ec bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {\n", codec)
+	g.printf("if !val.IsValid() || val.Type() != %s {\n", s.typeVar())
+	g.printf("return bsoncodec.ValueEncoderError{Name: \"%s.EncodeValue\", Types: []reflect.Type{%s}, Received: val}\n}\n\n",
+		codec, s.typeVar())
+
+	if s.usesStruct() {
+		g.printf("var s *%s\n", s.Name)
+		g.printf("if val.CanAddr() {\ns = val.Addr().Interface().(*%s)\n} else {\n", s.Name)
+		g.printf("v := val.Interface().(%s)\ns = &v\n}\n\n", s.Name)
+	}
+
+	g.printf("dw, err := vw.WriteDocument()\nif err != nil {\nreturn err\n}\n")
+	if s.hasKind() {
+		g.printf("var evw bsonrw.ValueWriter\n")
+	}
+
+	for _, f := range s.fields {
+		g.printf("\n")
+		var conds []string
+		if guard := f.nilGuard(); guard != "" {
+			conds = append(conds, guard)
+		}
+		if f.omitEmpty && f.kind != kindOther {
+			conds = append(conds, f.nonZero())
+		}
+		if len(conds) > 0 {
+			g.printf("if %s {\n", strings.Join(conds, " && "))
+		}
+
+		if f.kind == kindOther {
+			g.printf("err = bsoncodec.EncodeStructField(ec, dw, %q, %s, %t, %t)\n", f.name, f.reflectValue(), f.omitEmpty, f.minSize)
+			g.printf("if err != nil {\nreturn err\n}\n")
+		} else {
+			g.printf("evw, err = dw.WriteDocumentElement(%q)\nif err != nil {\nreturn err\n}\n", f.name)
+			f.writeValue(g)
+			g.printf("if err != nil {\nreturn bsoncodec.WrapEncodeError(%q, reflect.ValueOf(%s), err)\n}\n", f.name, f.selector())
+		}
+		if len(conds) > 0 {
+			g.printf("}\n")
+		}
+	}
+
+	g.printf("\n")
+	if s.inlineMap != nil {
+		g.printf("return bsoncodec.EncodeInlineMap(ec, dw, %s, c.hasField)\n}\n", s.inlineMap.reflectValue())
+		return
+	}
+	g.printf("return dw.WriteDocumentEnd()\n}\n")
+}
+
+func (s *Struct) generateDecode(g *generator) {
+	codec := s.codecName()
+	g.printf("// DecodeValue implements the bsoncodec.ValueDecoder interface.\n")
+	g.printf("func (c %s) DecodeValue(dc bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {\n", codec)
+	g.printf("if !val.CanSet() || val.Type() != %s {\n", s.typeVar())
+	g.printf("return bsoncodec.ValueDecoderError{Name: \"%s.DecodeValue\", Types: []reflect.Type{%s}, Received: val}\n}\n\n",
+		codec, s.typeVar())
+
+	g.printf(`switch vrType := vr.Type(); vrType {
+case bsontype.Type(0), bsontype.EmbeddedDocument:
+case bsontype.Null:
+	if err := vr.ReadNull(); err != nil {
+		return err
+	}
+	val.Set(reflect.Zero(val.Type()))
+	return nil
+case bsontype.Undefined:
+	if err := vr.ReadUndefined(); err != nil {
+		return err
+	}
+	val.Set(reflect.Zero(val.Type()))
+	return nil
+default:
+	return fmt.Errorf("cannot decode %%v into a %%s", vrType, val.Type())
+}
+
+`)
+	g.printf("s := val.Addr().Interface().(*%s)\n", s.Name)
+	g.printf(`dr, err := vr.ReadDocument()
+if err != nil {
+	return err
+}
+
+var seenFields []bool
+`)
+	if s.inlineMap != nil {
+		g.printf("var seenKeys map[string]struct{}\n")
+	}
+	g.printf(`if dc.Strict {
+	seenFields = make([]bool, %d)
+}
+
+for {
+	name, evr, err := dr.ReadElement()
+	if err == bsonrw.ErrEOD {
+		break
+	}
+	if err != nil {
+		return err
+	}
+
+	idx := c.fieldIndex(name)
+	if idx < 0 {
+		// Field names are matched case-insensitively if there is no exact match.
+		idx = c.fieldIndex(strings.ToLower(name))
+	}
+	if idx >= 0 {
+		if dc.Strict {
+			if seenFields[idx] {
+				return bsoncodec.NewDecodeError(name, bsoncodec.ErrDuplicateField)
+			}
+			seenFields[idx] = true
+		}
+		err = c.decodeField(dc, evr, val, s, idx)
+		if err != nil {
+			return err
+		}
+		continue
+	}
+
+`, len(s.fields))
+	if s.inlineMap != nil {
+		g.printf(`if dc.Strict {
+	if _, dup := seenKeys[name]; dup {
+		return bsoncodec.NewDecodeError(name, bsoncodec.ErrDuplicateField)
+	}
+	if seenKeys == nil {
+		seenKeys = make(map[string]struct{})
+	}
+	seenKeys[name] = struct{}{}
+}
+`)
+		g.printf("err = bsoncodec.DecodeInlineMapElement(dc, evr, name, %s)\n", s.inlineMap.reflectValue())
+	} else {
+		g.printf("if dc.Strict {\nreturn bsoncodec.NewDecodeError(name, bsoncodec.ErrUnknownField)\n}\n")
+		g.printf("err = evr.Skip()\n")
+	}
+	g.printf("if err != nil {\nreturn err\n}\n}\n\nreturn nil\n}\n\n")
+
+	g.printf("// fieldIndex returns the index of the field with the BSON key name, or -1 if there is no such field.\n")
+	g.printf("func (%s) fieldIndex(name string) int {\n", codec)
+	if len(s.fields) > 0 {
+		g.printf("switch name {\n")
+		for i, f := range s.fields {
+			g.printf("case %q:\nreturn %d\n", f.name, i)
+		}
+		g.printf("}\n")
+	}
+	g.printf("return -1\n}\n\n")
+
+	g.printf("func (%s) decodeField(dc bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value, s *%s, idx int) error {\n",
+		codec, s.Name)
+	if len(s.fields) > 0 {
+		g.printf("switch idx {\n")
+		for i, f := range s.fields {
+			g.printf("case %d:\n", i)
+			f.allocInline(g)
+			if f.kind != kindOther {
+				f.readValue(g)
+			}
+			g.printf("return bsoncodec.DecodeStructField(dc, vr, %q, %s, %t)\n", f.name, f.reflectValue(), f.truncate)
+		}
+		g.printf("}\n")
+	}
+	g.printf("return nil\n}\n")
+}
+
+func (s *Struct) hasKind() bool {
+	for _, f := range s.fields {
+		if f.kind != kindOther {
+			return true
+		}
+	}
+	return false
+}
+
+// usesStruct reports whether the generated encoder accesses the struct's fields directly.
+func (s *Struct) usesStruct() bool {
+	for _, f := range s.fields {
+		if f.kind != kindOther || f.nilGuard() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// selector returns the Go expression for the field relative to s.
+func (f field) selector() string { return "s." + strings.Join(f.goPath, ".") }
+
+// reflectValue returns the Go expression for the reflect.Value of the field relative to val.
+func (f field) reflectValue() string {
+	var b strings.Builder
+	b.WriteString("val")
+	for i, idx := range f.index {
+		fmt.Fprintf(&b, ".Field(%d)", idx)
+		if i < len(f.ptrTypes) && f.ptrTypes[i] != "" {
+			b.WriteString(".Elem()")
+		}
+	}
+	return b.String()
+}
+
+// nilGuard returns the condition under which all of the inlined struct pointers leading to the field are non-nil.
+func (f field) nilGuard() string {
+	var conds []string
+	for i, ptrType := range f.ptrTypes {
+		if ptrType != "" {
+			conds = append(conds, "s."+strings.Join(f.goPath[:i+1], ".")+" != nil")
+		}
+	}
+	return strings.Join(conds, " && ")
+}
+
+// allocInline writes statements that allocate the nil inlined struct pointers leading to the field.
+func (f field) allocInline(g *generator) {
+	for i, ptrType := range f.ptrTypes {
+		if ptrType == "" {
+			continue
+		}
+		sel := "s." + strings.Join(f.goPath[:i+1], ".")
+		g.printf("if %s == nil {\n%s = new(%s)\n}\n", sel, sel, ptrType)
+	}
+}
+
+// nonZero returns the condition under which the field is not considered empty by the StructCodec.
+func (f field) nonZero() string {
+	sel := f.selector()
+	switch f.kind {
+	case kindBool:
+		return sel
+	case kindString:
+		return sel + ` != ""`
+	case kindObjectID:
+		return "!" + sel + ".IsZero()"
+	}
+	return sel + " != 0"
+}
+
+// writeValue writes statements that encode the field to evw and assign the result to err.
+func (f field) writeValue(g *generator) {
+	sel := f.selector()
+	switch f.kind {
+	case kindBool:
+		g.printf("err = evw.WriteBoolean(%s)\n", sel)
+	case kindInt32:
+		g.printf("err = evw.WriteInt32(%s)\n", sel)
+	case kindInt, kindInt64:
+		if f.kind == kindInt64 && !f.minSize {
+			g.printf("err = evw.WriteInt64(%s)\n", sel)
+			return
+		}
+		g.printf("if i64 := int64(%s); i64 >= -2147483648 && i64 <= 2147483647 {\n", sel)
+		g.printf("err = evw.WriteInt32(int32(i64))\n} else {\nerr = evw.WriteInt64(i64)\n}\n")
+	case kindFloat64:
+		g.printf("err = evw.WriteDouble(%s)\n", sel)
+	case kindString:
+		g.printf("err = evw.WriteString(%s)\n", sel)
+	case kindObjectID:
+		g.printf("err = evw.WriteObjectID(%s)\n", sel)
+	}
+}
+
+// readValue writes statements that decode the field directly if the BSON type of vr matches it exactly.
+func (f field) readValue(g *generator) {
+	sel := f.selector()
+	read := func(bsonType, method, conv string) {
+		g.printf("if vr.Type() == bsontype.%s {\n", bsonType)
+		g.printf("v, err := vr.%s()\nif err != nil {\n", method)
+		g.printf("return bsoncodec.WrapDecodeError(%q, bsontype.%s, reflect.TypeOf(%s), err)\n}\n", f.name, bsonType, sel)
+		val := "v"
+		if conv != "" {
+			val = conv + "(v)"
+		}
+		g.printf("%s = %s\nreturn nil\n}\n", sel, val)
+	}
+	switch f.kind {
+	case kindBool:
+		read("Boolean", "ReadBoolean", "")
+	case kindInt:
+		read("Int32", "ReadInt32", "int")
+	case kindInt32:
+		read("Int32", "ReadInt32", "")
+	case kindInt64:
+		read("Int32", "ReadInt32", "int64")
+		read("Int64", "ReadInt64", "")
+	case kindFloat64:
+		read("Double", "ReadDouble", "")
+	case kindString:
+		read("String", "ReadString", "")
+	case kindObjectID:
+		read("ObjectID", "ReadObjectID", "")
+	}
+}
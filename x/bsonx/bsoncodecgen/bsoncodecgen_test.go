@@ -0,0 +1,97 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsoncodecgen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("matches checked in code", func(t *testing.T) {
+		dir := filepath.Join("internal", "gentest")
+		pkg, err := ParseDir(dir)
+		require.NoError(t, err, "ParseDir error")
+
+		var structs []*Struct
+		for _, name := range []string{"Everything", "Simple"} {
+			s, err := pkg.Struct(name)
+			require.NoError(t, err, "Struct error")
+			structs = append(structs, s)
+		}
+
+		var b bytes.Buffer
+		err = pkg.Generate(&b, "RegisterBSONCodecs", structs...)
+		require.NoError(t, err, "Generate error")
+
+		want, err := ioutil.ReadFile(filepath.Join(dir, "types_bsoncodec.go"))
+		require.NoError(t, err, "ReadFile error")
+		require.Equal(t, string(want), b.String(), "generated code is out of date; run go generate in %s", dir)
+	})
+	t.Run("errors", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			src    string
+			errMsg string
+		}{
+			{"not found", "type T int", "struct type T not found"},
+			{"duplicate key", "type T struct{ A int; B int `bson:\"a\"` }", "duplicated key a"},
+			{
+				"duplicate inline key",
+				"type I struct{ A int }\ntype T struct{ A int; I `bson:\",inline\"` }",
+				"duplicated key a",
+			},
+			{
+				"multiple inline maps",
+				"type T struct{ A map[string]int `bson:\",inline\"`; B map[string]int `bson:\",inline\"` }",
+				"multiple inline maps",
+			},
+			{"inline map key", "type T struct{ A map[int]int `bson:\",inline\"` }", "string keys"},
+			{
+				"inline other package",
+				"import \"time\"\ntype T struct{ A time.Time `bson:\",inline\"` }",
+				"cannot inline field A",
+			},
+			{"recursive inline", "type T struct{ *T `bson:\",inline\"` }", "recursive inline"},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				pkg, err := ParseSource("t.go", "package p\n"+tc.src)
+				require.NoError(t, err, "ParseSource error")
+				_, err = pkg.Struct("T")
+				require.Error(t, err, "expected error")
+				require.True(t, strings.Contains(err.Error(), tc.errMsg),
+					"expected error containing %q, got %v", tc.errMsg, err)
+			})
+		}
+	})
+	t.Run("field kinds", func(t *testing.T) {
+		src := `package p
+import prim "go.mongodb.org/mongo-driver/bson/primitive"
+type string int
+type T struct {
+	A string
+	B prim.ObjectID
+	C float64
+}`
+		pkg, err := ParseSource("t.go", src)
+		require.NoError(t, err, "ParseSource error")
+		s, err := pkg.Struct("T")
+		require.NoError(t, err, "Struct error")
+
+		want := []fieldKind{kindOther, kindObjectID, kindFloat64}
+		require.Equal(t, len(want), len(s.fields), "expected %d fields, got %d", len(want), len(s.fields))
+		for i, f := range s.fields {
+			require.Equal(t, want[i], f.kind, "kind mismatch for field %s", f.name)
+		}
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package gentest contains types used to test that generated codecs behave like the default StructCodec.
+package gentest
+
+//go:generate bsoncodecgen -type Everything,Simple -output types_bsoncodec.go
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Inner is inlined by value into Everything.
+type Inner struct {
+	A int32  `bson:"a"`
+	B string `bson:",omitempty"`
+}
+
+// Nested is inlined by pointer into Everything and is also used as a subdocument.
+type Nested struct {
+	C int64 `bson:"c,minsize"`
+	D bool  `bson:"d,omitempty"`
+}
+
+// Everything has a field for each struct tag option and each directly handled type.
+type Everything struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	Bool       bool
+	Int        int
+	Int32      int32   `bson:"i32,omitempty"`
+	Int64      int64   `bson:"i64,minsize"`
+	Int64Trunc int64   `bson:",truncate"`
+	Float      float64 `bson:",omitempty"`
+	Str        string
+	Uint       uint `bson:",minsize"`
+	Time       time.Time
+	Slice      []string
+	Map        map[string]int `bson:",omitempty"`
+	Ptr        *int64         `bson:",omitempty"`
+	Iface      interface{}
+	Doc        Nested
+	Skipped    string `bson:"-"`
+	unexported int
+
+	Inner   `bson:",inline"`
+	*Nested `bson:",inline"`
+	Extra   map[string]interface{} `bson:",inline"`
+}
+
+// Simple has only directly handled fields.
+type Simple struct {
+	Name  string
+	Count int
+}
@@ -0,0 +1,684 @@
+// Code generated by bsoncodecgen. DO NOT EDIT.
+
+package gentest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// RegisterBSONCodecs registers the generated BSON codecs with rb.
+func RegisterBSONCodecs(rb *bsoncodec.RegistryBuilder) *bsoncodec.RegistryBuilder {
+	rb.RegisterTypeEncoder(everythingBSONCodecType, everythingBSONCodec{})
+	rb.RegisterTypeDecoder(everythingBSONCodecType, everythingBSONCodec{})
+	rb.RegisterTypeEncoder(simpleBSONCodecType, simpleBSONCodec{})
+	rb.RegisterTypeDecoder(simpleBSONCodecType, simpleBSONCodec{})
+	return rb
+}
+
+var everythingBSONCodecType = reflect.TypeOf(Everything{})
+
+// everythingBSONCodec is the generated BSON codec for Everything.
+type everythingBSONCodec struct{}
+
+// EncodeValue implements the bsoncodec.ValueEncoder interface.
+func (c everythingBSONCodec) EncodeValue(ec bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != everythingBSONCodecType {
+		return bsoncodec.ValueEncoderError{Name: "everythingBSONCodec.EncodeValue", Types: []reflect.Type{everythingBSONCodecType}, Received: val}
+	}
+
+	var s *Everything
+	if val.CanAddr() {
+		s = val.Addr().Interface().(*Everything)
+	} else {
+		v := val.Interface().(Everything)
+		s = &v
+	}
+
+	dw, err := vw.WriteDocument()
+	if err != nil {
+		return err
+	}
+	var evw bsonrw.ValueWriter
+
+	if !s.ID.IsZero() {
+		evw, err = dw.WriteDocumentElement("_id")
+		if err != nil {
+			return err
+		}
+		err = evw.WriteObjectID(s.ID)
+		if err != nil {
+			return bsoncodec.WrapEncodeError("_id", reflect.ValueOf(s.ID), err)
+		}
+	}
+
+	evw, err = dw.WriteDocumentElement("bool")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteBoolean(s.Bool)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("bool", reflect.ValueOf(s.Bool), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("int")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.Int); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("int", reflect.ValueOf(s.Int), err)
+	}
+
+	if s.Int32 != 0 {
+		evw, err = dw.WriteDocumentElement("i32")
+		if err != nil {
+			return err
+		}
+		err = evw.WriteInt32(s.Int32)
+		if err != nil {
+			return bsoncodec.WrapEncodeError("i32", reflect.ValueOf(s.Int32), err)
+		}
+	}
+
+	evw, err = dw.WriteDocumentElement("i64")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.Int64); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("i64", reflect.ValueOf(s.Int64), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("int64trunc")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt64(s.Int64Trunc)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("int64trunc", reflect.ValueOf(s.Int64Trunc), err)
+	}
+
+	if s.Float != 0 {
+		evw, err = dw.WriteDocumentElement("float")
+		if err != nil {
+			return err
+		}
+		err = evw.WriteDouble(s.Float)
+		if err != nil {
+			return bsoncodec.WrapEncodeError("float", reflect.ValueOf(s.Float), err)
+		}
+	}
+
+	evw, err = dw.WriteDocumentElement("str")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.Str)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("str", reflect.ValueOf(s.Str), err)
+	}
+
+	err = bsoncodec.EncodeStructField(ec, dw, "uint", val.Field(8), false, true)
+	if err != nil {
+		return err
+	}
+
+	err = bsoncodec.EncodeStructField(ec, dw, "time", val.Field(9), false, false)
+	if err != nil {
+		return err
+	}
+
+	err = bsoncodec.EncodeStructField(ec, dw, "slice", val.Field(10), false, false)
+	if err != nil {
+		return err
+	}
+
+	err = bsoncodec.EncodeStructField(ec, dw, "map", val.Field(11), true, false)
+	if err != nil {
+		return err
+	}
+
+	err = bsoncodec.EncodeStructField(ec, dw, "ptr", val.Field(12), true, false)
+	if err != nil {
+		return err
+	}
+
+	err = bsoncodec.EncodeStructField(ec, dw, "iface", val.Field(13), false, false)
+	if err != nil {
+		return err
+	}
+
+	err = bsoncodec.EncodeStructField(ec, dw, "doc", val.Field(14), false, false)
+	if err != nil {
+		return err
+	}
+
+	evw, err = dw.WriteDocumentElement("a")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteInt32(s.Inner.A)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("a", reflect.ValueOf(s.Inner.A), err)
+	}
+
+	if s.Inner.B != "" {
+		evw, err = dw.WriteDocumentElement("b")
+		if err != nil {
+			return err
+		}
+		err = evw.WriteString(s.Inner.B)
+		if err != nil {
+			return bsoncodec.WrapEncodeError("b", reflect.ValueOf(s.Inner.B), err)
+		}
+	}
+
+	if s.Nested != nil {
+		evw, err = dw.WriteDocumentElement("c")
+		if err != nil {
+			return err
+		}
+		if i64 := int64(s.Nested.C); i64 >= -2147483648 && i64 <= 2147483647 {
+			err = evw.WriteInt32(int32(i64))
+		} else {
+			err = evw.WriteInt64(i64)
+		}
+		if err != nil {
+			return bsoncodec.WrapEncodeError("c", reflect.ValueOf(s.Nested.C), err)
+		}
+	}
+
+	if s.Nested != nil && s.Nested.D {
+		evw, err = dw.WriteDocumentElement("d")
+		if err != nil {
+			return err
+		}
+		err = evw.WriteBoolean(s.Nested.D)
+		if err != nil {
+			return bsoncodec.WrapEncodeError("d", reflect.ValueOf(s.Nested.D), err)
+		}
+	}
+
+	return bsoncodec.EncodeInlineMap(ec, dw, val.Field(19), c.hasField)
+}
+
+// DecodeValue implements the bsoncodec.ValueDecoder interface.
+func (c everythingBSONCodec) DecodeValue(dc bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != everythingBSONCodecType {
+		return bsoncodec.ValueDecoderError{Name: "everythingBSONCodec.DecodeValue", Types: []reflect.Type{everythingBSONCodecType}, Received: val}
+	}
+
+	switch vrType := vr.Type(); vrType {
+	case bsontype.Type(0), bsontype.EmbeddedDocument:
+	case bsontype.Null:
+		if err := vr.ReadNull(); err != nil {
+			return err
+		}
+		val.Set(reflect.Zero(val.Type()))
+		return nil
+	case bsontype.Undefined:
+		if err := vr.ReadUndefined(); err != nil {
+			return err
+		}
+		val.Set(reflect.Zero(val.Type()))
+		return nil
+	default:
+		return fmt.Errorf("cannot decode %v into a %s", vrType, val.Type())
+	}
+
+	s := val.Addr().Interface().(*Everything)
+	dr, err := vr.ReadDocument()
+	if err != nil {
+		return err
+	}
+
+	var seenFields []bool
+	var seenKeys map[string]struct{}
+	if dc.Strict {
+		seenFields = make([]bool, 19)
+	}
+
+	for {
+		name, evr, err := dr.ReadElement()
+		if err == bsonrw.ErrEOD {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		idx := c.fieldIndex(name)
+		if idx < 0 {
+			// Field names are matched case-insensitively if there is no exact match.
+			idx = c.fieldIndex(strings.ToLower(name))
+		}
+		if idx >= 0 {
+			if dc.Strict {
+				if seenFields[idx] {
+					return bsoncodec.NewDecodeError(name, bsoncodec.ErrDuplicateField)
+				}
+				seenFields[idx] = true
+			}
+			err = c.decodeField(dc, evr, val, s, idx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if dc.Strict {
+			if _, dup := seenKeys[name]; dup {
+				return bsoncodec.NewDecodeError(name, bsoncodec.ErrDuplicateField)
+			}
+			if seenKeys == nil {
+				seenKeys = make(map[string]struct{})
+			}
+			seenKeys[name] = struct{}{}
+		}
+		err = bsoncodec.DecodeInlineMapElement(dc, evr, name, val.Field(19))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fieldIndex returns the index of the field with the BSON key name, or -1 if there is no such field.
+func (everythingBSONCodec) fieldIndex(name string) int {
+	switch name {
+	case "_id":
+		return 0
+	case "bool":
+		return 1
+	case "int":
+		return 2
+	case "i32":
+		return 3
+	case "i64":
+		return 4
+	case "int64trunc":
+		return 5
+	case "float":
+		return 6
+	case "str":
+		return 7
+	case "uint":
+		return 8
+	case "time":
+		return 9
+	case "slice":
+		return 10
+	case "map":
+		return 11
+	case "ptr":
+		return 12
+	case "iface":
+		return 13
+	case "doc":
+		return 14
+	case "a":
+		return 15
+	case "b":
+		return 16
+	case "c":
+		return 17
+	case "d":
+		return 18
+	}
+	return -1
+}
+
+func (everythingBSONCodec) decodeField(dc bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value, s *Everything, idx int) error {
+	switch idx {
+	case 0:
+		if vr.Type() == bsontype.ObjectID {
+			v, err := vr.ReadObjectID()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("_id", bsontype.ObjectID, reflect.TypeOf(s.ID), err)
+			}
+			s.ID = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "_id", val.Field(0), false)
+	case 1:
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("bool", bsontype.Boolean, reflect.TypeOf(s.Bool), err)
+			}
+			s.Bool = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "bool", val.Field(1), false)
+	case 2:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("int", bsontype.Int32, reflect.TypeOf(s.Int), err)
+			}
+			s.Int = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "int", val.Field(2), false)
+	case 3:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("i32", bsontype.Int32, reflect.TypeOf(s.Int32), err)
+			}
+			s.Int32 = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "i32", val.Field(3), false)
+	case 4:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("i64", bsontype.Int32, reflect.TypeOf(s.Int64), err)
+			}
+			s.Int64 = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("i64", bsontype.Int64, reflect.TypeOf(s.Int64), err)
+			}
+			s.Int64 = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "i64", val.Field(4), false)
+	case 5:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("int64trunc", bsontype.Int32, reflect.TypeOf(s.Int64Trunc), err)
+			}
+			s.Int64Trunc = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("int64trunc", bsontype.Int64, reflect.TypeOf(s.Int64Trunc), err)
+			}
+			s.Int64Trunc = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "int64trunc", val.Field(5), true)
+	case 6:
+		if vr.Type() == bsontype.Double {
+			v, err := vr.ReadDouble()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("float", bsontype.Double, reflect.TypeOf(s.Float), err)
+			}
+			s.Float = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "float", val.Field(6), false)
+	case 7:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("str", bsontype.String, reflect.TypeOf(s.Str), err)
+			}
+			s.Str = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "str", val.Field(7), false)
+	case 8:
+		return bsoncodec.DecodeStructField(dc, vr, "uint", val.Field(8), false)
+	case 9:
+		return bsoncodec.DecodeStructField(dc, vr, "time", val.Field(9), false)
+	case 10:
+		return bsoncodec.DecodeStructField(dc, vr, "slice", val.Field(10), false)
+	case 11:
+		return bsoncodec.DecodeStructField(dc, vr, "map", val.Field(11), false)
+	case 12:
+		return bsoncodec.DecodeStructField(dc, vr, "ptr", val.Field(12), false)
+	case 13:
+		return bsoncodec.DecodeStructField(dc, vr, "iface", val.Field(13), false)
+	case 14:
+		return bsoncodec.DecodeStructField(dc, vr, "doc", val.Field(14), false)
+	case 15:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("a", bsontype.Int32, reflect.TypeOf(s.Inner.A), err)
+			}
+			s.Inner.A = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "a", val.Field(17).Field(0), false)
+	case 16:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("b", bsontype.String, reflect.TypeOf(s.Inner.B), err)
+			}
+			s.Inner.B = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "b", val.Field(17).Field(1), false)
+	case 17:
+		if s.Nested == nil {
+			s.Nested = new(Nested)
+		}
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("c", bsontype.Int32, reflect.TypeOf(s.Nested.C), err)
+			}
+			s.Nested.C = int64(v)
+			return nil
+		}
+		if vr.Type() == bsontype.Int64 {
+			v, err := vr.ReadInt64()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("c", bsontype.Int64, reflect.TypeOf(s.Nested.C), err)
+			}
+			s.Nested.C = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "c", val.Field(18).Elem().Field(0), false)
+	case 18:
+		if s.Nested == nil {
+			s.Nested = new(Nested)
+		}
+		if vr.Type() == bsontype.Boolean {
+			v, err := vr.ReadBoolean()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("d", bsontype.Boolean, reflect.TypeOf(s.Nested.D), err)
+			}
+			s.Nested.D = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "d", val.Field(18).Elem().Field(1), false)
+	}
+	return nil
+}
+
+func (everythingBSONCodec) hasField(key string) bool {
+	switch key {
+	case "_id", "bool", "int", "i32", "i64", "int64trunc", "float", "str", "uint", "time", "slice", "map", "ptr", "iface", "doc", "a", "b", "c", "d":
+		return true
+	}
+	return false
+}
+
+var simpleBSONCodecType = reflect.TypeOf(Simple{})
+
+// simpleBSONCodec is the generated BSON codec for Simple.
+type simpleBSONCodec struct{}
+
+// EncodeValue implements the bsoncodec.ValueEncoder interface.
+func (c simpleBSONCodec) EncodeValue(ec bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != simpleBSONCodecType {
+		return bsoncodec.ValueEncoderError{Name: "simpleBSONCodec.EncodeValue", Types: []reflect.Type{simpleBSONCodecType}, Received: val}
+	}
+
+	var s *Simple
+	if val.CanAddr() {
+		s = val.Addr().Interface().(*Simple)
+	} else {
+		v := val.Interface().(Simple)
+		s = &v
+	}
+
+	dw, err := vw.WriteDocument()
+	if err != nil {
+		return err
+	}
+	var evw bsonrw.ValueWriter
+
+	evw, err = dw.WriteDocumentElement("name")
+	if err != nil {
+		return err
+	}
+	err = evw.WriteString(s.Name)
+	if err != nil {
+		return bsoncodec.WrapEncodeError("name", reflect.ValueOf(s.Name), err)
+	}
+
+	evw, err = dw.WriteDocumentElement("count")
+	if err != nil {
+		return err
+	}
+	if i64 := int64(s.Count); i64 >= -2147483648 && i64 <= 2147483647 {
+		err = evw.WriteInt32(int32(i64))
+	} else {
+		err = evw.WriteInt64(i64)
+	}
+	if err != nil {
+		return bsoncodec.WrapEncodeError("count", reflect.ValueOf(s.Count), err)
+	}
+
+	return dw.WriteDocumentEnd()
+}
+
+// DecodeValue implements the bsoncodec.ValueDecoder interface.
+func (c simpleBSONCodec) DecodeValue(dc bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != simpleBSONCodecType {
+		return bsoncodec.ValueDecoderError{Name: "simpleBSONCodec.DecodeValue", Types: []reflect.Type{simpleBSONCodecType}, Received: val}
+	}
+
+	switch vrType := vr.Type(); vrType {
+	case bsontype.Type(0), bsontype.EmbeddedDocument:
+	case bsontype.Null:
+		if err := vr.ReadNull(); err != nil {
+			return err
+		}
+		val.Set(reflect.Zero(val.Type()))
+		return nil
+	case bsontype.Undefined:
+		if err := vr.ReadUndefined(); err != nil {
+			return err
+		}
+		val.Set(reflect.Zero(val.Type()))
+		return nil
+	default:
+		return fmt.Errorf("cannot decode %v into a %s", vrType, val.Type())
+	}
+
+	s := val.Addr().Interface().(*Simple)
+	dr, err := vr.ReadDocument()
+	if err != nil {
+		return err
+	}
+
+	var seenFields []bool
+	if dc.Strict {
+		seenFields = make([]bool, 2)
+	}
+
+	for {
+		name, evr, err := dr.ReadElement()
+		if err == bsonrw.ErrEOD {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		idx := c.fieldIndex(name)
+		if idx < 0 {
+			// Field names are matched case-insensitively if there is no exact match.
+			idx = c.fieldIndex(strings.ToLower(name))
+		}
+		if idx >= 0 {
+			if dc.Strict {
+				if seenFields[idx] {
+					return bsoncodec.NewDecodeError(name, bsoncodec.ErrDuplicateField)
+				}
+				seenFields[idx] = true
+			}
+			err = c.decodeField(dc, evr, val, s, idx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if dc.Strict {
+			return bsoncodec.NewDecodeError(name, bsoncodec.ErrUnknownField)
+		}
+		err = evr.Skip()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fieldIndex returns the index of the field with the BSON key name, or -1 if there is no such field.
+func (simpleBSONCodec) fieldIndex(name string) int {
+	switch name {
+	case "name":
+		return 0
+	case "count":
+		return 1
+	}
+	return -1
+}
+
+func (simpleBSONCodec) decodeField(dc bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value, s *Simple, idx int) error {
+	switch idx {
+	case 0:
+		if vr.Type() == bsontype.String {
+			v, err := vr.ReadString()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("name", bsontype.String, reflect.TypeOf(s.Name), err)
+			}
+			s.Name = v
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "name", val.Field(0), false)
+	case 1:
+		if vr.Type() == bsontype.Int32 {
+			v, err := vr.ReadInt32()
+			if err != nil {
+				return bsoncodec.WrapDecodeError("count", bsontype.Int32, reflect.TypeOf(s.Count), err)
+			}
+			s.Count = int(v)
+			return nil
+		}
+		return bsoncodec.DecodeStructField(dc, vr, "count", val.Field(1), false)
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package gentest
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var generatedRegistry = RegisterBSONCodecs(bson.NewRegistryBuilder()).Build()
+
+func TestGeneratedEncoding(t *testing.T) {
+	i64 := int64(42)
+	testCases := []struct {
+		name string
+		val  interface{}
+	}{
+		{"empty", Everything{}},
+		{"empty pointer", &Everything{}},
+		{"simple", Simple{Name: "foo", Count: math.MaxInt32 + 1}},
+		{
+			"populated",
+			Everything{
+				ID:         primitive.NewObjectID(),
+				Bool:       true,
+				Int:        -1,
+				Int32:      32,
+				Int64:      math.MaxInt32,
+				Int64Trunc: math.MinInt32 - 1,
+				Float:      3.14,
+				Str:        "hello",
+				Uint:       7,
+				Time:       time.Date(2020, 1, 2, 3, 4, 5, 6e6, time.UTC),
+				Slice:      []string{"a", "b"},
+				Map:        map[string]int{"x": 1},
+				Ptr:        &i64,
+				Iface:      bson.D{{"nested", int64(1)}},
+				Doc:        Nested{C: math.MaxInt64, D: true},
+				Skipped:    "skipped",
+				unexported: 1,
+				Inner:      Inner{A: 1, B: "b"},
+				Nested:     &Nested{C: 5},
+				Extra:      map[string]interface{}{"extra": "value"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			want, err := bson.Marshal(tc.val)
+			require.NoError(t, err, "Marshal error")
+			got, err := bson.MarshalWithRegistry(generatedRegistry, tc.val)
+			require.NoError(t, err, "MarshalWithRegistry error")
+			require.Equal(t, want, got, "expected %v, got %v", bson.Raw(want), bson.Raw(got))
+		})
+	}
+
+	t.Run("inline map collision", func(t *testing.T) {
+		val := Everything{Extra: map[string]interface{}{"str": "value"}}
+		_, want := bson.Marshal(val)
+		require.Error(t, want, "expected Marshal error")
+		_, got := bson.MarshalWithRegistry(generatedRegistry, val)
+		require.Equal(t, want, got, "expected error %v, got %v", want, got)
+	})
+}
+
+func TestGeneratedDecoding(t *testing.T) {
+	oid := primitive.NewObjectID()
+	testCases := []struct {
+		name string
+		doc  []byte
+	}{
+		{"empty", marshal(t, bson.D{})},
+		{
+			"exact types",
+			marshal(t, bson.D{
+				{"_id", oid},
+				{"bool", true},
+				{"int", int32(1)},
+				{"i32", int32(2)},
+				{"i64", int64(3)},
+				{"int64trunc", int32(4)},
+				{"float", 5.5},
+				{"str", "six"},
+				{"uint", int64(7)},
+				{"time", primitive.DateTime(8)},
+				{"a", int32(9)},
+				{"b", "ten"},
+				{"c", int32(11)},
+				{"d", true},
+				{"unknown", "extra"},
+			}),
+		},
+		{
+			"conversions",
+			marshal(t, bson.D{
+				{"_id", "abcdefghijkl"},
+				{"bool", int32(1)},
+				{"int", int64(1)},
+				{"int64trunc", 4.5},
+				{"float", int32(5)},
+				{"str", primitive.Symbol("six")},
+				{"doc", primitive.Null{}},
+			}),
+		},
+		{
+			"case insensitive names",
+			marshal(t, bson.D{{"BOOL", true}, {"Str", "str"}, {"A", int32(1)}}),
+		},
+		{
+			"wrong type",
+			marshal(t, bson.D{{"int", "1"}}),
+		},
+		{
+			"truncation not allowed",
+			marshal(t, bson.D{{"i64", float64(4.5)}}),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var want, got Everything
+			wantErr := bson.Unmarshal(tc.doc, &want)
+			gotErr := bson.UnmarshalWithRegistry(generatedRegistry, tc.doc, &got)
+			if wantErr != nil {
+				require.NotNil(t, gotErr, "expected error %v, got nil", wantErr)
+				require.Equal(t, wantErr.Error(), gotErr.Error(), "error mismatch")
+				return
+			}
+			require.NoError(t, gotErr, "UnmarshalWithRegistry error")
+			require.Equal(t, want, got, "decoded value mismatch")
+		})
+	}
+
+	t.Run("strict", func(t *testing.T) {
+		docs := []bson.D{
+			{{"str", "a"}, {"unknown", "b"}},
+			{{"str", "a"}, {"STR", "b"}},
+			{{"a", int32(1)}, {"a", int32(2)}},
+		}
+		for _, doc := range docs {
+			b := marshal(t, doc)
+			var want, got Simple
+			wantErr := decodeStrict(t, bson.DefaultRegistry, b, &want)
+			gotErr := decodeStrict(t, generatedRegistry, b, &got)
+			require.NotNil(t, wantErr, "expected error decoding %v", doc)
+			require.NotNil(t, gotErr, "expected error decoding %v", doc)
+			require.Equal(t, wantErr.Error(), gotErr.Error(), "error mismatch")
+
+			var wantAll, gotAll Everything
+			wantErr = decodeStrict(t, bson.DefaultRegistry, b, &wantAll)
+			gotErr = decodeStrict(t, generatedRegistry, b, &gotAll)
+			if wantErr == nil {
+				require.Nil(t, gotErr, "expected no error, got %v", gotErr)
+				require.Equal(t, wantAll, gotAll, "decoded value mismatch")
+				continue
+			}
+			require.NotNil(t, gotErr, "expected error %v, got nil", wantErr)
+			require.Equal(t, wantErr.Error(), gotErr.Error(), "error mismatch")
+		}
+	})
+	t.Run("null", func(t *testing.T) {
+		doc := marshal(t, bson.D{{"s", primitive.Null{}}})
+		got := struct{ S Simple }{S: Simple{Name: "foo"}}
+		err := bson.UnmarshalWithRegistry(generatedRegistry, doc, &got)
+		require.NoError(t, err, "UnmarshalWithRegistry error")
+		require.Equal(t, Simple{}, got.S, "expected zero value, got %v", got.S)
+	})
+	t.Run("invalid type", func(t *testing.T) {
+		doc := marshal(t, bson.D{{"s", "foo"}})
+		var want, got struct{ S Simple }
+		wantErr := bson.Unmarshal(doc, &want)
+		gotErr := bson.UnmarshalWithRegistry(generatedRegistry, doc, &got)
+		require.NotNil(t, gotErr, "expected error, got nil")
+		require.Equal(t, wantErr.Error(), gotErr.Error(), "error mismatch")
+	})
+}
+
+func marshal(t *testing.T, doc bson.D) []byte {
+	t.Helper()
+
+	b, err := bson.Marshal(doc)
+	require.NoError(t, err, "Marshal error")
+	return b
+}
+
+func decodeStrict(t *testing.T, r *bsoncodec.Registry, b []byte, val interface{}) error {
+	t.Helper()
+
+	dec, err := bson.NewDecoder(bsonrw.NewBSONDocumentReader(b))
+	require.NoError(t, err, "NewDecoder error")
+	err = dec.SetRegistry(r)
+	require.NoError(t, err, "SetRegistry error")
+	err = dec.SetStrict(true)
+	require.NoError(t, err, "SetStrict error")
+	return dec.Decode(val)
+}
@@ -0,0 +1,314 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsoncore
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// EditConflictError is returned when the edits of a DocumentEditor conflict, either because they modify the same key
+// or because one edit modifies a key that is within a value modified by another edit.
+type EditConflictError struct {
+	Key []string
+}
+
+func (ece EditConflictError) Error() string {
+	return fmt.Sprintf("conflicting edits for key %s", strings.Join(ece.Key, "."))
+}
+
+type editOp uint8
+
+const (
+	editNone editOp = iota
+	editSet
+	editUnset
+	editAppend
+	editRename
+)
+
+// editNode is a node in the tree of edits of a DocumentEditor. A node either has an operation or children.
+type editNode struct {
+	op     editOp
+	value  Value   // The value for editSet.
+	values []Value // The values for editAppend.
+	newKey string  // The new key for editRename.
+	index  int     // The position of the node in the keys of its parent.
+
+	keys     []string // The keys of the children in the order they were added.
+	children map[string]*editNode
+	renames  map[string]string // Maps the new keys of renamed children to their old keys.
+}
+
+// DocumentEditor modifies a Document without decoding it. Edits are recorded with the Set, Unset, Append, and Rename
+// methods and are applied with Apply, which copies the document in a single pass and only rewrites the elements that
+// are edited and the length prefixes of the documents and arrays that enclose them.
+//
+// Keys that descend into an array must be the decimal position of an element in the array. If a document contains a
+// key more than once, an edit applies to the first element with the key.
+//
+// A DocumentEditor can be applied to any number of documents, but it is not safe to add edits concurrently.
+type DocumentEditor struct {
+	root editNode
+	err  error
+}
+
+// NewDocumentEditor returns a DocumentEditor without any edits.
+func NewDocumentEditor() *DocumentEditor {
+	return &DocumentEditor{}
+}
+
+// Set sets the value at the given key path to val. If the key does not exist, it is added to the end of its document
+// and any missing documents along the path are created.
+func (de *DocumentEditor) Set(val Value, key ...string) *DocumentEditor {
+	if n := de.node(key, false); n != nil {
+		n.op, n.value = editSet, val
+	}
+	return de
+}
+
+// Unset removes the element at the given key path. Removing an element from an array shifts the positions of the
+// elements after it. Unsetting a key that does not exist has no effect.
+func (de *DocumentEditor) Unset(key ...string) *DocumentEditor {
+	if n := de.node(key, false); n != nil {
+		n.op = editUnset
+	}
+	return de
+}
+
+// Append appends val to the array at the given key path. If the key does not exist, an array containing val is
+// created. Applying the edit to a document in which the key holds a value that is not an array returns an error.
+func (de *DocumentEditor) Append(val Value, key ...string) *DocumentEditor {
+	if n := de.node(key, true); n != nil {
+		n.op = editAppend
+		n.values = append(n.values, val)
+	}
+	return de
+}
+
+// Rename changes the key of the element at the given key path to newKey. If the document already contains newKey,
+// that element is removed. Renaming a key that does not exist has no effect.
+func (de *DocumentEditor) Rename(newKey string, key ...string) *DocumentEditor {
+	n := de.node(key, false)
+	if n == nil {
+		return de
+	}
+
+	parent := &de.root
+	for _, k := range key[:len(key)-1] {
+		parent = parent.children[k]
+	}
+	oldKey := key[len(key)-1]
+	if _, exists := parent.children[newKey]; exists || parent.renames[newKey] != "" || newKey == oldKey {
+		de.err = EditConflictError{Key: append(key[:len(key)-1:len(key)-1], newKey)}
+		return de
+	}
+	if parent.renames == nil {
+		parent.renames = make(map[string]string)
+	}
+	parent.renames[newKey] = oldKey
+	n.op, n.newKey = editRename, newKey
+	return de
+}
+
+// node returns the node for key, creating it and the nodes along the path if they don't exist yet. If the edit for
+// key conflicts with an existing edit, the error is recorded and nil is returned. Appends may be combined with earlier
+// appends to the same key if combine is true.
+func (de *DocumentEditor) node(key []string, combine bool) *editNode {
+	if de.err != nil {
+		return nil
+	}
+	if len(key) < 1 {
+		de.err = ErrEmptyKey
+		return nil
+	}
+
+	n := &de.root
+	for i, k := range key {
+		if n.op != editNone || n.renames[k] != "" {
+			de.err = EditConflictError{Key: key[:i+1]}
+			return nil
+		}
+		child, ok := n.children[k]
+		if !ok {
+			if n.children == nil {
+				n.children = make(map[string]*editNode)
+			}
+			child = &editNode{index: len(n.keys)}
+			n.children[k] = child
+			n.keys = append(n.keys, k)
+		}
+		n = child
+	}
+
+	if n.op == editAppend && combine {
+		return n
+	}
+	if n.op != editNone || len(n.keys) > 0 {
+		de.err = EditConflictError{Key: key}
+		return nil
+	}
+	return n
+}
+
+// Apply applies the edits to doc and appends the resulting document to dst. The document is not modified.
+func (de *DocumentEditor) Apply(dst []byte, doc Document) ([]byte, error) {
+	if de.err != nil {
+		return dst, de.err
+	}
+	out, err := de.root.apply(dst, doc, false)
+	if err != nil {
+		return dst, err
+	}
+	return out, nil
+}
+
+// emptyDocument is used to create documents and arrays that are missing from the edited document.
+var emptyDocument = Document{0x05, 0x00, 0x00, 0x00, 0x00}
+
+// apply appends doc to dst with the edits of n and its children applied. If array is true, the elements are
+// renumbered and the values of an editAppend are added to the end.
+func (n *editNode) apply(dst []byte, doc Document, array bool) ([]byte, error) {
+	length, rem, ok := ReadLength(doc)
+	if !ok {
+		return dst, NewInsufficientBytesError(doc, rem)
+	}
+	if int(length) > len(doc) {
+		return dst, doc.lengtherror(int(length), len(doc))
+	}
+
+	// The elements with the new key of a rename are only removed if the renamed element exists.
+	var replaced map[string]bool
+	for newKey, oldKey := range n.renames {
+		if _, err := doc.LookupErr(oldKey); err == nil {
+			if replaced == nil {
+				replaced = make(map[string]bool)
+			}
+			replaced[newKey] = true
+		}
+	}
+
+	var pos int
+	header := func(dst []byte, t bsontype.Type, key []byte) []byte {
+		dst = AppendType(dst, t)
+		if array {
+			dst = strconv.AppendInt(dst, int64(pos), 10)
+			pos++
+		} else {
+			dst = append(dst, key...)
+		}
+		return append(dst, 0x00)
+	}
+
+	var seen []bool
+	if len(n.keys) > 0 {
+		seen = make([]bool, len(n.keys))
+	}
+
+	idx, dst := ReserveLength(dst)
+	length -= 4
+	var elem Element
+	var err error
+	for length > 1 {
+		elem, rem, ok = ReadElement(rem)
+		length -= int32(len(elem))
+		if !ok {
+			return dst, NewInsufficientBytesError(doc, rem)
+		}
+		key := elem.KeyBytes()
+		t := bsontype.Type(elem[0])
+		data := Document(elem[len(key)+2:])
+
+		child := n.children[string(key)]
+		if child != nil && seen[child.index] {
+			child = nil
+		}
+		if child == nil {
+			if replaced[string(key)] {
+				continue
+			}
+			if array {
+				dst = append(header(dst, t, key), data...)
+			} else {
+				dst = append(dst, elem...)
+			}
+			continue
+		}
+		seen[child.index] = true
+
+		switch child.op {
+		case editUnset:
+		case editSet:
+			dst = append(header(dst, child.value.Type, key), child.value.Data...)
+		case editRename:
+			if array {
+				return dst, ElementTypeError{"bsoncore.DocumentEditor.Rename", bsontype.Array}
+			}
+			dst = append(header(dst, t, []byte(child.newKey)), data...)
+		case editAppend:
+			if t != bsontype.Array {
+				return dst, ElementTypeError{"bsoncore.DocumentEditor.Append", t}
+			}
+			dst, err = child.apply(header(dst, t, key), data, true)
+		default:
+			if t != bsontype.EmbeddedDocument && t != bsontype.Array {
+				return dst, InvalidDepthTraversalError{Key: string(key), Type: t}
+			}
+			dst, err = child.apply(header(dst, t, key), data, t == bsontype.Array)
+		}
+		if err != nil {
+			return dst, err
+		}
+	}
+
+	for i, k := range n.keys {
+		child := n.children[k]
+		if seen[i] || !child.creates() {
+			continue
+		}
+		if array {
+			return dst, ErrOutOfBounds
+		}
+		switch child.op {
+		case editSet:
+			dst = append(header(dst, child.value.Type, []byte(k)), child.value.Data...)
+		case editAppend:
+			dst, err = child.apply(header(dst, bsontype.Array, []byte(k)), emptyDocument, true)
+		default:
+			dst, err = child.apply(header(dst, bsontype.EmbeddedDocument, []byte(k)), emptyDocument, false)
+		}
+		if err != nil {
+			return dst, err
+		}
+	}
+
+	if array {
+		for _, val := range n.values {
+			dst = append(header(dst, val.Type, nil), val.Data...)
+		}
+	}
+	return AppendDocumentEnd(dst, idx)
+}
+
+// creates reports whether n or one of its descendants adds a value when the key of n is missing.
+func (n *editNode) creates() bool {
+	switch n.op {
+	case editSet, editAppend:
+		return true
+	case editUnset, editRename:
+		return false
+	}
+	for _, child := range n.children {
+		if child.creates() {
+			return true
+		}
+	}
+	return false
+}
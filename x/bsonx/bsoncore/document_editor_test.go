@@ -0,0 +1,214 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsoncore
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+func TestDocumentEditor(t *testing.T) {
+	i32 := func(i int32) Value { return Value{Type: bsontype.Int32, Data: AppendInt32(nil, i)} }
+	str := func(s string) Value { return Value{Type: bsontype.String, Data: AppendString(nil, s)} }
+
+	// {"a": 1, "b": {"c": "x", "d": [1, 2, 3]}, "e": "y"}
+	doc := BuildDocumentFromElements(nil,
+		AppendInt32Element(nil, "a", 1),
+		BuildDocumentElement(nil, "b",
+			AppendStringElement(nil, "c", "x"),
+			BuildArrayElement(nil, "d", i32(1), i32(2), i32(3)),
+		),
+		AppendStringElement(nil, "e", "y"),
+	)
+
+	testCases := []struct {
+		name   string
+		editor *DocumentEditor
+		want   []byte
+		err    error
+	}{
+		{
+			"no edits",
+			NewDocumentEditor(),
+			doc,
+			nil,
+		},
+		{
+			"set existing nested value",
+			NewDocumentEditor().Set(i32(5), "b", "c"),
+			BuildDocumentFromElements(nil,
+				AppendInt32Element(nil, "a", 1),
+				BuildDocumentElement(nil, "b",
+					AppendInt32Element(nil, "c", 5),
+					BuildArrayElement(nil, "d", i32(1), i32(2), i32(3)),
+				),
+				AppendStringElement(nil, "e", "y"),
+			),
+			nil,
+		},
+		{
+			"set missing values",
+			NewDocumentEditor().Set(i32(5), "f").Set(str("z"), "g", "h").Set(i32(6), "b", "i"),
+			BuildDocumentFromElements(nil,
+				AppendInt32Element(nil, "a", 1),
+				BuildDocumentElement(nil, "b",
+					AppendStringElement(nil, "c", "x"),
+					BuildArrayElement(nil, "d", i32(1), i32(2), i32(3)),
+					AppendInt32Element(nil, "i", 6),
+				),
+				AppendStringElement(nil, "e", "y"),
+				AppendInt32Element(nil, "f", 5),
+				BuildDocumentElement(nil, "g", AppendStringElement(nil, "h", "z")),
+			),
+			nil,
+		},
+		{
+			"set array element",
+			NewDocumentEditor().Set(str("two"), "b", "d", "1"),
+			BuildDocumentFromElements(nil,
+				AppendInt32Element(nil, "a", 1),
+				BuildDocumentElement(nil, "b",
+					AppendStringElement(nil, "c", "x"),
+					BuildArrayElement(nil, "d", i32(1), str("two"), i32(3)),
+				),
+				AppendStringElement(nil, "e", "y"),
+			),
+			nil,
+		},
+		{
+			"unset",
+			NewDocumentEditor().Unset("a").Unset("b", "d", "0").Unset("missing", "key"),
+			BuildDocumentFromElements(nil,
+				BuildDocumentElement(nil, "b",
+					AppendStringElement(nil, "c", "x"),
+					BuildArrayElement(nil, "d", i32(2), i32(3)),
+				),
+				AppendStringElement(nil, "e", "y"),
+			),
+			nil,
+		},
+		{
+			"append",
+			NewDocumentEditor().Append(i32(4), "b", "d").Append(i32(5), "b", "d").Append(str("z"), "f"),
+			BuildDocumentFromElements(nil,
+				AppendInt32Element(nil, "a", 1),
+				BuildDocumentElement(nil, "b",
+					AppendStringElement(nil, "c", "x"),
+					BuildArrayElement(nil, "d", i32(1), i32(2), i32(3), i32(4), i32(5)),
+				),
+				AppendStringElement(nil, "e", "y"),
+				BuildArrayElement(nil, "f", str("z")),
+			),
+			nil,
+		},
+		{
+			"rename",
+			NewDocumentEditor().Rename("z", "b", "c").Rename("e", "a").Rename("q", "missing"),
+			BuildDocumentFromElements(nil,
+				AppendInt32Element(nil, "e", 1),
+				BuildDocumentElement(nil, "b",
+					AppendStringElement(nil, "z", "x"),
+					BuildArrayElement(nil, "d", i32(1), i32(2), i32(3)),
+				),
+			),
+			nil,
+		},
+		{
+			"append to non-array",
+			NewDocumentEditor().Append(i32(1), "a"),
+			nil,
+			ElementTypeError{"bsoncore.DocumentEditor.Append", bsontype.Int32},
+		},
+		{
+			"traverse non-document",
+			NewDocumentEditor().Set(i32(1), "e", "f"),
+			nil,
+			InvalidDepthTraversalError{Key: "e", Type: bsontype.String},
+		},
+		{
+			"rename in array",
+			NewDocumentEditor().Rename("x", "b", "d", "0"),
+			nil,
+			ElementTypeError{"bsoncore.DocumentEditor.Rename", bsontype.Array},
+		},
+		{
+			"set missing array element",
+			NewDocumentEditor().Set(i32(1), "b", "d", "3"),
+			nil,
+			ErrOutOfBounds,
+		},
+		{
+			"empty key",
+			NewDocumentEditor().Unset(),
+			nil,
+			ErrEmptyKey,
+		},
+		{
+			"conflicting keys",
+			NewDocumentEditor().Set(i32(1), "a").Unset("a"),
+			nil,
+			EditConflictError{Key: []string{"a"}},
+		},
+		{
+			"conflicting prefix",
+			NewDocumentEditor().Set(i32(1), "b", "c").Unset("b"),
+			nil,
+			EditConflictError{Key: []string{"b"}},
+		},
+		{
+			"conflicting nested",
+			NewDocumentEditor().Unset("b").Set(i32(1), "b", "c"),
+			nil,
+			EditConflictError{Key: []string{"b", "c"}},
+		},
+		{
+			"conflicting rename",
+			NewDocumentEditor().Rename("e", "a").Set(i32(1), "e"),
+			nil,
+			EditConflictError{Key: []string{"e"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.editor.Apply(nil, doc)
+			if !reflect.DeepEqual(err, tc.err) {
+				t.Fatalf("Errors do not match. got %v; want %v", err, tc.err)
+			}
+			if !bytes.Equal(got, tc.want) {
+				t.Errorf("Documents do not match. got %v; want %v", Document(got), Document(tc.want))
+			}
+			if err == nil {
+				noerr(t, Document(got).Validate())
+			}
+		})
+	}
+
+	t.Run("appends to dst", func(t *testing.T) {
+		dst := []byte{0x01, 0x02}
+		got, err := NewDocumentEditor().Set(i32(5), "a").Apply(dst, doc)
+		noerr(t, err)
+		if !cmp.Equal(got[:2], dst) {
+			t.Errorf("Expected dst to be preserved, got %v", got[:2])
+		}
+		if v := Document(got[2:]).Lookup("a"); !cmp.Equal(v, i32(5)) {
+			t.Errorf("Values do not match. got %v; want %v", v, i32(5))
+		}
+	})
+	t.Run("invalid document", func(t *testing.T) {
+		invalid := make([]byte, len(doc))
+		copy(invalid, doc)
+		invalid[4] = 0xFF
+		_, err := NewDocumentEditor().Set(i32(1), "a").Apply(nil, invalid)
+		if !compareErrors(err, NewInsufficientBytesError(nil, nil)) {
+			t.Errorf("Errors do not match. got %v; want %v", err, NewInsufficientBytesError(nil, nil))
+		}
+	})
+}
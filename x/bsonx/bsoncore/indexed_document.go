@@ -0,0 +1,327 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsoncore
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// IndexedDocument is a read-only view of a Document that records the offsets of its elements the first time they are
+// needed. After the index is built, key lookups take O(log n) time and array index lookups take O(1) time instead of
+// rescanning the document. Embedded documents and arrays are indexed separately and only when a lookup descends into
+// them, so only the nesting levels that are actually accessed are indexed.
+//
+// Values and elements returned from an IndexedDocument are slices of the underlying Document and are not copied. An
+// IndexedDocument must not be modified concurrently, which includes the lazy indexing done by its lookup methods, so
+// it is not safe for concurrent use without external synchronization.
+type IndexedDocument struct {
+	doc     Document
+	array   bool
+	indexed bool
+	err     error
+
+	// elems holds the offsets of the elements in document order. byKey holds the positions of the elements in elems
+	// sorted by key. It is not built for arrays, whose elements are looked up by position.
+	elems    []indexEntry
+	byKey    []int
+	children []*IndexedDocument
+}
+
+// indexEntry holds the offsets of an element within a document. The key of the element is at doc[start+1:value-1]
+// and its value is at doc[value:end].
+type indexEntry struct {
+	start, value, end int
+}
+
+// NewIndexedDocument returns an IndexedDocument for doc. The document is not read until the first lookup.
+func NewIndexedDocument(doc Document) *IndexedDocument {
+	return &IndexedDocument{doc: doc}
+}
+
+// NewIndexedArray returns an IndexedDocument for arr. The elements of an indexed array are looked up by their
+// position instead of their key. The array is not read until the first lookup.
+func NewIndexedArray(arr Array) *IndexedDocument {
+	return &IndexedDocument{doc: arr, array: true}
+}
+
+// Document returns the underlying document.
+func (id *IndexedDocument) Document() Document { return id.doc }
+
+// Len returns the number of elements in the document. An error is returned if the document is invalid.
+func (id *IndexedDocument) Len() (int, error) {
+	if err := id.index(); err != nil {
+		return 0, err
+	}
+	return len(id.elems), nil
+}
+
+// Index retrieves the element at the given index. This method will panic if the document is invalid or if the index
+// is out of bounds.
+func (id *IndexedDocument) Index(index uint) Element {
+	elem, err := id.IndexErr(index)
+	if err != nil {
+		panic(err)
+	}
+	return elem
+}
+
+// IndexErr retrieves the element at the given index.
+func (id *IndexedDocument) IndexErr(index uint) (Element, error) {
+	if err := id.index(); err != nil {
+		return nil, err
+	}
+	if index >= uint(len(id.elems)) {
+		return nil, ErrOutOfBounds
+	}
+	e := id.elems[index]
+	return Element(id.doc[e.start:e.end]), nil
+}
+
+// Lookup searches the document, potentially recursively, for the given key. If there are multiple keys provided, this
+// method will recurse down, as long as the top and intermediate nodes are either documents or arrays. If an error
+// occurs or if the value doesn't exist, an empty Value is returned.
+func (id *IndexedDocument) Lookup(key ...string) Value {
+	val, _ := id.LookupErr(key...)
+	return val
+}
+
+// LookupErr is the same as Lookup, except it returns an error in addition to an empty Value. It returns the same
+// errors as Document.LookupErr. If the document contains the same key more than once, the first element with the key
+// is returned. Keys that descend into an array must be the decimal position of an element in the array.
+func (id *IndexedDocument) LookupErr(key ...string) (Value, error) {
+	if len(key) < 1 {
+		return Value{}, ErrEmptyKey
+	}
+
+	current := id
+	for i := 0; ; i++ {
+		pos, err := current.find(key[i])
+		if err != nil {
+			return Value{}, err
+		}
+		if i == len(key)-1 {
+			return current.value(pos), nil
+		}
+		child, err := current.child(pos)
+		if err != nil {
+			return Value{}, err
+		}
+		current = child
+	}
+}
+
+// LookupIndexed returns the IndexedDocument for the embedded document or array at the given key path. The returned
+// view is cached, so repeated lookups of values within the same embedded document only index it once.
+func (id *IndexedDocument) LookupIndexed(key ...string) (*IndexedDocument, error) {
+	current := id
+	for _, k := range key {
+		pos, err := current.find(k)
+		if err != nil {
+			return nil, err
+		}
+		child, err := current.child(pos)
+		if err != nil {
+			return nil, err
+		}
+		current = child
+	}
+	return current, nil
+}
+
+// Find calls fn with each value that matches path, in document order, until fn returns false. The path is a dot
+// separated list of keys, like "items.0.sku". A "*" component matches every element of a document or array, so
+// "items.*.sku" matches the sku of every element in items. Components that do not match anything, including
+// components that would traverse into a value that is neither a document nor an array, are skipped. An error is only
+// returned if the path is empty or a document on the path is invalid.
+//
+// Find does not allocate once the documents along the path have been indexed.
+func (id *IndexedDocument) Find(path string, fn func(Value) bool) error {
+	if path == "" {
+		return ErrEmptyKey
+	}
+	_, err := id.walk(path, fn)
+	return err
+}
+
+// FindAll returns all of the values that match path. See Find for the path syntax.
+func (id *IndexedDocument) FindAll(path string) ([]Value, error) {
+	var vals []Value
+	err := id.Find(path, func(v Value) bool {
+		vals = append(vals, v)
+		return true
+	})
+	return vals, err
+}
+
+// walk calls fn with the values matching path. It returns false if fn returned false.
+func (id *IndexedDocument) walk(path string, fn func(Value) bool) (bool, error) {
+	if err := id.index(); err != nil {
+		return false, err
+	}
+
+	component, rest := path, ""
+	last := true
+	if idx := strings.IndexByte(path, '.'); idx != -1 {
+		component, rest = path[:idx], path[idx+1:]
+		last = false
+	}
+
+	if component != "*" {
+		pos, err := id.find(component)
+		if err != nil {
+			return true, nil
+		}
+		return id.walkElement(pos, rest, last, fn)
+	}
+	for pos := range id.elems {
+		cont, err := id.walkElement(pos, rest, last, fn)
+		if err != nil || !cont {
+			return cont, err
+		}
+	}
+	return true, nil
+}
+
+// walkElement calls fn with the element at pos if last is true and otherwise with the values that match rest within
+// the element.
+func (id *IndexedDocument) walkElement(pos int, rest string, last bool, fn func(Value) bool) (bool, error) {
+	if last {
+		return fn(id.value(pos)), nil
+	}
+	child, err := id.child(pos)
+	if _, ok := err.(InvalidDepthTraversalError); ok {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return child.walk(rest, fn)
+}
+
+// find returns the position of the element with the given key.
+func (id *IndexedDocument) find(key string) (int, error) {
+	if err := id.index(); err != nil {
+		return 0, err
+	}
+
+	if id.array {
+		// Array keys are the indexes in canonical decimal form, so keys such as "01", "+1", and "-0" do not match.
+		pos, err := strconv.Atoi(key)
+		if err != nil || pos < 0 || pos >= len(id.elems) || strconv.Itoa(pos) != key {
+			return 0, ErrElementNotFound
+		}
+		return pos, nil
+	}
+
+	// Binary search for the first element with a key that is not less than key. Converting the key bytes to a string
+	// in a comparison does not allocate.
+	lo, hi := 0, len(id.byKey)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if string(id.key(id.byKey[mid])) < key {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo < len(id.byKey) && string(id.key(id.byKey[lo])) == key {
+		return id.byKey[lo], nil
+	}
+	return 0, ErrElementNotFound
+}
+
+// child returns the IndexedDocument for the embedded document or array at pos.
+func (id *IndexedDocument) child(pos int) (*IndexedDocument, error) {
+	if id.children != nil && id.children[pos] != nil {
+		return id.children[pos], nil
+	}
+
+	val := id.value(pos)
+	var child *IndexedDocument
+	switch val.Type {
+	case bsontype.EmbeddedDocument:
+		child = NewIndexedDocument(val.Data)
+	case bsontype.Array:
+		child = NewIndexedArray(val.Data)
+	default:
+		return nil, InvalidDepthTraversalError{Key: string(id.key(pos)), Type: val.Type}
+	}
+
+	if id.children == nil {
+		id.children = make([]*IndexedDocument, len(id.elems))
+	}
+	id.children[pos] = child
+	return child, nil
+}
+
+func (id *IndexedDocument) key(pos int) []byte {
+	e := id.elems[pos]
+	return id.doc[e.start+1 : e.value-1]
+}
+
+func (id *IndexedDocument) value(pos int) Value {
+	e := id.elems[pos]
+	return Value{Type: bsontype.Type(id.doc[e.start]), Data: id.doc[e.value:e.end]}
+}
+
+// index reads the offsets of the elements of the document if they have not been read yet. If the document is
+// invalid, the error is recorded and returned from every later call.
+func (id *IndexedDocument) index() error {
+	if id.indexed {
+		return id.err
+	}
+	id.indexed = true
+
+	length, rem, ok := ReadLength(id.doc)
+	if !ok {
+		id.err = NewInsufficientBytesError(id.doc, rem)
+		return id.err
+	}
+	if int(length) > len(id.doc) {
+		id.err = id.doc.lengtherror(int(length), len(id.doc))
+		return id.err
+	}
+
+	offset := 4
+	length -= 4
+	var elem Element
+	for length > 1 {
+		elem, rem, ok = ReadElement(rem)
+		length -= int32(len(elem))
+		if !ok {
+			id.elems = nil
+			id.err = NewInsufficientBytesError(id.doc, rem)
+			return id.err
+		}
+		keyLen := bytes.IndexByte(elem[1:], 0x00)
+		id.elems = append(id.elems, indexEntry{
+			start: offset,
+			value: offset + keyLen + 2,
+			end:   offset + len(elem),
+		})
+		offset += len(elem)
+	}
+
+	if id.array {
+		return nil
+	}
+	id.byKey = make([]int, len(id.elems))
+	for i := range id.byKey {
+		id.byKey[i] = i
+	}
+	// A stable sort keeps elements with duplicate keys in document order, so lookups return the first one like
+	// Document.LookupErr does.
+	sort.SliceStable(id.byKey, func(i, j int) bool {
+		return bytes.Compare(id.key(id.byKey[i]), id.key(id.byKey[j])) < 0
+	})
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsoncore
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+func TestIndexedDocument(t *testing.T) {
+	// {"b": 1, "a": {"x": "y"}, "items": [{"sku": "s0"}, {"qty": 1}, {"sku": "s2"}], "b": 2}
+	items := BuildArray(nil,
+		BuildDocumentValue(AppendStringElement(nil, "sku", "s0")),
+		BuildDocumentValue(AppendInt32Element(nil, "qty", 1)),
+		BuildDocumentValue(AppendStringElement(nil, "sku", "s2")),
+	)
+	doc := BuildDocumentFromElements(nil,
+		AppendInt32Element(nil, "b", 1),
+		BuildDocumentElement(nil, "a", AppendStringElement(nil, "x", "y")),
+		AppendArrayElement(nil, "items", items),
+		AppendInt32Element(nil, "b", 2),
+	)
+
+	t.Run("LookupErr", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			key  []string
+		}{
+			{"empty key", []string{}},
+			{"top level", []string{"a"}},
+			{"duplicate key", []string{"b"}},
+			{"missing", []string{"c"}},
+			{"nested", []string{"a", "x"}},
+			{"nested missing", []string{"a", "z"}},
+			{"array index", []string{"items", "2", "sku"}},
+			{"array out of bounds", []string{"items", "3"}},
+			{"array non-numeric", []string{"items", "x"}},
+			{"array leading zero", []string{"items", "01"}},
+			{"array plus sign", []string{"items", "+1"}},
+			{"array negative zero", []string{"items", "-0"}},
+			{"invalid depth", []string{"b", "x"}},
+		}
+		id := NewIndexedDocument(doc)
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				want, wantErr := Document(doc).LookupErr(tc.key...)
+				got, gotErr := id.LookupErr(tc.key...)
+				if !compareErrors(gotErr, wantErr) {
+					t.Errorf("Errors do not match. got %v; want %v", gotErr, wantErr)
+				}
+				if !cmp.Equal(got, want) {
+					t.Errorf("Values do not match. got %v; want %v", got, want)
+				}
+			})
+		}
+	})
+	t.Run("Index", func(t *testing.T) {
+		id := NewIndexedDocument(doc)
+		n, err := id.Len()
+		noerr(t, err)
+		if n != 4 {
+			t.Errorf("Unexpected length. got %d; want %d", n, 4)
+		}
+		for i := uint(0); i < uint(n); i++ {
+			got, err := id.IndexErr(i)
+			noerr(t, err)
+			if want := Document(doc).Index(i); !cmp.Equal(got, want) {
+				t.Errorf("Elements do not match at %d. got %v; want %v", i, got, want)
+			}
+		}
+		if _, err := id.IndexErr(uint(n)); err != ErrOutOfBounds {
+			t.Errorf("Errors do not match. got %v; want %v", err, ErrOutOfBounds)
+		}
+	})
+	t.Run("LookupIndexed", func(t *testing.T) {
+		id := NewIndexedDocument(doc)
+		first, err := id.LookupIndexed("items", "1")
+		noerr(t, err)
+		second, err := id.LookupIndexed("items", "1")
+		noerr(t, err)
+		if first != second {
+			t.Errorf("Expected the nested view to be cached")
+		}
+		if got := first.Lookup("qty"); !cmp.Equal(got, Document(doc).Lookup("items", "1", "qty")) {
+			t.Errorf("Unexpected value %v", got)
+		}
+		_, err = id.LookupIndexed("a", "x")
+		if _, ok := err.(InvalidDepthTraversalError); !ok {
+			t.Errorf("Expected InvalidDepthTraversalError, got %v", err)
+		}
+	})
+	t.Run("Find", func(t *testing.T) {
+		testCases := []struct {
+			path string
+			want []string
+		}{
+			{"items.*.sku", []string{`"s0"`, `"s2"`}},
+			{"items.1.qty", []string{`{"$numberInt":"1"}`}},
+			{"*.x", []string{`"y"`}},
+			{"b", []string{`{"$numberInt":"1"}`}},
+			{"*", []string{`{"$numberInt":"1"}`, `{"x": "y"}`, Value{Type: bsontype.Array, Data: items}.String(), `{"$numberInt":"2"}`}},
+			{"items.*.sku.foo", nil},
+			{"missing.*", nil},
+		}
+		id := NewIndexedDocument(doc)
+		for _, tc := range testCases {
+			t.Run(tc.path, func(t *testing.T) {
+				vals, err := id.FindAll(tc.path)
+				noerr(t, err)
+				var got []string
+				for _, v := range vals {
+					got = append(got, v.String())
+				}
+				if !cmp.Equal(got, tc.want) {
+					t.Errorf("Values do not match. got %v; want %v", got, tc.want)
+				}
+			})
+		}
+
+		var count int
+		err := id.Find("items.*", func(Value) bool {
+			count++
+			return count < 2
+		})
+		noerr(t, err)
+		if count != 2 {
+			t.Errorf("Expected Find to stop after 2 values, got %d", count)
+		}
+		if err := id.Find("", func(Value) bool { return true }); err != ErrEmptyKey {
+			t.Errorf("Errors do not match. got %v; want %v", err, ErrEmptyKey)
+		}
+	})
+	t.Run("no allocations", func(t *testing.T) {
+		id := NewIndexedDocument(doc)
+		var sink Value
+		fn := func(v Value) bool {
+			sink = v
+			return true
+		}
+		// Build the index for every level used below.
+		_ = id.Find("items.*.sku", fn)
+		_ = id.Lookup("a", "x")
+
+		allocs := testing.AllocsPerRun(100, func() {
+			sink = id.Lookup("items", "2", "sku")
+			sink = id.Lookup("a", "x")
+			_ = id.Find("items.*.sku", fn)
+		})
+		if allocs != 0 {
+			t.Errorf("Expected no allocations, got %v", allocs)
+		}
+		if sink.Type != bsontype.String {
+			t.Errorf("Unexpected value %v", sink)
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		// The value of "items" is truncated.
+		invalid := make(Document, len(doc))
+		copy(invalid, doc)
+		idx := strings.Index(string(invalid), "items") + len("items") + 1
+		invalid[idx] = 0xFF
+
+		id := NewIndexedDocument(invalid)
+		_, err := id.Len()
+		want := NewInsufficientBytesError(nil, nil)
+		if !compareErrors(err, want) {
+			t.Errorf("Errors do not match. got %v; want %v", err, want)
+		}
+		if err := id.Find("*", func(Value) bool { return true }); !compareErrors(err, want) {
+			t.Errorf("Errors do not match. got %v; want %v", err, want)
+		}
+		if _, err := NewIndexedDocument(Document{0x05}).Len(); !compareErrors(err, want) {
+			t.Errorf("Errors do not match. got %v; want %v", err, want)
+		}
+	})
+}
+
+func BenchmarkIndexedDocumentLookup(b *testing.B) {
+	elems := make([][]byte, 0, 100)
+	for i := 0; i < 100; i++ {
+		elems = append(elems, AppendInt32Element(nil, "field"+string(rune('a'+i%26))+string(rune('a'+i/26)), int32(i)))
+	}
+	doc := Document(BuildDocumentFromElements(nil, elems...))
+
+	b.Run("Document", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = doc.Lookup("fieldvd")
+		}
+	})
+	b.Run("IndexedDocument", func(b *testing.B) {
+		id := NewIndexedDocument(doc)
+		for i := 0; i < b.N; i++ {
+			_ = id.Lookup("fieldvd")
+		}
+	})
+}
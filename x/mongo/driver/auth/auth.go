@@ -58,6 +58,8 @@ type HandshakeOptions struct {
 	DBUser                string
 	PerformAuthentication func(description.Server) bool
 	ClusterClock          *session.ClusterClock
+	ServerAPI             *driver.ServerAPIOptions
+	LoadBalanced          bool
 }
 
 type authHandshaker struct {
@@ -77,7 +79,9 @@ func (ah *authHandshaker) GetDescription(ctx context.Context, addr address.Addre
 		AppName(ah.options.AppName).
 		Compressors(ah.options.Compressors).
 		SASLSupportedMechs(ah.options.DBUser).
-		ClusterClock(ah.options.ClusterClock)
+		ClusterClock(ah.options.ClusterClock).
+		ServerAPI(ah.options.ServerAPI).
+		LoadBalanced(ah.options.LoadBalanced)
 
 	if ah.options.Authenticator != nil {
 		if speculativeAuth, ok := ah.options.Authenticator.(SpeculativeAuthenticator); ok {
@@ -119,6 +123,7 @@ func (ah *authHandshaker) FinishHandshake(ctx context.Context, conn driver.Conne
 			Description:  desc,
 			Connection:   conn,
 			ClusterClock: ah.options.ClusterClock,
+			ServerAPI:    ah.options.ServerAPI,
 		}
 
 		if err := ah.authenticate(ctx, cfg); err != nil {
@@ -161,6 +166,7 @@ type Config struct {
 	Description  description.Server
 	Connection   driver.Connection
 	ClusterClock *session.ClusterClock
+	ServerAPI    *driver.ServerAPIOptions
 }
 
 // Authenticator handles authenticating a connection.
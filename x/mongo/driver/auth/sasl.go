@@ -135,7 +135,8 @@ func (sc *saslConversation) Finish(ctx context.Context, cfg *Config, firstRespon
 		saslContinueCmd := operation.NewCommand(doc).
 			Database(sc.source).
 			Deployment(driver.SingleConnectionDeployment{cfg.Connection}).
-			ClusterClock(cfg.ClusterClock)
+			ClusterClock(cfg.ClusterClock).
+			ServerAPI(cfg.ServerAPI)
 
 		err = saslContinueCmd.Execute(ctx)
 		if err != nil {
@@ -163,7 +164,8 @@ func ConductSaslConversation(ctx context.Context, cfg *Config, authSource string
 	saslStartCmd := operation.NewCommand(saslStartDoc).
 		Database(authSource).
 		Deployment(driver.SingleConnectionDeployment{cfg.Connection}).
-		ClusterClock(cfg.ClusterClock)
+		ClusterClock(cfg.ClusterClock).
+		ServerAPI(cfg.ServerAPI)
 	if err := saslStartCmd.Execute(ctx); err != nil {
 		return newError(err, conversation.mechanism)
 	}
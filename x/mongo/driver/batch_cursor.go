@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"go.mongodb.org/mongo-driver/event"
@@ -30,6 +31,9 @@ type BatchCursor struct {
 	cmdMonitor           *event.CommandMonitor
 	postBatchResumeToken bsoncore.Document
 	crypt                *Crypt
+	timeout              *time.Duration
+	serverAPI            *ServerAPIOptions
+	connection           PinnedConnection // only set when the deployment is behind a load balancer
 
 	// legacy server (< 3.2) fields
 	legacy      bool // This field is provided for ListCollectionsBatchCursor.
@@ -47,6 +51,25 @@ type CursorResponse struct {
 	Collection           string
 	ID                   int64
 	postBatchResumeToken bsoncore.Document
+
+	// Connection is the connection the cursor is pinned to. It is only set if the deployment is behind a load
+	// balancer and the cursor has a non-zero ID.
+	Connection PinnedConnection
+}
+
+// connectionServer is the Server passed to an operation's ProcessResponseFn when the operation was executed against a
+// load balancer. It carries the connection the response was read from so NewCursorResponse can pin the cursor to it.
+type connectionServer struct {
+	Server
+	conn Connection
+}
+
+// responseServer returns the Server that should be passed to ProcessResponseFn for a response read from conn.
+func responseServer(srvr Server, conn Connection) Server {
+	if !conn.Description().LoadBalanced() {
+		return srvr
+	}
+	return connectionServer{Server: srvr, conn: conn}
 }
 
 // NewCursorResponse constructs a cursor response from the given response and server. This method
@@ -60,6 +83,10 @@ func NewCursorResponse(response bsoncore.Document, server Server, desc descripti
 	if err != nil {
 		return CursorResponse{}, err
 	}
+	var conn Connection
+	if cs, ok := server.(connectionServer); ok {
+		server, conn = cs.Server, cs.conn
+	}
 	curresp := CursorResponse{Server: server, Desc: desc}
 
 	for _, elem := range elems {
@@ -93,6 +120,19 @@ func NewCursorResponse(response bsoncore.Document, server Server, desc descripti
 			}
 		}
 	}
+
+	// If the deployment is behind a load balancer and the cursor is still open, pin it to the connection that created
+	// it so getMore and killCursors commands reach the same mongos.
+	if conn != nil && curresp.ID != 0 {
+		pinnedConn, ok := conn.(PinnedConnection)
+		if !ok {
+			return CursorResponse{}, fmt.Errorf("expected Connection used to create a cursor to be a PinnedConnection, but got %T", conn)
+		}
+		if err := pinnedConn.PinToCursor(); err != nil {
+			return CursorResponse{}, fmt.Errorf("error pinning connection to cursor: %v", err)
+		}
+		curresp.Connection = pinnedConn
+	}
 	return curresp, nil
 }
 
@@ -103,6 +143,14 @@ type CursorOptions struct {
 	Limit          int32
 	CommandMonitor *event.CommandMonitor
 	Crypt          *Crypt
+
+	// Timeout is the client-side timeout applied to each getMore and killCursors command run by the cursor. Each
+	// command gets the full timeout if the context passed to it does not have a deadline.
+	Timeout *time.Duration
+
+	// ServerAPI is the Stable API declaration attached to killCursors commands run by the cursor. It is never attached
+	// to getMore commands.
+	ServerAPI *ServerAPIOptions
 }
 
 // NewBatchCursor creates a new BatchCursor from the provided parameters.
@@ -121,6 +169,9 @@ func NewBatchCursor(cr CursorResponse, clientSession *session.Client, clock *ses
 		firstBatch:           true,
 		postBatchResumeToken: cr.postBatchResumeToken,
 		crypt:                opts.Crypt,
+		timeout:              opts.Timeout,
+		serverAPI:            opts.ServerAPI,
+		connection:           cr.Connection,
 	}
 
 	if ds != nil {
@@ -199,10 +250,31 @@ func (bc *BatchCursor) Close(ctx context.Context) error {
 	bc.currentBatch.Data = nil
 	bc.currentBatch.Style = 0
 	bc.currentBatch.ResetIterator()
+	bc.unpinConnection()
 
 	return err
 }
 
+// unpinConnection releases the cursor's reference to its pinned connection, if it has one, and returns the connection
+// to the pool once no other cursors or transactions reference it.
+func (bc *BatchCursor) unpinConnection() {
+	if bc.connection == nil {
+		return
+	}
+
+	_ = bc.connection.UnpinFromCursor()
+	_ = bc.connection.Close()
+	bc.connection = nil
+}
+
+// deployment returns the Deployment used to run getMore and killCursors commands for this cursor.
+func (bc *BatchCursor) deployment() Deployment {
+	if bc.connection != nil {
+		return &pinnedCursorDeployment{server: bc.server, conn: bc.connection}
+	}
+	return SingleServerDeployment{Server: bc.server}
+}
+
 // Server returns the server for this cursor.
 func (bc *BatchCursor) Server() Server {
 	return bc.server
@@ -225,11 +297,13 @@ func (bc *BatchCursor) KillCursor(ctx context.Context) error {
 			return dst, nil
 		},
 		Database:       bc.database,
-		Deployment:     SingleServerDeployment{Server: bc.server},
+		Deployment:     bc.deployment(),
 		Client:         bc.clientSession,
 		Clock:          bc.clock,
 		Legacy:         LegacyKillCursors,
 		CommandMonitor: bc.cmdMonitor,
+		Timeout:        bc.timeout,
+		ServerAPI:      bc.serverAPI,
 	}.Execute(ctx, nil)
 }
 
@@ -265,7 +339,7 @@ func (bc *BatchCursor) getMore(ctx context.Context) {
 			return dst, nil
 		},
 		Database:   bc.database,
-		Deployment: SingleServerDeployment{Server: bc.server},
+		Deployment: bc.deployment(),
 		ProcessResponseFn: func(response bsoncore.Document, srvr Server, desc description.Server) error {
 			id, ok := response.Lookup("cursor", "id").Int64OK()
 			if !ok {
@@ -303,8 +377,14 @@ func (bc *BatchCursor) getMore(ctx context.Context) {
 		Legacy:         LegacyGetMore,
 		CommandMonitor: bc.cmdMonitor,
 		Crypt:          bc.crypt,
+		Timeout:        bc.timeout,
 	}.Execute(ctx, nil)
 
+	// The connection no longer needs to be pinned once the server has exhausted the cursor.
+	if bc.id == 0 {
+		bc.unpinConnection()
+	}
+
 	// Required for legacy operations which don't support limit.
 	if bc.limit != 0 && bc.numReturned >= bc.limit {
 		// call KillCursor instead of Close because Close will clear out the data for the current batch.
@@ -320,3 +400,38 @@ func (bc *BatchCursor) getMore(ctx context.Context) {
 func (bc *BatchCursor) PostBatchResumeToken() bsoncore.Document {
 	return bc.postBatchResumeToken
 }
+
+// pinnedCursorDeployment is the Deployment used for getMore and killCursors commands when a cursor is pinned to a
+// connection because the deployment is behind a load balancer. Every command uses the pinned connection and errors are
+// processed by the server that created the cursor.
+type pinnedCursorDeployment struct {
+	server Server
+	conn   PinnedConnection
+}
+
+var _ Deployment = (*pinnedCursorDeployment)(nil)
+var _ Server = (*pinnedCursorDeployment)(nil)
+var _ ErrorProcessor = (*pinnedCursorDeployment)(nil)
+
+// SelectServer implements the Deployment interface. It always returns itself.
+func (p *pinnedCursorDeployment) SelectServer(context.Context, description.ServerSelector) (Server, error) {
+	return p, nil
+}
+
+// SupportsRetryWrites implements the Deployment interface. It always returns false.
+func (p *pinnedCursorDeployment) SupportsRetryWrites() bool { return false }
+
+// Kind implements the Deployment interface. It always returns description.LoadBalanced.
+func (p *pinnedCursorDeployment) Kind() description.TopologyKind { return description.LoadBalanced }
+
+// Connection implements the Server interface. It always returns the pinned connection.
+func (p *pinnedCursorDeployment) Connection(context.Context) (Connection, error) {
+	return p.conn, nil
+}
+
+// ProcessError implements the ErrorProcessor interface by forwarding errors to the server that created the cursor.
+func (p *pinnedCursorDeployment) ProcessError(err error, conn Connection) {
+	if ep, ok := p.server.(ErrorProcessor); ok {
+		ep.ProcessError(err, conn)
+	}
+}
@@ -70,6 +70,8 @@ type ConnString struct {
 	Hosts                              []string
 	J                                  bool
 	JSet                               bool
+	LoadBalanced                       bool
+	LoadBalancedSet                    bool
 	LocalThreshold                     time.Duration
 	LocalThresholdSet                  bool
 	MaxConnIdleTime                    time.Duration
@@ -95,6 +97,8 @@ type ConnString struct {
 	ServerSelectionTimeoutSet          bool
 	SocketTimeout                      time.Duration
 	SocketTimeoutSet                   bool
+	Timeout                            time.Duration
+	TimeoutSet                         bool
 	SSL                                bool
 	SSLSet                             bool
 	SSLClientCertificateKeyFile        string
@@ -338,6 +342,19 @@ func (p *parser) validate() error {
 		}
 	}
 
+	// Check for invalid use of load balanced mode.
+	if p.LoadBalancedSet && p.LoadBalanced {
+		if len(p.Hosts) > 1 {
+			return errors.New("loadBalanced cannot be set to true if multiple hosts are specified")
+		}
+		if p.ReplicaSet != "" {
+			return errors.New("loadBalanced cannot be set to true if a replica set name is specified")
+		}
+		if p.DirectConnectionSet && p.DirectConnection {
+			return errors.New("loadBalanced cannot be set to true if the direct connection option is specified")
+		}
+	}
+
 	return nil
 }
 
@@ -632,6 +649,17 @@ func (p *parser) addOption(pair string) error {
 		}
 
 		p.JSet = true
+	case "loadbalanced":
+		switch value {
+		case "true":
+			p.LoadBalanced = true
+		case "false":
+			p.LoadBalanced = false
+		default:
+			return fmt.Errorf("invalid value for %s: %s", key, value)
+		}
+
+		p.LoadBalancedSet = true
 	case "localthresholdms":
 		n, err := strconv.Atoi(value)
 		if err != nil || n < 0 {
@@ -725,6 +753,13 @@ func (p *parser) addOption(pair string) error {
 		}
 		p.SocketTimeout = time.Duration(n) * time.Millisecond
 		p.SocketTimeoutSet = true
+	case "timeoutms":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid value for %s: %s", key, value)
+		}
+		p.Timeout = time.Duration(n) * time.Millisecond
+		p.TimeoutSet = true
 	case "ssl", "tls":
 		switch value {
 		case "true":
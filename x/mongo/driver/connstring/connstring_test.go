@@ -212,6 +212,39 @@ func TestHeartbeatInterval(t *testing.T) {
 	}
 }
 
+func TestLoadBalanced(t *testing.T) {
+	testCases := []struct {
+		s        string
+		expected bool
+		err      bool
+	}{
+		{"localhost/?loadBalanced=true", true, false},
+		{"localhost/?loadBalanced=false", false, false},
+		{"localhost/?loadBalanced=blah", false, true},
+		{"localhost,localhost:27018/?loadBalanced=true", false, true},
+		{"localhost,localhost:27018/?loadBalanced=false", false, false},
+		{"localhost/?loadBalanced=true&replicaSet=foo", false, true},
+		{"localhost/?loadBalanced=true&directConnection=true", false, true},
+		{"localhost/?loadBalanced=true&directConnection=false", true, false},
+	}
+
+	for _, tc := range testCases {
+		s := fmt.Sprintf("mongodb://%s", tc.s)
+		t.Run(s, func(t *testing.T) {
+			cs, err := connstring.ParseAndValidate(s)
+			if tc.err {
+				assert.NotNil(t, err, "expected error, got nil")
+				return
+			}
+
+			assert.Nil(t, err, "expected no error, got %v", err)
+			assert.Equal(t, tc.expected, cs.LoadBalanced, "expected LoadBalanced value %v, got %v", tc.expected,
+				cs.LoadBalanced)
+			assert.True(t, cs.LoadBalancedSet, "expected LoadBalancedSet to be true, got false")
+		})
+	}
+}
+
 func TestLocalThreshold(t *testing.T) {
 	tests := []struct {
 		s        string
@@ -537,6 +570,33 @@ func TestSocketTimeout(t *testing.T) {
 	}
 }
 
+func TestTimeout(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected time.Duration
+		err      bool
+	}{
+		{s: "timeoutMS=0", expected: time.Duration(0)},
+		{s: "timeoutMS=100", expected: time.Duration(100) * time.Millisecond},
+		{s: "timeoutMS=-2", err: true},
+		{s: "timeoutMS=gsdge", err: true},
+	}
+
+	for _, test := range tests {
+		s := fmt.Sprintf("mongodb://localhost/?%s", test.s)
+		t.Run(s, func(t *testing.T) {
+			cs, err := connstring.ParseAndValidate(s)
+			if test.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, cs.Timeout)
+				require.True(t, cs.TimeoutSet)
+			}
+		})
+	}
+}
+
 func TestWTimeout(t *testing.T) {
 	tests := []struct {
 		s        string
@@ -45,6 +45,7 @@ type Server struct {
 	MaxMessageSize          uint32
 	Members                 []address.Address
 	ReadOnly                bool
+	ServiceID               *primitive.ObjectID // Only returned by servers behind a load balancer.
 	SessionTimeoutMinutes   uint32
 	SetName                 string
 	SetVersion              uint32
@@ -227,6 +228,13 @@ func NewServer(addr address.Address, response bsoncore.Document) Server {
 				desc.LastError = fmt.Errorf("expected 'secondary' to be a boolean but it's a BSON %s", element.Value().Type)
 				return desc
 			}
+		case "serviceId":
+			oid, ok := element.Value().ObjectIDOK()
+			if !ok {
+				desc.LastError = fmt.Errorf("expected 'serviceId' to be an ObjectId but it's a BSON %s", element.Value().Type)
+				return desc
+			}
+			desc.ServiceID = &oid
 		case "setName":
 			desc.SetName, ok = element.Value().StringValueOK()
 			if !ok {
@@ -332,6 +340,11 @@ func (s Server) SetAverageRTT(rtt time.Duration) Server {
 	return s
 }
 
+// LoadBalanced returns true if the server is a load balancer or is behind a load balancer.
+func (s Server) LoadBalanced() bool {
+	return s.Kind == LoadBalancer || s.ServiceID != nil
+}
+
 // DataBearing returns true if the server is a data bearing server.
 func (s Server) DataBearing() bool {
 	return s.Kind == RSPrimary ||
@@ -340,6 +353,54 @@ func (s Server) DataBearing() bool {
 		s.Kind == Standalone
 }
 
+// Equal compares two server descriptions and returns true if they are equal. Fields that change on every heartbeat,
+// such as the round trip time and the last update time, are not compared.
+func (s Server) Equal(other Server) bool {
+	if s.Addr.String() != other.Addr.String() || s.CanonicalAddr.String() != other.CanonicalAddr.String() {
+		return false
+	}
+	if s.Kind != other.Kind || s.SetName != other.SetName || s.SetVersion != other.SetVersion {
+		return false
+	}
+	if s.ElectionID != other.ElectionID || s.SessionTimeoutMinutes != other.SessionTimeoutMinutes {
+		return false
+	}
+	if !addressSliceEqual(s.Members, other.Members) {
+		return false
+	}
+	if (s.LastError == nil) != (other.LastError == nil) {
+		return false
+	}
+	if s.LastError != nil && s.LastError.Error() != other.LastError.Error() {
+		return false
+	}
+	if (s.WireVersion == nil) != (other.WireVersion == nil) {
+		return false
+	}
+	if s.WireVersion != nil && *s.WireVersion != *other.WireVersion {
+		return false
+	}
+	if (s.TopologyVersion == nil) != (other.TopologyVersion == nil) {
+		return false
+	}
+	if s.TopologyVersion != nil && *s.TopologyVersion != *other.TopologyVersion {
+		return false
+	}
+	return len(s.Tags) == len(other.Tags) && s.Tags.ContainsAll(other.Tags)
+}
+
+func addressSliceEqual(s1, s2 []address.Address) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	for i := range s1 {
+		if s1[i].String() != s2[i].String() {
+			return false
+		}
+	}
+	return true
+}
+
 // SelectServer selects this server if it is in the list of given candidates.
 func (s Server) SelectServer(_ Topology, candidates []Server) ([]Server, error) {
 	for _, candidate := range candidates {
@@ -63,3 +63,30 @@ func TestTopology_DiffHostlist(t *testing.T) {
 	assert.EqualValues(t, []Server{s6, s1, s3, s2}, topo.Servers)
 	assert.EqualValues(t, []string{h2, h4, h3, h5}, hostlist)
 }
+
+func TestTopology_Equal(t *testing.T) {
+	s1 := Server{Addr: "1.0.0.0:27017", Kind: RSPrimary}
+	s2 := Server{Addr: "2.0.0.0:27017", Kind: RSSecondary}
+	s2WithRTT := s2.SetAverageRTT(10)
+	s2Unknown := Server{Addr: "2.0.0.0:27017", Kind: Unknown}
+
+	testCases := []struct {
+		name  string
+		t1    Topology
+		t2    Topology
+		equal bool
+	}{
+		{"empty", Topology{}, Topology{}, true},
+		{"same servers", Topology{Servers: []Server{s1, s2}}, Topology{Servers: []Server{s1, s2}}, true},
+		{"different order", Topology{Servers: []Server{s1, s2}}, Topology{Servers: []Server{s2, s1}}, true},
+		{"different RTT", Topology{Servers: []Server{s1, s2}}, Topology{Servers: []Server{s1, s2WithRTT}}, true},
+		{"different kind", Topology{Kind: ReplicaSetWithPrimary}, Topology{Kind: ReplicaSetNoPrimary}, false},
+		{"different servers", Topology{Servers: []Server{s1}}, Topology{Servers: []Server{s2}}, false},
+		{"different server kind", Topology{Servers: []Server{s2}}, Topology{Servers: []Server{s2Unknown}}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.equal, tc.t1.Equal(tc.t2))
+		})
+	}
+}
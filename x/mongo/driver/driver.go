@@ -54,6 +54,22 @@ type Connection interface {
 	Address() address.Address
 }
 
+// PinnedConnection represents a Connection that can be pinned by one or more cursors or transactions. This is used
+// when the deployment is behind a load balancer, because getMore, killCursors, and transaction commands must be sent
+// over the connection that created the cursor or started the transaction. Implementations must maintain the following
+// invariants:
+//
+// 1. Each Pin* call increments the number of references to the connection.
+// 2. Each Unpin* call decrements the number of references to the connection.
+// 3. Calls to Close are ignored until all references have been removed.
+type PinnedConnection interface {
+	Connection
+	PinToCursor() error
+	PinToTransaction() error
+	UnpinFromCursor() error
+	UnpinFromTransaction() error
+}
+
 // LocalAddresser is a type that is able to supply its local address
 type LocalAddresser interface {
 	LocalAddress() address.Address
@@ -89,9 +105,9 @@ type Compressor interface {
 
 // ErrorProcessor implementations can handle processing errors, which may modify their internal state.
 // If this type is implemented by a Server, then Operation.Execute will call it's ProcessError
-// method after it decodes a wire message.
+// method after it decodes a wire message. The Connection is the one the error occurred on.
 type ErrorProcessor interface {
-	ProcessError(error)
+	ProcessError(err error, conn Connection)
 }
 
 // Handshaker is the interface implemented by types that can perform a MongoDB
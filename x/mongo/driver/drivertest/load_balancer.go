@@ -0,0 +1,218 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package drivertest
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/wiremessage"
+)
+
+// LoadBalancerHandler is called by a LoadBalancer for every command that is not part of the connection handshake. It
+// returns the reply document to send. The serviceID is the one assigned to the connection the command was sent on.
+type LoadBalancerHandler func(serviceID primitive.ObjectID, cmd bsoncore.Document) bsoncore.Document
+
+// LoadBalancer is a local stand-in for a load balancer in front of one or more mongos instances. It listens on a
+// local TCP port and answers every command itself, so load balanced mode can be tested without a real deployment.
+//
+// Each accepted connection is assigned a serviceId from ServiceIDs in round-robin order, which simulates the load
+// balancer routing connections to different mongos instances. The handshake reply includes the assigned serviceId and
+// every other command is answered by Handler, or with {ok: 1} if Handler is nil.
+type LoadBalancer struct {
+	ServiceIDs []primitive.ObjectID
+	Handler    LoadBalancerHandler
+
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mu       sync.Mutex
+	accepted int
+	conns    map[net.Conn]struct{}
+}
+
+// NewLoadBalancer creates a LoadBalancer that assigns the given serviceIds and starts accepting connections. If no
+// serviceIds are given, a single one is generated.
+func NewLoadBalancer(serviceIDs ...primitive.ObjectID) (*LoadBalancer, error) {
+	if len(serviceIDs) == 0 {
+		serviceIDs = []primitive.ObjectID{primitive.NewObjectID()}
+	}
+
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return nil, err
+	}
+
+	lb := &LoadBalancer{
+		ServiceIDs: serviceIDs,
+		listener:   l,
+		conns:      make(map[net.Conn]struct{}),
+	}
+	lb.wg.Add(1)
+	go lb.accept()
+	return lb, nil
+}
+
+// Addr returns the address the LoadBalancer is listening on.
+func (lb *LoadBalancer) Addr() string {
+	return lb.listener.Addr().String()
+}
+
+// Accepted returns the number of connections the LoadBalancer has accepted.
+func (lb *LoadBalancer) Accepted() int {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+	return lb.accepted
+}
+
+// Close stops accepting connections, closes all open connections, and waits for them to finish.
+func (lb *LoadBalancer) Close() error {
+	err := lb.listener.Close()
+
+	lb.mu.Lock()
+	for nc := range lb.conns {
+		_ = nc.Close()
+	}
+	lb.mu.Unlock()
+
+	lb.wg.Wait()
+	return err
+}
+
+func (lb *LoadBalancer) accept() {
+	defer lb.wg.Done()
+	for {
+		nc, err := lb.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		lb.mu.Lock()
+		serviceID := lb.ServiceIDs[lb.accepted%len(lb.ServiceIDs)]
+		lb.accepted++
+		lb.conns[nc] = struct{}{}
+		lb.mu.Unlock()
+
+		lb.wg.Add(1)
+		go lb.serve(nc, serviceID)
+	}
+}
+
+func (lb *LoadBalancer) serve(nc net.Conn, serviceID primitive.ObjectID) {
+	defer lb.wg.Done()
+	defer func() {
+		lb.mu.Lock()
+		delete(lb.conns, nc)
+		lb.mu.Unlock()
+		_ = nc.Close()
+	}()
+
+	for {
+		wm, err := readWireMessage(nc)
+		if err != nil {
+			return
+		}
+
+		_, requestID, _, opcode, _, ok := wiremessage.ReadHeader(wm)
+		if !ok {
+			return
+		}
+
+		var cmd bsoncore.Document
+		switch opcode {
+		case wiremessage.OpQuery:
+			cmd, err = GetCommandFromQueryWireMessage(wm)
+		case wiremessage.OpMsg:
+			cmd, err = GetCommandFromMsgWireMessage(wm)
+		default:
+			return
+		}
+		if err != nil {
+			return
+		}
+
+		reply := lb.reply(serviceID, cmd)
+		if opcode == wiremessage.OpQuery {
+			_, err = nc.Write(makeReply(requestID, reply))
+		} else {
+			_, err = nc.Write(makeMsgReply(requestID, reply))
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// reply returns the reply document for the given command.
+func (lb *LoadBalancer) reply(serviceID primitive.ObjectID, cmd bsoncore.Document) bsoncore.Document {
+	elem, err := cmd.IndexErr(0)
+	if err != nil {
+		return bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "ok", 0))
+	}
+
+	switch strings.ToLower(elem.Key()) {
+	case "ismaster", "hello":
+		return bsoncore.BuildDocumentFromElements(nil,
+			bsoncore.AppendBooleanElement(nil, "ismaster", true),
+			bsoncore.AppendStringElement(nil, "msg", "isdbgrid"),
+			bsoncore.AppendInt32Element(nil, "minWireVersion", 0),
+			bsoncore.AppendInt32Element(nil, "maxWireVersion", 13),
+			bsoncore.AppendInt32Element(nil, "logicalSessionTimeoutMinutes", 30),
+			bsoncore.AppendObjectIDElement(nil, "serviceId", serviceID),
+			bsoncore.AppendDoubleElement(nil, "ok", 1),
+		)
+	}
+
+	if lb.Handler != nil {
+		return lb.Handler(serviceID, cmd)
+	}
+	return bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendDoubleElement(nil, "ok", 1))
+}
+
+// readWireMessage reads a single wire message from r.
+func readWireMessage(r io.Reader) ([]byte, error) {
+	var sizeBuf [4]byte
+	if _, err := io.ReadFull(r, sizeBuf[:]); err != nil {
+		return nil, err
+	}
+
+	size := int32(binary.LittleEndian.Uint32(sizeBuf[:]))
+	if size < 16 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	wm := make([]byte, size)
+	copy(wm, sizeBuf[:])
+	if _, err := io.ReadFull(r, wm[4:]); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// makeReply creates an OP_REPLY wire message that responds to the request with the given ID.
+func makeReply(responseTo int32, doc bsoncore.Document) []byte {
+	idx, dst := wiremessage.AppendHeaderStart(nil, wiremessage.NextRequestID(), responseTo, wiremessage.OpReply)
+	dst = wiremessage.AppendReplyFlags(dst, 0)
+	dst = wiremessage.AppendReplyCursorID(dst, 0)
+	dst = wiremessage.AppendReplyStartingFrom(dst, 0)
+	dst = wiremessage.AppendReplyNumberReturned(dst, 1)
+	dst = append(dst, doc...)
+	return bsoncore.UpdateLength(dst, idx, int32(len(dst[idx:])))
+}
+
+// makeMsgReply creates an OP_MSG wire message that responds to the request with the given ID.
+func makeMsgReply(responseTo int32, doc bsoncore.Document) []byte {
+	idx, dst := wiremessage.AppendHeaderStart(nil, wiremessage.NextRequestID(), responseTo, wiremessage.OpMsg)
+	dst = wiremessage.AppendMsgFlags(dst, 0)
+	dst = wiremessage.AppendMsgSectionType(dst, wiremessage.SingleDocument)
+	dst = append(dst, doc...)
+	return bsoncore.UpdateLength(dst, idx, int32(len(dst[idx:])))
+}
@@ -38,6 +38,9 @@ var (
 	// ErrUnsupportedStorageEngine is returned when a retryable write is attempted against a server
 	// that uses a storage engine that does not support retryable writes
 	ErrUnsupportedStorageEngine = errors.New("this MongoDB deployment does not support retryable writes. Please add retryWrites=false to your connection string")
+	// ErrDeadlineWouldBeExceeded is returned when a client-side timeout is in effect and the time remaining before the
+	// operation's deadline is shorter than the server's average round trip time, so the command is not sent.
+	ErrDeadlineWouldBeExceeded = errors.New("operation not sent to the server because the remaining time before the timeout is less than the server's average round trip time")
 )
 
 // QueryFailureError is an error representing a command failure as a document.
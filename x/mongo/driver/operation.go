@@ -13,6 +13,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/internal"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
@@ -35,6 +36,9 @@ var (
 	ErrReplyDocumentMismatch = errors.New("number of documents returned does not match numberReturned field")
 	// ErrNonPrimaryReadPref is returned when a read is attempted in a transaction with a non-primary read preference.
 	ErrNonPrimaryReadPref = errors.New("read preference in a transaction must be primary")
+	// ErrSnapshotReadsNotSupported is returned when a read in a snapshot session is sent to a server that does not
+	// support snapshot reads.
+	ErrSnapshotReadsNotSupported = errors.New("snapshot reads require MongoDB 5.0 or later")
 )
 
 const (
@@ -42,6 +46,8 @@ const (
 	cryptMaxBsonObjectSize uint32 = 2097152
 	// minimum wire version necessary to use automatic encryption
 	cryptMinWireVersion int32 = 8
+	// minimum wire version necessary to use snapshot reads outside of transactions
+	readSnapshotMinWireVersion int32 = 13
 )
 
 // InvalidOperationError is returned from Validate and indicates that a required field is missing
@@ -182,6 +188,17 @@ type Operation struct {
 
 	// Crypt specifies a Crypt object to use for automatic client side encryption and decryption.
 	Crypt *Crypt
+
+	// Timeout is the client-side timeout for the entire operation, including server selection, connection checkout,
+	// retries, and every round trip to the server. If the context passed to Execute does not have a deadline, one is
+	// derived from this value. While a timeout is in effect, retryable operations are retried until the deadline
+	// expires and a maxTimeMS value computed from the remaining time is attached to each command. If this field is nil
+	// or points to a zero duration, no client-side timeout is applied.
+	Timeout *time.Duration
+
+	// ServerAPI specifies the Stable API version to declare on the command. If this field is set, the command is
+	// always sent using OP_MSG.
+	ServerAPI *ServerAPIOptions
 }
 
 // shouldEncrypt returns true if this operation should automatically be encrypted.
@@ -189,6 +206,11 @@ func (op Operation) shouldEncrypt() bool {
 	return op.Crypt != nil && !op.Crypt.BypassAutoEncryption
 }
 
+// timeoutEnabled returns true if a client-side timeout has been configured for this operation.
+func (op Operation) timeoutEnabled() bool {
+	return op.Timeout != nil && *op.Timeout > 0
+}
+
 // selectServer handles performing server selection for an operation.
 func (op Operation) selectServer(ctx context.Context) (Server, error) {
 	if err := op.Validate(); err != nil {
@@ -210,6 +232,44 @@ func (op Operation) selectServer(ctx context.Context) (Server, error) {
 	return op.Deployment.SelectServer(ctx, selector)
 }
 
+// getServerAndConnection selects a server and checks out a connection from it. If the operation is part of a
+// transaction that is pinned to a connection because the deployment is behind a load balancer, the pinned connection is
+// returned instead. If the operation starts a transaction against a load balancer, the returned connection is pinned
+// to the transaction.
+func (op Operation) getServerAndConnection(ctx context.Context) (Server, Connection, error) {
+	srvr, err := op.selectServer(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if op.Client != nil && op.Client.PinnedConnection != nil {
+		if op.Client.TransactionRunning() || op.Client.Committing || op.Client.Aborting {
+			return srvr, op.Client.PinnedConnection, nil
+		}
+		// The transaction has finished, so the connection no longer needs to be pinned.
+		op.Client.UnpinConnection()
+	}
+
+	conn, err := srvr.Connection(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if conn.Description().LoadBalanced() && op.Client != nil && op.Client.TransactionStarting() {
+		pinnedConn, ok := conn.(PinnedConnection)
+		if !ok {
+			conn.Close()
+			return nil, nil, fmt.Errorf("expected Connection used to start a transaction to be a PinnedConnection, but got %T", conn)
+		}
+		if err := pinnedConn.PinToTransaction(); err != nil {
+			conn.Close()
+			return nil, nil, fmt.Errorf("error pinning connection to transaction: %v", err)
+		}
+		op.Client.PinnedConnection = pinnedConn
+	}
+	return srvr, conn, nil
+}
+
 // Validate validates this operation, ensuring the fields are set properly.
 func (op Operation) Validate() error {
 	if op.CommandFn == nil {
@@ -235,12 +295,10 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 		return err
 	}
 
-	srvr, err := op.selectServer(ctx)
-	if err != nil {
-		return err
-	}
+	ctx, cancel := internal.MakeTimeoutContext(ctx, op.Timeout)
+	defer cancel()
 
-	conn, err := srvr.Connection(ctx)
+	srvr, conn, err := op.getServerAndConnection(ctx)
 	if err != nil {
 		return err
 	}
@@ -303,6 +361,10 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 			}
 		}
 	}
+	// When a client-side timeout is in effect, retries are bounded by the operation's deadline instead of a count.
+	if retries != 0 && op.timeoutEnabled() {
+		retries = -1
+	}
 	batching := op.Batches.Valid()
 	retryEnabled := op.RetryMode != nil && op.RetryMode.Enabled()
 	currIndex := 0
@@ -367,7 +429,7 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 		}
 		res, err = roundTrip(ctx, conn, wm)
 		if ep, ok := srvr.(ErrorProcessor); ok {
-			ep.ProcessError(err)
+			ep.ProcessError(err, conn)
 		}
 
 		finishedInfo.response = res
@@ -376,7 +438,7 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 
 		var perr error
 		if op.ProcessResponseFn != nil {
-			perr = op.ProcessResponseFn(res, srvr, desc.Server)
+			perr = op.ProcessResponseFn(res, responseServer(srvr, conn), desc.Server)
 		}
 		switch tt := err.(type) {
 		case WriteCommandError:
@@ -397,11 +459,7 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 				retries--
 				original, err = err, nil
 				conn.Close() // Avoid leaking the connection.
-				srvr, err = op.selectServer(ctx)
-				if err != nil {
-					return original
-				}
-				conn, err = srvr.Connection(ctx)
+				srvr, conn, err = op.getServerAndConnection(ctx)
 				if err != nil || conn == nil || !op.retryable(conn.Description()) {
 					if conn != nil {
 						conn.Close()
@@ -451,7 +509,7 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 			operationErr.Labels = tt.Labels
 		case Error:
 			if tt.HasErrorLabel(TransientTransactionError) || tt.HasErrorLabel(UnknownTransactionCommitResult) {
-				op.Client.ClearPinnedServer()
+				op.Client.ClearPinnedResources()
 			}
 			if e := err.(Error); retryable && op.Type == Write && e.UnsupportedStorageEngine() {
 				return ErrUnsupportedStorageEngine
@@ -474,11 +532,7 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 				retries--
 				original, err = err, nil
 				conn.Close() // Avoid leaking the connection.
-				srvr, err = op.selectServer(ctx)
-				if err != nil {
-					return original
-				}
-				conn, err = srvr.Connection(ctx)
+				srvr, conn, err = op.getServerAndConnection(ctx)
 				if err != nil || conn == nil || !op.retryable(conn.Description()) {
 					if conn != nil {
 						conn.Close()
@@ -515,7 +569,7 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 				if *op.RetryMode > RetryNone {
 					op.Client.IncrementTxnNumber()
 				}
-				if *op.RetryMode == RetryOncePerCommand {
+				if *op.RetryMode == RetryOncePerCommand && !op.timeoutEnabled() {
 					retries = 1
 				}
 			}
@@ -615,6 +669,7 @@ func (op Operation) readWireMessage(ctx context.Context, conn Connection, wm []b
 	op.updateClusterTimes(res)
 	op.updateOperationTime(res)
 	op.Client.UpdateRecoveryToken(bson.Raw(res))
+	op.Client.UpdateSnapshotTime(res)
 
 	if err != nil {
 		return res, err
@@ -689,8 +744,10 @@ func (Operation) decompressWireMessage(wm []byte) ([]byte, error) {
 func (op Operation) createWireMessage(ctx context.Context, dst []byte,
 	desc description.SelectedServer, conn Connection) ([]byte, startedInformation, error) {
 
-	if desc.WireVersion == nil || desc.WireVersion.Max < wiremessage.OpmsgWireVersion {
-		return op.createQueryWireMessage(dst, desc)
+	// Servers that support the Stable API always support OP_MSG, so a declared API version means that OP_MSG can be
+	// used even before the server's wire version is known, as is the case for the initial handshake.
+	if op.ServerAPI == nil && (desc.WireVersion == nil || desc.WireVersion.Max < wiremessage.OpmsgWireVersion) {
+		return op.createQueryWireMessage(ctx, dst, desc)
 	}
 	return op.createMsgWireMessage(ctx, dst, desc, conn)
 }
@@ -704,7 +761,7 @@ func (op Operation) addBatchArray(dst []byte) []byte {
 	return dst
 }
 
-func (op Operation) createQueryWireMessage(dst []byte, desc description.SelectedServer) ([]byte, startedInformation, error) {
+func (op Operation) createQueryWireMessage(ctx context.Context, dst []byte, desc description.SelectedServer) ([]byte, startedInformation, error) {
 	var info startedInformation
 	flags := op.slaveOK(desc)
 	var wmindex int32
@@ -754,6 +811,11 @@ func (op Operation) createQueryWireMessage(dst []byte, desc description.Selected
 
 	dst = op.addClusterTime(dst, desc)
 
+	dst, err = op.addMaxTimeMS(ctx, dst, idx, desc)
+	if err != nil {
+		return dst, info, err
+	}
+
 	dst, _ = bsoncore.AppendDocumentEnd(dst, idx)
 	// Command monitoring only reports the document inside $query
 	info.cmd = dst[idx:]
@@ -815,6 +877,13 @@ func (op Operation) createMsgWireMessage(ctx context.Context, dst []byte, desc d
 
 	dst = op.addClusterTime(dst, desc)
 
+	dst, err = op.addMaxTimeMS(ctx, dst, idx, desc)
+	if err != nil {
+		return dst, info, err
+	}
+
+	dst = op.addServerAPI(dst, idx)
+
 	dst = bsoncore.AppendStringElement(dst, "$db", op.Database)
 	rp, err := op.createReadPref(desc.Server.Kind, desc.Kind, false)
 	if err != nil {
@@ -898,6 +967,16 @@ func (op Operation) addReadConcern(dst []byte, desc description.SelectedServer)
 		rc = readconcern.New()
 	}
 
+	// Reads in a snapshot session always use the snapshot read concern. Operations that do not support a read concern
+	// do not set one, so they are not affected.
+	snapshot := client != nil && client.Snapshot && op.Type == Read && rc != nil
+	if snapshot {
+		if desc.WireVersion == nil || !desc.WireVersion.Includes(readSnapshotMinWireVersion) {
+			return dst, ErrSnapshotReadsNotSupported
+		}
+		rc = readconcern.Snapshot()
+	}
+
 	if rc == nil {
 		return dst, nil
 	}
@@ -912,6 +991,11 @@ func (op Operation) addReadConcern(dst []byte, desc description.SelectedServer)
 		data = bsoncore.AppendTimestampElement(data, "afterClusterTime", client.OperationTime.T, client.OperationTime.I)
 		data, _ = bsoncore.AppendDocumentEnd(data, 0)
 	}
+	if snapshot && client.SnapshotTime != nil {
+		data = data[:len(data)-1] // remove the null byte
+		data = bsoncore.AppendTimestampElement(data, "atClusterTime", client.SnapshotTime.T, client.SnapshotTime.I)
+		data, _ = bsoncore.AppendDocumentEnd(data, 0)
+	}
 
 	if len(data) == bsoncore.EmptyDocumentLength {
 		return dst, nil
@@ -989,6 +1073,75 @@ func (op Operation) addClusterTime(dst []byte, desc description.SelectedServer)
 	// return bsoncore.AppendDocumentElement(dst, "$clusterTime", clusterTime)
 }
 
+// addMaxTimeMS appends a maxTimeMS element computed from the time remaining before the context deadline if a
+// client-side timeout is in effect. The command document being built in dst starts at idx. Nothing is appended for
+// getMore commands, for commands within a running transaction other than commitTransaction and abortTransaction, or
+// if the command already contains a maxTimeMS element. If the remaining time is not longer than the server's average
+// round trip time, ErrDeadlineWouldBeExceeded is returned.
+func (op Operation) addMaxTimeMS(ctx context.Context, dst []byte, idx int32, desc description.SelectedServer) ([]byte, error) {
+	if !op.timeoutEnabled() {
+		return dst, nil
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return dst, nil
+	}
+	if op.getCommandName(dst[idx:]) == "getMore" {
+		return dst, nil
+	}
+	if client := op.Client; client != nil && client.TransactionRunning() && !client.Committing && !client.Aborting {
+		return dst, nil
+	}
+
+	// The document hasn't been terminated yet, so walk the elements that have been appended so far.
+	rem := dst[idx+4:]
+	for len(rem) > 0 {
+		elem, next, ok := bsoncore.ReadElement(rem)
+		if !ok {
+			break
+		}
+		if elem.Key() == "maxTimeMS" {
+			return dst, nil
+		}
+		rem = next
+	}
+
+	remaining := time.Until(deadline) - desc.AverageRTT
+	if remaining <= 0 {
+		return dst, ErrDeadlineWouldBeExceeded
+	}
+	maxTimeMS := int64(remaining / time.Millisecond)
+	if maxTimeMS == 0 {
+		maxTimeMS = 1
+	}
+	return bsoncore.AppendInt64Element(dst, "maxTimeMS", maxTimeMS), nil
+}
+
+// addServerAPI appends the Stable API fields to the command document being built in dst, which starts at idx. Nothing
+// is appended for getMore commands or for commands that continue a transaction, including commitTransaction and
+// abortTransaction, because the server only accepts the fields on the first command of a transaction.
+func (op Operation) addServerAPI(dst []byte, idx int32) []byte {
+	sa := op.ServerAPI
+	if sa == nil {
+		return dst
+	}
+	if op.getCommandName(dst[idx:]) == "getMore" {
+		return dst
+	}
+	if client := op.Client; client != nil && (client.TransactionInProgress() || client.Committing || client.Aborting) {
+		return dst
+	}
+
+	dst = bsoncore.AppendStringElement(dst, "apiVersion", sa.ServerAPIVersion)
+	if sa.Strict != nil {
+		dst = bsoncore.AppendBooleanElement(dst, "apiStrict", *sa.Strict)
+	}
+	if sa.DeprecationErrors != nil {
+		dst = bsoncore.AppendBooleanElement(dst, "apiDeprecationErrors", *sa.DeprecationErrors)
+	}
+	return dst
+}
+
 // updateClusterTimes updates the cluster times for the session and cluster clock attached to this
 // operation. While the session's AdvanceClusterTime may return an error, this method does not
 // because an error being returned from this method will not be returned further up.
@@ -11,6 +11,7 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
@@ -31,6 +32,8 @@ type AbortTransaction struct {
 	database      string
 	deployment    driver.Deployment
 	selector      description.ServerSelector
+	timeout       *time.Duration
+	serverAPI     *driver.ServerAPIOptions
 	writeConcern  *writeconcern.WriteConcern
 	retry         *driver.RetryMode
 }
@@ -63,6 +66,8 @@ func (at *AbortTransaction) Execute(ctx context.Context) error {
 		Database:          at.database,
 		Deployment:        at.deployment,
 		Selector:          at.selector,
+		Timeout:           at.timeout,
+		ServerAPI:         at.serverAPI,
 		WriteConcern:      at.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -167,6 +172,26 @@ func (at *AbortTransaction) ServerSelector(selector description.ServerSelector)
 	return at
 }
 
+// Timeout sets the client-side timeout for this operation.
+func (at *AbortTransaction) Timeout(timeout *time.Duration) *AbortTransaction {
+	if at == nil {
+		at = new(AbortTransaction)
+	}
+
+	at.timeout = timeout
+	return at
+}
+
+// ServerAPI sets the Stable API version declaration for this operation.
+func (at *AbortTransaction) ServerAPI(serverAPI *driver.ServerAPIOptions) *AbortTransaction {
+	if at == nil {
+		at = new(AbortTransaction)
+	}
+
+	at.serverAPI = serverAPI
+	return at
+}
+
 // WriteConcern sets the write concern for this operation.
 func (at *AbortTransaction) WriteConcern(writeConcern *writeconcern.WriteConcern) *AbortTransaction {
 	if at == nil {
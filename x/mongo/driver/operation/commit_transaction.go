@@ -11,6 +11,7 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
@@ -31,6 +32,8 @@ type CommitTransaction struct {
 	database      string
 	deployment    driver.Deployment
 	selector      description.ServerSelector
+	timeout       *time.Duration
+	serverAPI     *driver.ServerAPIOptions
 	writeConcern  *writeconcern.WriteConcern
 	retry         *driver.RetryMode
 }
@@ -63,6 +66,8 @@ func (ct *CommitTransaction) Execute(ctx context.Context) error {
 		Database:          ct.database,
 		Deployment:        ct.deployment,
 		Selector:          ct.selector,
+		Timeout:           ct.timeout,
+		ServerAPI:         ct.serverAPI,
 		WriteConcern:      ct.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -170,6 +175,26 @@ func (ct *CommitTransaction) ServerSelector(selector description.ServerSelector)
 	return ct
 }
 
+// Timeout sets the client-side timeout for this operation.
+func (ct *CommitTransaction) Timeout(timeout *time.Duration) *CommitTransaction {
+	if ct == nil {
+		ct = new(CommitTransaction)
+	}
+
+	ct.timeout = timeout
+	return ct
+}
+
+// ServerAPI sets the Stable API version declaration for this operation.
+func (ct *CommitTransaction) ServerAPI(serverAPI *driver.ServerAPIOptions) *CommitTransaction {
+	if ct == nil {
+		ct = new(CommitTransaction)
+	}
+
+	ct.serverAPI = serverAPI
+	return ct
+}
+
 // WriteConcern sets the write concern for this operation.
 func (ct *CommitTransaction) WriteConcern(writeConcern *writeconcern.WriteConcern) *CommitTransaction {
 	if ct == nil {
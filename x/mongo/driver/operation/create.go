@@ -11,6 +11,7 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
@@ -41,6 +42,8 @@ type Create struct {
 	database            string
 	deployment          driver.Deployment
 	selector            description.ServerSelector
+	timeout             *time.Duration
+	serverAPI           *driver.ServerAPIOptions
 	writeConcern        *writeconcern.WriteConcern
 }
 
@@ -72,6 +75,8 @@ func (c *Create) Execute(ctx context.Context) error {
 		Database:          c.database,
 		Deployment:        c.deployment,
 		Selector:          c.selector,
+		Timeout:           c.timeout,
+		ServerAPI:         c.serverAPI,
 		WriteConcern:      c.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -310,6 +315,26 @@ func (c *Create) ServerSelector(selector description.ServerSelector) *Create {
 	return c
 }
 
+// Timeout sets the client-side timeout for this operation.
+func (c *Create) Timeout(timeout *time.Duration) *Create {
+	if c == nil {
+		c = new(Create)
+	}
+
+	c.timeout = timeout
+	return c
+}
+
+// ServerAPI sets the Stable API version declaration for this operation.
+func (c *Create) ServerAPI(serverAPI *driver.ServerAPIOptions) *Create {
+	if c == nil {
+		c = new(Create)
+	}
+
+	c.serverAPI = serverAPI
+	return c
+}
+
 // WriteConcern sets the write concern for this operation.
 func (c *Create) WriteConcern(writeConcern *writeconcern.WriteConcern) *Create {
 	if c == nil {
@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"go.mongodb.org/mongo-driver/event"
@@ -35,6 +36,8 @@ type CreateIndexes struct {
 	database     string
 	deployment   driver.Deployment
 	selector     description.ServerSelector
+	timeout      *time.Duration
+	serverAPI    *driver.ServerAPIOptions
 	writeConcern *writeconcern.WriteConcern
 	result       CreateIndexesResult
 }
@@ -111,6 +114,8 @@ func (ci *CreateIndexes) Execute(ctx context.Context) error {
 		Database:          ci.database,
 		Deployment:        ci.deployment,
 		Selector:          ci.selector,
+		Timeout:           ci.timeout,
+		ServerAPI:         ci.serverAPI,
 		WriteConcern:      ci.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -245,6 +250,26 @@ func (ci *CreateIndexes) ServerSelector(selector description.ServerSelector) *Cr
 	return ci
 }
 
+// Timeout sets the client-side timeout for this operation.
+func (ci *CreateIndexes) Timeout(timeout *time.Duration) *CreateIndexes {
+	if ci == nil {
+		ci = new(CreateIndexes)
+	}
+
+	ci.timeout = timeout
+	return ci
+}
+
+// ServerAPI sets the Stable API version declaration for this operation.
+func (ci *CreateIndexes) ServerAPI(serverAPI *driver.ServerAPIOptions) *CreateIndexes {
+	if ci == nil {
+		ci = new(CreateIndexes)
+	}
+
+	ci.serverAPI = serverAPI
+	return ci
+}
+
 // WriteConcern sets the write concern for this operation.
 func (ci *CreateIndexes) WriteConcern(writeConcern *writeconcern.WriteConcern) *CreateIndexes {
 	if ci == nil {
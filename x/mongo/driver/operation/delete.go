@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
@@ -33,6 +34,8 @@ type Delete struct {
 	database     string
 	deployment   driver.Deployment
 	selector     description.ServerSelector
+	timeout      *time.Duration
+	serverAPI    *driver.ServerAPIOptions
 	writeConcern *writeconcern.WriteConcern
 	retry        *driver.RetryMode
 	hint         *bool
@@ -103,6 +106,8 @@ func (d *Delete) Execute(ctx context.Context) error {
 		Database:          d.database,
 		Deployment:        d.deployment,
 		Selector:          d.selector,
+		Timeout:           d.timeout,
+		ServerAPI:         d.serverAPI,
 		WriteConcern:      d.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -227,6 +232,26 @@ func (d *Delete) ServerSelector(selector description.ServerSelector) *Delete {
 	return d
 }
 
+// Timeout sets the client-side timeout for this operation.
+func (d *Delete) Timeout(timeout *time.Duration) *Delete {
+	if d == nil {
+		d = new(Delete)
+	}
+
+	d.timeout = timeout
+	return d
+}
+
+// ServerAPI sets the Stable API version declaration for this operation.
+func (d *Delete) ServerAPI(serverAPI *driver.ServerAPIOptions) *Delete {
+	if d == nil {
+		d = new(Delete)
+	}
+
+	d.serverAPI = serverAPI
+	return d
+}
+
 // WriteConcern sets the write concern for this operation.
 func (d *Delete) WriteConcern(writeConcern *writeconcern.WriteConcern) *Delete {
 	if d == nil {
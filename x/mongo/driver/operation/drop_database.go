@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
@@ -30,6 +31,8 @@ type DropDatabase struct {
 	database     string
 	deployment   driver.Deployment
 	selector     description.ServerSelector
+	timeout      *time.Duration
+	serverAPI    *driver.ServerAPIOptions
 	writeConcern *writeconcern.WriteConcern
 	result       DropDatabaseResult
 }
@@ -88,6 +91,8 @@ func (dd *DropDatabase) Execute(ctx context.Context) error {
 		Database:          dd.database,
 		Deployment:        dd.deployment,
 		Selector:          dd.selector,
+		Timeout:           dd.timeout,
+		ServerAPI:         dd.serverAPI,
 		WriteConcern:      dd.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -169,6 +174,26 @@ func (dd *DropDatabase) ServerSelector(selector description.ServerSelector) *Dro
 	return dd
 }
 
+// Timeout sets the client-side timeout for this operation.
+func (dd *DropDatabase) Timeout(timeout *time.Duration) *DropDatabase {
+	if dd == nil {
+		dd = new(DropDatabase)
+	}
+
+	dd.timeout = timeout
+	return dd
+}
+
+// ServerAPI sets the Stable API version declaration for this operation.
+func (dd *DropDatabase) ServerAPI(serverAPI *driver.ServerAPIOptions) *DropDatabase {
+	if dd == nil {
+		dd = new(DropDatabase)
+	}
+
+	dd.serverAPI = serverAPI
+	return dd
+}
+
 // WriteConcern sets the write concern for this operation.
 func (dd *DropDatabase) WriteConcern(writeConcern *writeconcern.WriteConcern) *DropDatabase {
 	if dd == nil {
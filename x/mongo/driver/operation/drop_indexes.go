@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
@@ -33,6 +34,8 @@ type DropIndexes struct {
 	database     string
 	deployment   driver.Deployment
 	selector     description.ServerSelector
+	timeout      *time.Duration
+	serverAPI    *driver.ServerAPIOptions
 	writeConcern *writeconcern.WriteConcern
 	result       DropIndexesResult
 }
@@ -93,6 +96,8 @@ func (di *DropIndexes) Execute(ctx context.Context) error {
 		Database:          di.database,
 		Deployment:        di.deployment,
 		Selector:          di.selector,
+		Timeout:           di.timeout,
+		ServerAPI:         di.serverAPI,
 		WriteConcern:      di.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -210,6 +215,26 @@ func (di *DropIndexes) ServerSelector(selector description.ServerSelector) *Drop
 	return di
 }
 
+// Timeout sets the client-side timeout for this operation.
+func (di *DropIndexes) Timeout(timeout *time.Duration) *DropIndexes {
+	if di == nil {
+		di = new(DropIndexes)
+	}
+
+	di.timeout = timeout
+	return di
+}
+
+// ServerAPI sets the Stable API version declaration for this operation.
+func (di *DropIndexes) ServerAPI(serverAPI *driver.ServerAPIOptions) *DropIndexes {
+	if di == nil {
+		di = new(DropIndexes)
+	}
+
+	di.serverAPI = serverAPI
+	return di
+}
+
 // WriteConcern sets the write concern for this operation.
 func (di *DropIndexes) WriteConcern(writeConcern *writeconcern.WriteConcern) *DropIndexes {
 	if di == nil {
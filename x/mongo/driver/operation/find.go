@@ -11,6 +11,7 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"go.mongodb.org/mongo-driver/event"
@@ -56,6 +57,8 @@ type Find struct {
 	readConcern         *readconcern.ReadConcern
 	readPreference      *readpref.ReadPref
 	selector            description.ServerSelector
+	timeout             *time.Duration
+	serverAPI           *driver.ServerAPIOptions
 	retry               *driver.RetryMode
 	result              driver.CursorResponse
 }
@@ -98,6 +101,8 @@ func (f *Find) Execute(ctx context.Context) error {
 		ReadConcern:       f.readConcern,
 		ReadPreference:    f.readPreference,
 		Selector:          f.selector,
+		Timeout:           f.timeout,
+		ServerAPI:         f.serverAPI,
 		Legacy:            driver.LegacyFind,
 	}.Execute(ctx, nil)
 
@@ -500,6 +505,26 @@ func (f *Find) ServerSelector(selector description.ServerSelector) *Find {
 	return f
 }
 
+// Timeout sets the client-side timeout for this operation.
+func (f *Find) Timeout(timeout *time.Duration) *Find {
+	if f == nil {
+		f = new(Find)
+	}
+
+	f.timeout = timeout
+	return f
+}
+
+// ServerAPI sets the Stable API version declaration for this operation.
+func (f *Find) ServerAPI(serverAPI *driver.ServerAPIOptions) *Find {
+	if f == nil {
+		f = new(Find)
+	}
+
+	f.serverAPI = serverAPI
+	return f
+}
+
 // Retry enables retryable mode for this operation. Retries are handled automatically in driver.Operation.Execute based
 // on how the operation is set.
 func (f *Find) Retry(retry driver.RetryMode) *Find {
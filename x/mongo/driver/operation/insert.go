@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
@@ -34,6 +35,8 @@ type Insert struct {
 	database                 string
 	deployment               driver.Deployment
 	selector                 description.ServerSelector
+	timeout                  *time.Duration
+	serverAPI                *driver.ServerAPIOptions
 	writeConcern             *writeconcern.WriteConcern
 	retry                    *driver.RetryMode
 	result                   InsertResult
@@ -103,6 +106,8 @@ func (i *Insert) Execute(ctx context.Context) error {
 		Database:          i.database,
 		Deployment:        i.deployment,
 		Selector:          i.selector,
+		Timeout:           i.timeout,
+		ServerAPI:         i.serverAPI,
 		WriteConcern:      i.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -232,6 +237,26 @@ func (i *Insert) ServerSelector(selector description.ServerSelector) *Insert {
 	return i
 }
 
+// Timeout sets the client-side timeout for this operation.
+func (i *Insert) Timeout(timeout *time.Duration) *Insert {
+	if i == nil {
+		i = new(Insert)
+	}
+
+	i.timeout = timeout
+	return i
+}
+
+// ServerAPI sets the Stable API version declaration for this operation.
+func (i *Insert) ServerAPI(serverAPI *driver.ServerAPIOptions) *Insert {
+	if i == nil {
+		i = new(Insert)
+	}
+
+	i.serverAPI = serverAPI
+	return i
+}
+
 // WriteConcern sets the write concern for this operation.
 func (i *Insert) WriteConcern(writeConcern *writeconcern.WriteConcern) *Insert {
 	if i == nil {
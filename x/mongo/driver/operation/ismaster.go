@@ -23,6 +23,10 @@ type IsMaster struct {
 	d                  driver.Deployment
 	clock              *session.ClusterClock
 	speculativeAuth    bsoncore.Document
+	serverAPI          *driver.ServerAPIOptions
+	loadBalanced       bool
+	topologyVersion    *description.TopologyVersion
+	maxAwaitTimeMS     *int64
 
 	res bsoncore.Document
 }
@@ -73,6 +77,34 @@ func (im *IsMaster) SpeculativeAuthenticate(doc bsoncore.Document) *IsMaster {
 	return im
 }
 
+// ServerAPI sets the Stable API version declaration for this operation.
+func (im *IsMaster) ServerAPI(serverAPI *driver.ServerAPIOptions) *IsMaster {
+	im.serverAPI = serverAPI
+	return im
+}
+
+// LoadBalanced specifies whether or not this operation is being sent over a connection to a load balanced cluster.
+// If true, the handshake asks the server to report a serviceId and fails if the reply does not contain one.
+func (im *IsMaster) LoadBalanced(lb bool) *IsMaster {
+	im.loadBalanced = lb
+	return im
+}
+
+// TopologyVersion sets the topologyVersion of the server the operation is sent to. If this and MaxAwaitTimeMS are both
+// set, the operation is an awaitable isMaster: the server does not reply until its topologyVersion changes or
+// maxAwaitTimeMS elapses.
+func (im *IsMaster) TopologyVersion(tv *description.TopologyVersion) *IsMaster {
+	im.topologyVersion = tv
+	return im
+}
+
+// MaxAwaitTimeMS sets the maximum time in milliseconds that the server waits for its topologyVersion to change before
+// replying to an awaitable isMaster.
+func (im *IsMaster) MaxAwaitTimeMS(awaitTime int64) *IsMaster {
+	im.maxAwaitTimeMS = &awaitTime
+	return im
+}
+
 // Result returns the result of executing this operation.
 func (im *IsMaster) Result(addr address.Address) description.Server {
 	return description.NewServer(addr, im.res)
@@ -132,6 +164,9 @@ func (im *IsMaster) handshakeCommand(dst []byte, desc description.SelectedServer
 	if im.speculativeAuth != nil {
 		dst = bsoncore.AppendDocumentElement(dst, "speculativeAuthenticate", im.speculativeAuth)
 	}
+	if im.loadBalanced {
+		dst = bsoncore.AppendBooleanElement(dst, "loadBalanced", true)
+	}
 	var idx int32
 	idx, dst = bsoncore.AppendArrayElementStart(dst, "compression")
 	for i, compressor := range im.compressors {
@@ -165,7 +200,17 @@ func (im *IsMaster) handshakeCommand(dst []byte, desc description.SelectedServer
 
 // command appends all necessary command fields.
 func (im *IsMaster) command(dst []byte, _ description.SelectedServer) ([]byte, error) {
-	return bsoncore.AppendInt32Element(dst, "isMaster", 1), nil
+	dst = bsoncore.AppendInt32Element(dst, "isMaster", 1)
+	if tv := im.topologyVersion; tv != nil && im.maxAwaitTimeMS != nil {
+		var idx int32
+		idx, dst = bsoncore.AppendDocumentElementStart(dst, "topologyVersion")
+		dst = bsoncore.AppendObjectIDElement(dst, "processId", tv.ProcessID)
+		dst = bsoncore.AppendInt64Element(dst, "counter", tv.Counter)
+		dst, _ = bsoncore.AppendDocumentEnd(dst, idx)
+
+		dst = bsoncore.AppendInt64Element(dst, "maxAwaitTimeMS", *im.maxAwaitTimeMS)
+	}
+	return dst, nil
 }
 
 // Execute runs this operation.
@@ -174,16 +219,27 @@ func (im *IsMaster) Execute(ctx context.Context) error {
 		return errors.New("an IsMaster must have a Deployment set before Execute can be called")
 	}
 
+	return im.createOperation().Execute(ctx, nil)
+}
+
+// StreamResponse reads the next response of an awaitable isMaster from a connection that is currently streaming. The
+// server sends a new response whenever its topologyVersion changes or maxAwaitTimeMS elapses.
+func (im *IsMaster) StreamResponse(ctx context.Context, conn driver.StreamerConnection) error {
+	return im.createOperation().ExecuteExhaust(ctx, conn, nil)
+}
+
+func (im *IsMaster) createOperation() driver.Operation {
 	return driver.Operation{
 		Clock:      im.clock,
 		CommandFn:  im.command,
 		Database:   "admin",
 		Deployment: im.d,
+		ServerAPI:  im.serverAPI,
 		ProcessResponseFn: func(response bsoncore.Document, _ driver.Server, _ description.Server) error {
 			im.res = response
 			return nil
 		},
-	}.Execute(ctx, nil)
+	}
 }
 
 // GetDescription retrieves the server description for the given connection. This function implements the Handshaker
@@ -194,6 +250,7 @@ func (im *IsMaster) GetDescription(ctx context.Context, _ address.Address, c dri
 		CommandFn:  im.handshakeCommand,
 		Deployment: driver.SingleConnectionDeployment{c},
 		Database:   "admin",
+		ServerAPI:  im.serverAPI,
 		ProcessResponseFn: func(response bsoncore.Document, _ driver.Server, _ description.Server) error {
 			im.res = response
 			return nil
@@ -202,7 +259,13 @@ func (im *IsMaster) GetDescription(ctx context.Context, _ address.Address, c dri
 	if err != nil {
 		return description.Server{}, err
 	}
-	return im.Result(c.Address()), nil
+
+	desc := im.Result(c.Address())
+	if im.loadBalanced && desc.ServiceID == nil {
+		return description.Server{}, fmt.Errorf("load balancing is enabled but the server at %s did not include a "+
+			"serviceId in its handshake reply", c.Address())
+	}
+	return desc, nil
 }
 
 // FinishHandshake implements the Handshaker interface. This is a no-op function because a non-authenticated connection
@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/event"
@@ -35,6 +36,8 @@ type ListDatabases struct {
 	readPreference      *readpref.ReadPref
 	retry               *driver.RetryMode
 	selector            description.ServerSelector
+	timeout             *time.Duration
+	serverAPI           *driver.ServerAPIOptions
 	crypt               *driver.Crypt
 
 	result ListDatabasesResult
@@ -170,6 +173,8 @@ func (ld *ListDatabases) Execute(ctx context.Context) error {
 		RetryMode:      ld.retry,
 		Type:           driver.Read,
 		Selector:       ld.selector,
+		Timeout:        ld.timeout,
+		ServerAPI:      ld.serverAPI,
 		Crypt:          ld.crypt,
 	}.Execute(ctx, nil)
 
@@ -293,6 +298,26 @@ func (ld *ListDatabases) ServerSelector(selector description.ServerSelector) *Li
 	return ld
 }
 
+// Timeout sets the client-side timeout for this operation.
+func (ld *ListDatabases) Timeout(timeout *time.Duration) *ListDatabases {
+	if ld == nil {
+		ld = new(ListDatabases)
+	}
+
+	ld.timeout = timeout
+	return ld
+}
+
+// ServerAPI sets the Stable API version declaration for this operation.
+func (ld *ListDatabases) ServerAPI(serverAPI *driver.ServerAPIOptions) *ListDatabases {
+	if ld == nil {
+		ld = new(ListDatabases)
+	}
+
+	ld.serverAPI = serverAPI
+	return ld
+}
+
 // Retry enables retryable mode for this operation. Retries are handled automatically in driver.Operation.Execute based
 // on how the operation is set.
 func (ld *ListDatabases) Retry(retry driver.RetryMode) *ListDatabases {
@@ -11,6 +11,7 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -32,6 +33,8 @@ type ListCollections struct {
 	deployment     driver.Deployment
 	readPreference *readpref.ReadPref
 	selector       description.ServerSelector
+	timeout        *time.Duration
+	serverAPI      *driver.ServerAPIOptions
 	retry          *driver.RetryMode
 	result         driver.CursorResponse
 }
@@ -81,6 +84,8 @@ func (lc *ListCollections) Execute(ctx context.Context) error {
 		Deployment:        lc.deployment,
 		ReadPreference:    lc.readPreference,
 		Selector:          lc.selector,
+		Timeout:           lc.timeout,
+		ServerAPI:         lc.serverAPI,
 		Legacy:            driver.LegacyListCollections,
 	}.Execute(ctx, nil)
 
@@ -198,6 +203,26 @@ func (lc *ListCollections) ServerSelector(selector description.ServerSelector) *
 	return lc
 }
 
+// Timeout sets the client-side timeout for this operation.
+func (lc *ListCollections) Timeout(timeout *time.Duration) *ListCollections {
+	if lc == nil {
+		lc = new(ListCollections)
+	}
+
+	lc.timeout = timeout
+	return lc
+}
+
+// ServerAPI sets the Stable API version declaration for this operation.
+func (lc *ListCollections) ServerAPI(serverAPI *driver.ServerAPIOptions) *ListCollections {
+	if lc == nil {
+		lc = new(ListCollections)
+	}
+
+	lc.serverAPI = serverAPI
+	return lc
+}
+
 // Retry enables retryable mode for this operation. Retries are handled automatically in driver.Operation.Execute based
 // on how the operation is set.
 func (lc *ListCollections) Retry(retry driver.RetryMode) *ListCollections {
@@ -11,6 +11,7 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
@@ -30,6 +31,8 @@ type ListIndexes struct {
 	database   string
 	deployment driver.Deployment
 	selector   description.ServerSelector
+	timeout    *time.Duration
+	serverAPI  *driver.ServerAPIOptions
 	retry      *driver.RetryMode
 	crypt      *driver.Crypt
 
@@ -74,6 +77,8 @@ func (li *ListIndexes) Execute(ctx context.Context) error {
 		Database:       li.database,
 		Deployment:     li.deployment,
 		Selector:       li.selector,
+		Timeout:        li.timeout,
+		ServerAPI:      li.serverAPI,
 		Crypt:          li.crypt,
 		Legacy:         driver.LegacyListIndexes,
 		RetryMode:      li.retry,
@@ -190,6 +195,26 @@ func (li *ListIndexes) ServerSelector(selector description.ServerSelector) *List
 	return li
 }
 
+// Timeout sets the client-side timeout for this operation.
+func (li *ListIndexes) Timeout(timeout *time.Duration) *ListIndexes {
+	if li == nil {
+		li = new(ListIndexes)
+	}
+
+	li.timeout = timeout
+	return li
+}
+
+// ServerAPI sets the Stable API version declaration for this operation.
+func (li *ListIndexes) ServerAPI(serverAPI *driver.ServerAPIOptions) *ListIndexes {
+	if li == nil {
+		li = new(ListIndexes)
+	}
+
+	li.serverAPI = serverAPI
+	return li
+}
+
 // Retry enables retryable mode for this operation. Retries are handled automatically in driver.Operation.Execute based
 // on how the operation is set.
 func (li *ListIndexes) Retry(retry driver.RetryMode) *ListIndexes {
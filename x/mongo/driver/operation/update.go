@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/event"
@@ -35,6 +36,8 @@ type Update struct {
 	deployment               driver.Deployment
 	hint                     *bool
 	selector                 description.ServerSelector
+	timeout                  *time.Duration
+	serverAPI                *driver.ServerAPIOptions
 	writeConcern             *writeconcern.WriteConcern
 	retry                    *driver.RetryMode
 	result                   UpdateResult
@@ -151,6 +154,8 @@ func (u *Update) Execute(ctx context.Context) error {
 		Database:          u.database,
 		Deployment:        u.deployment,
 		Selector:          u.selector,
+		Timeout:           u.timeout,
+		ServerAPI:         u.serverAPI,
 		WriteConcern:      u.writeConcern,
 		Crypt:             u.crypt,
 	}.Execute(ctx, nil)
@@ -297,6 +302,26 @@ func (u *Update) ServerSelector(selector description.ServerSelector) *Update {
 	return u
 }
 
+// Timeout sets the client-side timeout for this operation.
+func (u *Update) Timeout(timeout *time.Duration) *Update {
+	if u == nil {
+		u = new(Update)
+	}
+
+	u.timeout = timeout
+	return u
+}
+
+// ServerAPI sets the Stable API version declaration for this operation.
+func (u *Update) ServerAPI(serverAPI *driver.ServerAPIOptions) *Update {
+	if u == nil {
+		u = new(Update)
+	}
+
+	u.serverAPI = serverAPI
+	return u
+}
+
 // WriteConcern sets the write concern for this operation.
 func (u *Update) WriteConcern(writeConcern *writeconcern.WriteConcern) *Update {
 	if u == nil {
@@ -234,6 +234,45 @@ func TestOperation(t *testing.T) {
 			}
 		}
 	})
+	t.Run("addReadConcern snapshot", func(t *testing.T) {
+		snapshot := true
+		id, _ := uuid.New()
+		sess, err := session.NewClientSession(&session.Pool{}, id, session.Explicit, &session.ClientOptions{Snapshot: &snapshot})
+		noerr(t, err)
+		desc := description.SelectedServer{Server: description.Server{WireVersion: &description.VersionRange{Max: 13}}}
+
+		op := Operation{ReadConcern: readconcern.New(), Client: sess, Type: Read}
+		want := bsoncore.AppendDocumentElement(nil, "readConcern", bsoncore.BuildDocument(nil,
+			bsoncore.AppendStringElement(nil, "level", "snapshot"),
+		))
+		got, err := op.addReadConcern(nil, desc)
+		noerr(t, err)
+		if !bytes.Equal(got, want) {
+			t.Errorf("ReadConcern elements do not match. got %v; want %v", got, want)
+		}
+
+		sess.SnapshotTime = &primitive.Timestamp{T: 10, I: 5}
+		want = bsoncore.AppendDocumentElement(nil, "readConcern", bsoncore.BuildDocument(nil, bsoncore.AppendTimestampElement(
+			bsoncore.AppendStringElement(nil, "level", "snapshot"), "atClusterTime", 10, 5,
+		)))
+		got, err = op.addReadConcern(nil, desc)
+		noerr(t, err)
+		if !bytes.Equal(got, want) {
+			t.Errorf("ReadConcern elements do not match. got %v; want %v", got, want)
+		}
+
+		// Operations that are not reads do not use the snapshot read concern.
+		got, err = Operation{Client: sess}.addReadConcern(nil, desc)
+		noerr(t, err)
+		if got != nil {
+			t.Errorf("expected no ReadConcern element, got %v", got)
+		}
+
+		_, err = op.addReadConcern(nil, description.SelectedServer{Server: description.Server{WireVersion: &description.VersionRange{Max: 12}}})
+		if err != ErrSnapshotReadsNotSupported {
+			t.Errorf("expected error %v, got %v", ErrSnapshotReadsNotSupported, err)
+		}
+	})
 	t.Run("addWriteConcern", func(t *testing.T) {
 		want := bsoncore.AppendDocumentElement(nil, "writeConcern", bsoncore.BuildDocumentFromElements(
 			nil, bsoncore.AppendStringElement(nil, "w", "majority"),
@@ -276,6 +315,148 @@ func TestOperation(t *testing.T) {
 			}
 		})
 	})
+	t.Run("addMaxTimeMS", func(t *testing.T) {
+		timeout := 10 * time.Second
+		startCommand := func(name string, elems ...[]byte) ([]byte, int32) {
+			idx, dst := bsoncore.AppendDocumentStart(nil)
+			dst = bsoncore.AppendInt32Element(dst, name, 1)
+			for _, elem := range elems {
+				dst = append(dst, elem...)
+			}
+			return dst, idx
+		}
+		deadlineCtx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		t.Run("appends maxTimeMS when a timeout and deadline are set", func(t *testing.T) {
+			dst, idx := startCommand("find")
+			got, err := Operation{Timeout: &timeout}.addMaxTimeMS(deadlineCtx, dst, idx, description.SelectedServer{})
+			noerr(t, err)
+			got, _ = bsoncore.AppendDocumentEnd(got, idx)
+			val, err := bsoncore.Document(got).LookupErr("maxTimeMS")
+			noerr(t, err)
+			maxTimeMS := val.Int64()
+			assert.True(t, maxTimeMS > 0 && maxTimeMS <= 10000, "expected maxTimeMS in (0, 10000], got %v", maxTimeMS)
+		})
+		t.Run("subtracts the average RTT", func(t *testing.T) {
+			dst, idx := startCommand("find")
+			desc := description.SelectedServer{Server: description.Server{AverageRTT: 5 * time.Second}}
+			got, err := Operation{Timeout: &timeout}.addMaxTimeMS(deadlineCtx, dst, idx, desc)
+			noerr(t, err)
+			got, _ = bsoncore.AppendDocumentEnd(got, idx)
+			maxTimeMS := bsoncore.Document(got).Lookup("maxTimeMS").Int64()
+			assert.True(t, maxTimeMS > 0 && maxTimeMS <= 5000, "expected maxTimeMS in (0, 5000], got %v", maxTimeMS)
+		})
+		t.Run("errors if the deadline would be exceeded", func(t *testing.T) {
+			dst, idx := startCommand("find")
+			desc := description.SelectedServer{Server: description.Server{AverageRTT: 20 * time.Second}}
+			_, err := Operation{Timeout: &timeout}.addMaxTimeMS(deadlineCtx, dst, idx, desc)
+			assert.Equal(t, ErrDeadlineWouldBeExceeded, err, "expected error %v, got %v", ErrDeadlineWouldBeExceeded, err)
+		})
+
+		testCases := []struct {
+			name    string
+			op      Operation
+			ctx     context.Context
+			command string
+			elems   [][]byte
+		}{
+			{"no timeout", Operation{}, deadlineCtx, "find", nil},
+			{"no deadline", Operation{Timeout: &timeout}, context.Background(), "find", nil},
+			{"getMore", Operation{Timeout: &timeout}, deadlineCtx, "getMore", nil},
+			{
+				"maxTimeMS already set",
+				Operation{Timeout: &timeout},
+				deadlineCtx,
+				"find",
+				[][]byte{bsoncore.AppendInt64Element(nil, "maxTimeMS", 500)},
+			},
+		}
+		for _, tc := range testCases {
+			t.Run("skips if "+tc.name, func(t *testing.T) {
+				dst, idx := startCommand(tc.command, tc.elems...)
+				want := append([]byte(nil), dst...)
+				got, err := tc.op.addMaxTimeMS(tc.ctx, dst, idx, description.SelectedServer{})
+				noerr(t, err)
+				if !bytes.Equal(got, want) {
+					t.Errorf("expected command to be unchanged. got %v; want %v", got, want)
+				}
+			})
+		}
+	})
+	t.Run("addServerAPI", func(t *testing.T) {
+		startCommand := func(name string) ([]byte, int32) {
+			idx, dst := bsoncore.AppendDocumentStart(nil)
+			return bsoncore.AppendInt32Element(dst, name, 1), idx
+		}
+		serverAPI := NewServerAPIOptions("1").SetStrict(true).SetDeprecationErrors(false)
+
+		t.Run("appends all fields", func(t *testing.T) {
+			dst, idx := startCommand("find")
+			got := Operation{ServerAPI: serverAPI}.addServerAPI(dst, idx)
+			got, _ = bsoncore.AppendDocumentEnd(got, idx)
+			want := bsoncore.BuildDocumentFromElements(nil,
+				bsoncore.AppendInt32Element(nil, "find", 1),
+				bsoncore.AppendStringElement(nil, "apiVersion", "1"),
+				bsoncore.AppendBooleanElement(nil, "apiStrict", true),
+				bsoncore.AppendBooleanElement(nil, "apiDeprecationErrors", false),
+			)
+			if !bytes.Equal(got, want) {
+				t.Errorf("commands do not match. got %v; want %v", bsoncore.Document(got), bsoncore.Document(want))
+			}
+		})
+		t.Run("omits unset optional fields", func(t *testing.T) {
+			dst, idx := startCommand("find")
+			got := Operation{ServerAPI: NewServerAPIOptions("1")}.addServerAPI(dst, idx)
+			got, _ = bsoncore.AppendDocumentEnd(got, idx)
+			want := bsoncore.BuildDocumentFromElements(nil,
+				bsoncore.AppendInt32Element(nil, "find", 1),
+				bsoncore.AppendStringElement(nil, "apiVersion", "1"),
+			)
+			if !bytes.Equal(got, want) {
+				t.Errorf("commands do not match. got %v; want %v", bsoncore.Document(got), bsoncore.Document(want))
+			}
+		})
+
+		sessPool := session.NewPool(nil)
+		id, err := uuid.New()
+		noerr(t, err)
+		starting, err := session.NewClientSession(sessPool, id, session.Explicit)
+		noerr(t, err)
+		noerr(t, starting.StartTransaction(nil))
+		inProgress, err := session.NewClientSession(sessPool, id, session.Explicit)
+		noerr(t, err)
+		noerr(t, inProgress.StartTransaction(nil))
+		inProgress.ApplyCommand(description.Server{})
+
+		t.Run("appends to the first command in a transaction", func(t *testing.T) {
+			dst, idx := startCommand("insert")
+			got := Operation{ServerAPI: serverAPI, Client: starting}.addServerAPI(dst, idx)
+			got, _ = bsoncore.AppendDocumentEnd(got, idx)
+			_, err := bsoncore.Document(got).LookupErr("apiVersion")
+			noerr(t, err)
+		})
+
+		testCases := []struct {
+			name    string
+			op      Operation
+			command string
+		}{
+			{"no server API", Operation{}, "find"},
+			{"getMore", Operation{ServerAPI: serverAPI}, "getMore"},
+			{"transaction in progress", Operation{ServerAPI: serverAPI, Client: inProgress}, "insert"},
+		}
+		for _, tc := range testCases {
+			t.Run("skips if "+tc.name, func(t *testing.T) {
+				dst, idx := startCommand(tc.command)
+				want := append([]byte(nil), dst...)
+				got := tc.op.addServerAPI(dst, idx)
+				if !bytes.Equal(got, want) {
+					t.Errorf("expected command to be unchanged. got %v; want %v", got, want)
+				}
+			})
+		}
+	})
 	t.Run("updateClusterTimes", func(t *testing.T) {
 		clustertime := bsoncore.BuildDocumentFromElements(nil,
 			bsoncore.AppendDocumentElement(nil, "$clusterTime", bsoncore.BuildDocumentFromElements(nil,
@@ -504,7 +685,7 @@ func TestOperation(t *testing.T) {
 						Kind: tc.server,
 					},
 				}
-				wm, _, err := op.createQueryWireMessage(wm, desc)
+				wm, _, err := op.createQueryWireMessage(context.Background(), wm, desc)
 				noerr(t, err)
 
 				// We know where the $query would be within the OP_QUERY, so we'll just index into there.
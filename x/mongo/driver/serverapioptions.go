@@ -0,0 +1,36 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package driver
+
+// ServerAPIOptions represents the Stable API declaration that is attached to commands. When set on an Operation, the
+// apiVersion, apiStrict, and apiDeprecationErrors fields are appended to every command except getMore and commands that
+// continue a running transaction.
+type ServerAPIOptions struct {
+	ServerAPIVersion  string
+	Strict            *bool
+	DeprecationErrors *bool
+}
+
+// NewServerAPIOptions creates a new ServerAPIOptions for the given API version.
+func NewServerAPIOptions(serverAPIVersion string) *ServerAPIOptions {
+	return &ServerAPIOptions{
+		ServerAPIVersion: serverAPIVersion,
+	}
+}
+
+// SetStrict specifies whether the server should return errors for features that are not part of the declared API
+// version.
+func (s *ServerAPIOptions) SetStrict(strict bool) *ServerAPIOptions {
+	s.Strict = &strict
+	return s
+}
+
+// SetDeprecationErrors specifies whether the server should return errors for deprecated features.
+func (s *ServerAPIOptions) SetDeprecationErrors(deprecationErrors bool) *ServerAPIOptions {
+	s.DeprecationErrors = &deprecationErrors
+	return s
+}
@@ -7,6 +7,7 @@
 package session // import "go.mongodb.org/mongo-driver/x/mongo/driver/session"
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -15,6 +16,8 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/address"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/description"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/uuid"
 )
@@ -40,6 +43,12 @@ var ErrCommitAfterAbort = errors.New("cannot call commitTransaction after callin
 // ErrUnackWCUnsupported is returned if an unacknowledged write concern is supported for a transaciton.
 var ErrUnackWCUnsupported = errors.New("transactions do not support unacknowledged write concerns")
 
+// ErrSnapshotCausalConsistency is returned if a session is created with both snapshot reads and causal consistency.
+var ErrSnapshotCausalConsistency = errors.New("causal consistency and snapshot reads cannot both be enabled for a session")
+
+// ErrSnapshotTransaction is returned if a transaction is started in a snapshot session.
+var ErrSnapshotTransaction = errors.New("transactions are not supported in snapshot sessions")
+
 // Type describes the type of the session
 type Type uint8
 
@@ -75,6 +84,11 @@ type Client struct {
 	Aborting       bool
 	RetryWrite     bool
 	RetryRead      bool
+	Snapshot       bool
+
+	// SnapshotTime is the cluster time of the snapshot used by all reads in a snapshot session. It is set from the
+	// response to the first read.
+	SnapshotTime *primitive.Timestamp
 
 	// options for the current transaction
 	// most recently set by transactionopt
@@ -93,6 +107,26 @@ type Client struct {
 	state         state
 	PinnedServer  *description.Server
 	RecoveryToken bson.Raw
+
+	// PinnedConnection is the connection a transaction is pinned to when the deployment is behind a load balancer.
+	PinnedConnection LoadBalancedTransactionConnection
+}
+
+// LoadBalancedTransactionConnection represents a connection that is pinned by a Client because it is being used to
+// execute a transaction against a load balanced deployment. It mirrors driver.PinnedConnection and exists so
+// transactions can be pinned without an import cycle between this package and the driver package.
+type LoadBalancedTransactionConnection interface {
+	// Methods from driver.Connection.
+	WriteWireMessage(context.Context, []byte) error
+	ReadWireMessage(ctx context.Context, dst []byte) ([]byte, error)
+	Description() description.Server
+	Close() error
+	ID() string
+	Address() address.Address
+
+	// Methods from driver.PinnedConnection that are needed to manage a transaction's pin.
+	PinToTransaction() error
+	UnpinFromTransaction() error
 }
 
 func getClusterTime(clusterTime bson.Raw) (uint32, uint32) {
@@ -144,6 +178,17 @@ func NewClientSession(pool *Pool, clientID uuid.UUID, sessionType Type, opts ...
 	if mergedOpts.CausalConsistency != nil {
 		c.Consistent = *mergedOpts.CausalConsistency
 	}
+	if mergedOpts.Snapshot != nil {
+		c.Snapshot = *mergedOpts.Snapshot
+		// Reads in a snapshot session all use the same point in time, so they are not causally consistent with
+		// other operations unless causal consistency was explicitly requested, which is an error.
+		if c.Snapshot {
+			if mergedOpts.CausalConsistency != nil && *mergedOpts.CausalConsistency {
+				return nil, ErrSnapshotCausalConsistency
+			}
+			c.Consistent = false
+		}
+	}
 	if mergedOpts.DefaultReadPreference != nil {
 		c.transactionRp = mergedOpts.DefaultReadPreference
 	}
@@ -221,6 +266,28 @@ func (c *Client) UpdateRecoveryToken(response bson.Raw) {
 	c.RecoveryToken = token.Document()
 }
 
+// UpdateSnapshotTime sets the SnapshotTime of a snapshot session from the atClusterTime field of the response if it
+// has not been set yet. The field is either at the top level of the response or in its cursor document.
+func (c *Client) UpdateSnapshotTime(response bsoncore.Document) {
+	if c == nil || !c.Snapshot || c.SnapshotTime != nil {
+		return
+	}
+
+	atClusterTime, err := response.LookupErr("atClusterTime")
+	if err != nil {
+		atClusterTime, err = response.LookupErr("cursor", "atClusterTime")
+	}
+	if err != nil {
+		return
+	}
+
+	t, i, ok := atClusterTime.TimestampOK()
+	if !ok {
+		return
+	}
+	c.SnapshotTime = &primitive.Timestamp{T: t, I: i}
+}
+
 // ClearPinnedServer sets the PinnedServer to nil.
 func (c *Client) ClearPinnedServer() {
 	if c != nil {
@@ -228,6 +295,28 @@ func (c *Client) ClearPinnedServer() {
 	}
 }
 
+// ClearPinnedResources clears the PinnedServer and unpins the PinnedConnection, if there is one.
+func (c *Client) ClearPinnedResources() {
+	if c == nil {
+		return
+	}
+
+	c.PinnedServer = nil
+	c.UnpinConnection()
+}
+
+// UnpinConnection releases the transaction's reference to the PinnedConnection, if there is one, and sets it to nil.
+// The connection is returned to its pool once no cursors reference it.
+func (c *Client) UnpinConnection() {
+	if c == nil || c.PinnedConnection == nil {
+		return
+	}
+
+	_ = c.PinnedConnection.UnpinFromTransaction()
+	_ = c.PinnedConnection.Close()
+	c.PinnedConnection = nil
+}
+
 // EndSession ends the session.
 func (c *Client) EndSession() {
 	if c.Terminated {
@@ -235,6 +324,7 @@ func (c *Client) EndSession() {
 	}
 
 	c.Terminated = true
+	c.UnpinConnection()
 	c.pool.ReturnSession(c.Server)
 
 	return
@@ -264,6 +354,9 @@ func (c *Client) TransactionCommitted() bool {
 // CheckStartTransaction checks to see if allowed to start transaction and returns
 // an error if not allowed
 func (c *Client) CheckStartTransaction() error {
+	if c.Snapshot {
+		return ErrSnapshotTransaction
+	}
 	if c.state == InProgress || c.state == Starting {
 		return ErrTransactInProgress
 	}
@@ -310,7 +403,7 @@ func (c *Client) StartTransaction(opts *TransactionOptions) error {
 	}
 
 	c.state = Starting
-	c.PinnedServer = nil
+	c.ClearPinnedResources()
 	return nil
 }
 
@@ -400,4 +493,5 @@ func (c *Client) clearTransactionOpts() {
 	c.CurrentRc = nil
 	c.PinnedServer = nil
 	c.RecoveryToken = nil
+	c.UnpinConnection()
 }
@@ -192,4 +192,38 @@ func TestClientSession(t *testing.T) {
 			t.Errorf("expected error, got %v", err)
 		}
 	})
+	t.Run("TestSnapshot", func(t *testing.T) {
+		snapshot := true
+		id, _ := uuid.New()
+		_, err := NewClientSession(&Pool{}, id, Explicit, &ClientOptions{CausalConsistency: &consistent, Snapshot: &snapshot})
+		if err != ErrSnapshotCausalConsistency {
+			t.Errorf("expected error %v, got %v", ErrSnapshotCausalConsistency, err)
+		}
+
+		sess, err := NewClientSession(&Pool{}, id, Explicit, &ClientOptions{Snapshot: &snapshot})
+		require.Nil(t, err, "Unexpected error")
+		if sess.Consistent {
+			t.Errorf("expected snapshot session not to be causally consistent")
+		}
+
+		err = sess.StartTransaction(nil)
+		if err != ErrSnapshotTransaction {
+			t.Errorf("expected error %v, got %v", ErrSnapshotTransaction, err)
+		}
+
+		sess.UpdateSnapshotTime(bsoncore.BuildDocument(nil, bsoncore.AppendInt32Element(nil, "ok", 1)))
+		if sess.SnapshotTime != nil {
+			t.Errorf("expected snapshot time to be nil, got %v", sess.SnapshotTime)
+		}
+
+		// The time of the first response that has one is kept.
+		cursorResponse := bsoncore.BuildDocument(nil, bsoncore.AppendDocumentElement(nil, "cursor",
+			bsoncore.BuildDocument(nil, bsoncore.AppendTimestampElement(nil, "atClusterTime", 10, 5))))
+		sess.UpdateSnapshotTime(cursorResponse)
+		compareOperationTimes(t, &primitive.Timestamp{T: 10, I: 5}, sess.SnapshotTime)
+
+		distinctResponse := bsoncore.BuildDocument(nil, bsoncore.AppendTimestampElement(nil, "atClusterTime", 20, 1))
+		sess.UpdateSnapshotTime(distinctResponse)
+		compareOperationTimes(t, &primitive.Timestamp{T: 10, I: 5}, sess.SnapshotTime)
+	})
 }
@@ -21,6 +21,7 @@ type ClientOptions struct {
 	DefaultWriteConcern   *writeconcern.WriteConcern
 	DefaultReadPreference *readpref.ReadPref
 	DefaultMaxCommitTime  *time.Duration
+	Snapshot              *bool
 }
 
 // TransactionOptions represents all possible options for starting a transaction in a session.
@@ -52,6 +53,9 @@ func mergeClientOptions(opts ...*ClientOptions) *ClientOptions {
 		if opt.DefaultMaxCommitTime != nil {
 			c.DefaultMaxCommitTime = opt.DefaultMaxCommitTime
 		}
+		if opt.Snapshot != nil {
+			c.Snapshot = opt.Snapshot
+		}
 	}
 
 	return c
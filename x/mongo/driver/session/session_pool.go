@@ -28,6 +28,10 @@ type Pool struct {
 	timeout  uint32
 	mutex    sync.Mutex // mutex to protect list and sessionTimeout
 
+	// loadBalanced is true if the topology is load balanced. The session timeout of a load balanced topology is not
+	// known because the load balancer is never monitored.
+	loadBalanced bool
+
 	checkedOut int // number of sessions checked out of pool
 }
 
@@ -55,11 +59,22 @@ func (p *Pool) updateTimeout() {
 	select {
 	case newDesc := <-p.descChan:
 		p.timeout = newDesc.SessionTimeoutMinutes
+		p.loadBalanced = newDesc.Kind == description.LoadBalanced
 	default:
 		// no new description waiting
 	}
 }
 
+// expired returns whether ss has expired. A timeout of 0 in a load balanced topology means that the timeout is
+// unknown, so the session is assumed to be valid and is left for the server to expire.
+// assumes caller has mutex to protect the pool
+func (p *Pool) expired(ss *Server) bool {
+	if p.loadBalanced && p.timeout == 0 {
+		return false
+	}
+	return ss.expired(p.timeout)
+}
+
 // GetSession retrieves an unexpired session from the pool.
 func (p *Pool) GetSession() (*Server, error) {
 	p.mutex.Lock() // prevent changing the linked list while seeing if sessions have expired
@@ -73,7 +88,7 @@ func (p *Pool) GetSession() (*Server, error) {
 	p.updateTimeout()
 	for p.head != nil {
 		// pull session from head of queue and return if it is valid for at least 1 more minute
-		if p.head.expired(p.timeout) {
+		if p.expired(p.head.Server) {
 			p.head = p.head.next
 			continue
 		}
@@ -112,7 +127,7 @@ func (p *Pool) ReturnSession(ss *Server) {
 	p.updateTimeout()
 	// check sessions at end of queue for expired
 	// stop checking after hitting the first valid session
-	for p.tail != nil && p.tail.expired(p.timeout) {
+	for p.tail != nil && p.expired(p.tail.Server) {
 		if p.tail.prev != nil {
 			p.tail.prev.next = nil
 		}
@@ -120,7 +135,7 @@ func (p *Pool) ReturnSession(ss *Server) {
 	}
 
 	// session expired
-	if ss.expired(p.timeout) {
+	if p.expired(ss) {
 		return
 	}
 
@@ -65,4 +65,24 @@ func TestSessionPool(t *testing.T) {
 		assert.False(t, bytes.Equal(sess.SessionID, firstID), "first expired session was not removed")
 		assert.False(t, bytes.Equal(sess.SessionID, secondID), "second expired session was not removed")
 	})
+
+	t.Run("load balanced sessions are reused", func(t *testing.T) {
+		// The load balancer is not monitored, so the topology description never contains a session timeout.
+		descChan := make(chan description.Topology, 1)
+		descChan <- description.Topology{Kind: description.LoadBalanced}
+		p := NewPool(descChan)
+
+		first, err := p.GetSession()
+		assert.Nil(t, err, "GetSession error: %v", err)
+		firstID := first.SessionID
+		p.ReturnSession(first)
+
+		ids := p.IDSlice()
+		assert.Equal(t, 1, len(ids), "expected 1 session in pool, got %v", len(ids))
+
+		sess, err := p.GetSession()
+		assert.Nil(t, err, "GetSession error: %v", err)
+		assert.True(t, bytes.Equal(sess.SessionID, firstID),
+			"session ID mismatch; expected %s, got %s", firstID, sess.SessionID)
+	})
 }
@@ -156,6 +156,10 @@ func (c *connection) connect(ctx context.Context) {
 	if c.config.descCallback != nil {
 		c.config.descCallback(c.desc)
 	}
+	// Connections to a load balancer take the generation of their serviceId, which is only known after the handshake.
+	if c.desc.ServiceID != nil && c.pool != nil {
+		c.generation = c.pool.getGeneration(c.desc.ServiceID)
+	}
 	if len(c.desc.Compression) > 0 {
 	clientMethodLoop:
 		for _, method := range c.config.compressors {
@@ -325,6 +329,12 @@ func (c *connection) expired() bool {
 	return atomic.LoadInt32(&c.connected) == disconnected
 }
 
+// setSocketTimeout sets the read and write timeouts for the connection.
+func (c *connection) setSocketTimeout(timeout time.Duration) {
+	c.readTimeout = timeout
+	c.writeTimeout = timeout
+}
+
 func (c *connection) bumpIdleDeadline() {
 	if c.idleTimeout > 0 {
 		c.idleDeadline.Store(time.Now().Add(c.idleTimeout))
@@ -376,11 +386,13 @@ type Connection struct {
 	*connection
 	s *Server
 
-	mu sync.RWMutex
+	mu       sync.RWMutex
+	refCount int // the number of cursors and transactions this connection is pinned to
 }
 
 var _ driver.Connection = (*Connection)(nil)
 var _ driver.Expirable = (*Connection)(nil)
+var _ driver.PinnedConnection = (*Connection)(nil)
 
 // WriteWireMessage handles writing a wire message to the underlying connection.
 func (c *Connection) WriteWireMessage(ctx context.Context, wm []byte) error {
@@ -447,11 +459,11 @@ func (c *Connection) Description() description.Server {
 }
 
 // Close returns this connection to the connection pool. This method may not closeConnection the underlying
-// socket.
+// socket. If the connection is pinned to a cursor or transaction, Close is a no-op.
 func (c *Connection) Close() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	if c.connection == nil {
+	if c.connection == nil || c.refCount > 0 {
 		return nil
 	}
 
@@ -474,6 +486,51 @@ func (c *Connection) Expire() error {
 	return err
 }
 
+// PinToCursor updates this connection to reflect that it is pinned to a cursor.
+func (c *Connection) PinToCursor() error {
+	return c.pin("cursor")
+}
+
+// PinToTransaction updates this connection to reflect that it is pinned to a transaction.
+func (c *Connection) PinToTransaction() error {
+	return c.pin("transaction")
+}
+
+// UnpinFromCursor updates this connection to reflect that it is no longer pinned to a cursor.
+func (c *Connection) UnpinFromCursor() error {
+	return c.unpin("cursor")
+}
+
+// UnpinFromTransaction updates this connection to reflect that it is no longer pinned to a transaction.
+func (c *Connection) UnpinFromTransaction() error {
+	return c.unpin("transaction")
+}
+
+func (c *Connection) pin(reason string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.connection == nil {
+		return fmt.Errorf("attempted to pin a connection for a %s, but the connection has already been returned to the pool", reason)
+	}
+
+	c.refCount++
+	return nil
+}
+
+func (c *Connection) unpin(reason string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.connection == nil {
+		return fmt.Errorf("attempted to unpin a connection from a %s, but the connection has already been returned to the pool", reason)
+	}
+	if c.refCount == 0 {
+		return fmt.Errorf("attempted to unpin a connection from a %s, but the connection is not pinned", reason)
+	}
+
+	c.refCount--
+	return nil
+}
+
 // Alive returns if the connection is still alive.
 func (c *Connection) Alive() bool {
 	return c.connection != nil
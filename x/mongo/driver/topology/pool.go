@@ -13,6 +13,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/address"
 	"golang.org/x/sync/semaphore"
@@ -66,6 +67,10 @@ type pool struct {
 	generation uint64        // must be accessed using atomic package
 	monitor    *event.PoolMonitor
 
+	// serviceGenerations holds the generation for each serviceId when the pool is connected to a load balancer.
+	serviceGenerations     map[primitive.ObjectID]uint64
+	serviceGenerationsLock sync.Mutex
+
 	connected int32 // Must be accessed using the sync/atomic package.
 	nextid    uint64
 	opened    map[uint64]*connection // opened holds all of the currently open connections.
@@ -157,6 +162,8 @@ func newPool(config poolConfig, connOpts ...ConnectionOption) (*pool, error) {
 		opened:    make(map[uint64]*connection),
 		opts:      opts,
 		sem:       semaphore.NewWeighted(int64(maxConns)),
+
+		serviceGenerations: make(map[primitive.ObjectID]uint64),
 	}
 
 	// we do not pass in config.MaxPoolSize because we manage the max size at this level rather than the resource pool level
@@ -190,9 +197,22 @@ func newPool(config poolConfig, connOpts ...ConnectionOption) (*pool, error) {
 	return pool, nil
 }
 
-// stale checks if a given connection's generation is below the generation of the pool
+// stale checks if a given connection's generation is below the generation of the pool. Connections to a load balancer
+// are compared against the generation for their serviceId.
 func (p *pool) stale(c *connection) bool {
-	return c == nil || c.generation < atomic.LoadUint64(&p.generation)
+	return c == nil || c.generation < p.getGeneration(c.desc.ServiceID)
+}
+
+// getGeneration returns the current generation for the given serviceId, or the generation of the whole pool if
+// serviceID is nil.
+func (p *pool) getGeneration(serviceID *primitive.ObjectID) uint64 {
+	if serviceID == nil {
+		return atomic.LoadUint64(&p.generation)
+	}
+
+	p.serviceGenerationsLock.Lock()
+	defer p.serviceGenerationsLock.Unlock()
+	return p.serviceGenerations[*serviceID]
 }
 
 // connect puts the pool into the connected state, allowing it to be used and will allow items to begin being processed from the wait queue
@@ -520,13 +540,23 @@ func (p *pool) put(c *connection) error {
 	return nil
 }
 
-// clear clears the pool by incrementing the generation
-func (p *pool) clear() {
+// clear clears the pool by incrementing the generation. If serviceID is non-nil, only connections to that service are
+// cleared.
+func (p *pool) clear(serviceID *primitive.ObjectID) {
 	if p.monitor != nil {
 		p.monitor.Event(&event.PoolEvent{
-			Type:    event.PoolCleared,
-			Address: p.address.String(),
+			Type:      event.PoolCleared,
+			Address:   p.address.String(),
+			ServiceID: serviceID,
 		})
 	}
-	atomic.AddUint64(&p.generation, 1)
+
+	if serviceID == nil {
+		atomic.AddUint64(&p.generation, 1)
+		return
+	}
+
+	p.serviceGenerationsLock.Lock()
+	p.serviceGenerations[*serviceID]++
+	p.serviceGenerationsLock.Unlock()
 }
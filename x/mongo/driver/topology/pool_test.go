@@ -9,8 +9,11 @@ import (
 	"testing"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/address"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/description"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/operation"
 )
 
@@ -662,6 +665,39 @@ func TestPool(t *testing.T) {
 				t.Errorf("Pool should have 1 total connection. got %d; want %d", p.conns.totalSize, 1)
 			}
 		})
+		t.Run("pinned connection is not returned to pool until unpinned", func(t *testing.T) {
+			cleanup := make(chan struct{})
+			defer close(cleanup)
+			addr := bootstrapConnections(t, 1, func(nc net.Conn) {
+				<-cleanup
+				_ = nc.Close()
+			})
+			pc := poolConfig{
+				Address: address.Address(addr.String()),
+			}
+			p, err := newPool(pc)
+			noerr(t, err)
+			err = p.connect()
+			noerr(t, err)
+			c, err := p.get(context.Background())
+			noerr(t, err)
+			c1 := &Connection{connection: c}
+
+			noerr(t, c1.PinToCursor())
+			noerr(t, c1.PinToTransaction())
+			noerr(t, c1.Close())
+			noerr(t, c1.UnpinFromCursor())
+			noerr(t, c1.Close())
+			assert.Equal(t, uint64(0), p.conns.size, "expected no connections in pool, got %d", p.conns.size)
+
+			noerr(t, c1.UnpinFromTransaction())
+			err = c1.UnpinFromTransaction()
+			assert.NotNil(t, err, "expected error unpinning connection that is not pinned, got nil")
+			noerr(t, c1.Close())
+			assert.Equal(t, uint64(1), p.conns.size, "expected 1 connection in pool, got %d", p.conns.size)
+			err = c1.PinToCursor()
+			assert.NotNil(t, err, "expected error pinning connection that was returned to the pool, got nil")
+		})
 		t.Run("close does not panic if expires before connected", func(t *testing.T) {
 			cleanup := make(chan struct{})
 			defer close(cleanup)
@@ -688,6 +724,47 @@ func TestPool(t *testing.T) {
 	})
 }
 
+func TestPoolClear(t *testing.T) {
+	t.Run("clearing a serviceId only marks its connections as stale", func(t *testing.T) {
+		cleanup := make(chan struct{})
+		defer close(cleanup)
+		addr := bootstrapConnections(t, 2, func(nc net.Conn) {
+			<-cleanup
+			_ = nc.Close()
+		})
+
+		serviceIDs := []primitive.ObjectID{primitive.NewObjectID(), primitive.NewObjectID()}
+		var handshakes int32
+		handshaker := &testHandshaker{
+			getDescription: func(_ context.Context, addr address.Address, _ driver.Connection) (description.Server, error) {
+				idx := atomic.AddInt32(&handshakes, 1) - 1
+				return description.Server{Addr: addr, Kind: description.Mongos, ServiceID: &serviceIDs[idx]}, nil
+			},
+		}
+		pc := poolConfig{
+			Address: address.Address(addr.String()),
+		}
+		p, err := newPool(pc, WithHandshaker(func(Handshaker) Handshaker { return handshaker }))
+		noerr(t, err)
+		noerr(t, p.connect())
+		defer func() { _ = p.disconnect(context.Background()) }()
+
+		c1, err := p.get(context.Background())
+		noerr(t, err)
+		c2, err := p.get(context.Background())
+		noerr(t, err)
+
+		p.clear(&serviceIDs[0])
+		assert.True(t, p.stale(c1), "expected connection to cleared service to be stale")
+		assert.False(t, p.stale(c2), "expected connection to other service not to be stale")
+		assert.Equal(t, uint64(0), atomic.LoadUint64(&p.generation), "expected pool generation to be unchanged, got %d",
+			atomic.LoadUint64(&p.generation))
+
+		p.clear(nil)
+		assert.False(t, p.stale(c2), "expected clearing the whole pool not to affect load balanced connections")
+	})
+}
+
 type sleepDialer struct {
 	Dialer
 }
@@ -0,0 +1,172 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package topology
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/operation"
+)
+
+// rttAlpha is the weight given to a new sample when updating the average RTT.
+const rttAlpha = 0.2
+
+type rttConfig struct {
+	interval           time.Duration
+	createConnectionFn func(context.Context) (*connection, error)
+	createOperationFn  func(driver.Connection) *operation.IsMaster
+}
+
+// rttMonitor keeps the average round trip time to a server. When a server is monitored with awaitable isMaster
+// commands, the time a heartbeat takes depends on when the server's state changes, so the RTT is measured by a
+// separate goroutine that runs a regular isMaster on its own connection.
+type rttMonitor struct {
+	mu            sync.Mutex // guards averageRTT, averageRTTSet, started, and conn
+	averageRTT    time.Duration
+	averageRTTSet bool
+	started       bool
+	conn          *connection
+
+	cfg      *rttConfig
+	ctx      context.Context
+	cancelFn context.CancelFunc
+	closeWg  sync.WaitGroup
+}
+
+func newRTTMonitor(cfg *rttConfig) *rttMonitor {
+	r := &rttMonitor{
+		cfg: cfg,
+	}
+	r.reset()
+	return r
+}
+
+// reset prepares the monitor to be started again after it has been disconnected. The average RTT is discarded because
+// the server's description is reset when the server is reconnected. It must not be called while the monitoring
+// goroutine is running.
+func (r *rttMonitor) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ctx, r.cancelFn = context.WithCancel(context.Background())
+	r.started = false
+	r.conn = nil
+	r.averageRTT = 0
+	r.averageRTTSet = false
+}
+
+// connect starts the monitoring goroutine. It is a no-op if the goroutine has already been started.
+func (r *rttMonitor) connect() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.started {
+		return
+	}
+
+	r.started = true
+	r.closeWg.Add(1)
+	go r.start()
+}
+
+// disconnect stops the monitoring goroutine and waits for it to exit. The monitor can be started again after calling
+// reset.
+func (r *rttMonitor) disconnect() {
+	r.cancelFn()
+
+	// Reads are not interrupted by context cancellation, so the connection is closed to stop an isMaster that is in
+	// progress.
+	r.mu.Lock()
+	if r.conn != nil {
+		_ = r.conn.close()
+	}
+	r.mu.Unlock()
+
+	r.closeWg.Wait()
+}
+
+func (r *rttMonitor) start() {
+	defer r.closeWg.Done()
+	ticker := time.NewTicker(r.cfg.interval)
+	defer ticker.Stop()
+
+	defer func() {
+		r.mu.Lock()
+		if r.conn != nil {
+			_ = r.conn.close()
+		}
+		r.mu.Unlock()
+	}()
+
+	for {
+		r.runIsMaster()
+
+		select {
+		case <-ticker.C:
+		case <-r.ctx.Done():
+			return
+		}
+	}
+}
+
+// runIsMaster runs an isMaster on the monitor's connection, creating the connection first if needed, and adds its
+// duration as a sample.
+func (r *rttMonitor) runIsMaster() {
+	r.mu.Lock()
+	conn := r.conn
+	r.mu.Unlock()
+
+	if conn == nil || conn.expired() {
+		var err error
+		conn, err = r.cfg.createConnectionFn(r.ctx)
+		if err != nil {
+			return
+		}
+
+		r.mu.Lock()
+		r.conn = conn
+		r.mu.Unlock()
+
+		// The monitor could have been disconnected while the connection was being created, in which case the
+		// connection would not have been closed.
+		if r.ctx.Err() != nil {
+			_ = conn.close()
+			return
+		}
+	}
+
+	start := time.Now()
+	err := r.cfg.createOperationFn(initConnection{conn}).Execute(r.ctx)
+	if err != nil {
+		// A new connection is created for the next sample.
+		_ = conn.close()
+		return
+	}
+	r.addSample(time.Since(start))
+}
+
+// addSample adds a round trip time sample to the exponentially weighted moving average.
+func (r *rttMonitor) addSample(rtt time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.averageRTTSet {
+		r.averageRTT = rtt
+		r.averageRTTSet = true
+		return
+	}
+	r.averageRTT = time.Duration(rttAlpha*float64(rtt) + (1-rttAlpha)*float64(r.averageRTT))
+}
+
+// getRTT returns the average round trip time.
+func (r *rttMonitor) getRTT() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.averageRTT
+}
@@ -0,0 +1,176 @@
+// Copyright (C) MongoDB, Inc. 2020-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package topology
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/address"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/operation"
+)
+
+// rttTestServer accepts connections for an rttMonitor over in-memory pipes. Every command read from a connection is
+// reported on the requests channel and, unless the server is unresponsive, answered with an isMaster reply.
+type rttTestServer struct {
+	requests     chan struct{}
+	dials        int32
+	unresponsive bool
+}
+
+func newRTTTestServer(unresponsive bool) *rttTestServer {
+	return &rttTestServer{
+		requests:     make(chan struct{}, 100),
+		unresponsive: unresponsive,
+	}
+}
+
+func (s *rttTestServer) config(interval time.Duration) *rttConfig {
+	return &rttConfig{
+		interval:           interval,
+		createConnectionFn: s.createConnection,
+		createOperationFn: func(conn driver.Connection) *operation.IsMaster {
+			return operation.NewIsMaster().Deployment(driver.SingleConnectionDeployment{C: conn})
+		},
+	}
+}
+
+func (s *rttTestServer) createConnection(ctx context.Context) (*connection, error) {
+	atomic.AddInt32(&s.dials, 1)
+	client, server := net.Pipe()
+	go s.serve(server)
+
+	conn, err := newConnection(ctx, address.Address("localhost:27017"), WithDialer(func(Dialer) Dialer {
+		return DialerFunc(func(context.Context, string, string) (net.Conn, error) { return client, nil })
+	}))
+	if err != nil {
+		return nil, err
+	}
+	conn.connect(ctx)
+	return conn, conn.wait()
+}
+
+func (s *rttTestServer) serve(nc net.Conn) {
+	defer func() {
+		_ = nc.Close()
+	}()
+
+	for {
+		var sizeBuf [4]byte
+		if _, err := io.ReadFull(nc, sizeBuf[:]); err != nil {
+			return
+		}
+		size := int32(binary.LittleEndian.Uint32(sizeBuf[:]))
+		if _, err := io.ReadFull(nc, make([]byte, size-4)); err != nil {
+			return
+		}
+		s.requests <- struct{}{}
+
+		if s.unresponsive {
+			continue
+		}
+		if _, err := nc.Write(makeIsMasterReply()); err != nil {
+			return
+		}
+	}
+}
+
+// waitForRequests waits until n commands have been read by the server.
+func (s *rttTestServer) waitForRequests(t *testing.T, n int) {
+	t.Helper()
+
+	timer := time.NewTimer(5 * time.Second)
+	defer timer.Stop()
+	for i := 0; i < n; i++ {
+		select {
+		case <-s.requests:
+		case <-timer.C:
+			t.Fatalf("timed out waiting for request %d of %d", i+1, n)
+		}
+	}
+}
+
+// disconnectWithTimeout calls disconnect on the monitor and fails the test if it does not return within 5 seconds.
+func disconnectWithTimeout(t *testing.T, r *rttMonitor) {
+	t.Helper()
+
+	done := make(chan struct{})
+	go func() {
+		r.disconnect()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for rttMonitor to disconnect")
+	}
+}
+
+func TestRTTMonitor(t *testing.T) {
+	t.Run("samples immediately and then at the interval", func(t *testing.T) {
+		server := newRTTTestServer(false)
+		rtt := newRTTMonitor(server.config(time.Hour))
+		rtt.connect()
+		rtt.connect()
+		defer disconnectWithTimeout(t, rtt)
+
+		server.waitForRequests(t, 1)
+		select {
+		case <-server.requests:
+			t.Fatal("expected no isMaster before the interval elapsed")
+		case <-time.After(50 * time.Millisecond):
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&server.dials), "expected 1 connection to be created")
+	})
+	t.Run("samples repeatedly on the same connection", func(t *testing.T) {
+		server := newRTTTestServer(false)
+		rtt := newRTTMonitor(server.config(10 * time.Millisecond))
+		rtt.connect()
+		defer disconnectWithTimeout(t, rtt)
+
+		server.waitForRequests(t, 3)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&server.dials), "expected 1 connection to be created")
+
+		// The sample for the last isMaster is added after the reply is read, so the average can still be unset for a
+		// moment after the server reads the third request.
+		deadline := time.Now().Add(5 * time.Second)
+		for rtt.getRTT() == 0 && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		assert.NotEqual(t, time.Duration(0), rtt.getRTT(), "expected average RTT to be set")
+	})
+	t.Run("disconnect interrupts an in-progress isMaster", func(t *testing.T) {
+		server := newRTTTestServer(true)
+		rtt := newRTTMonitor(server.config(10 * time.Millisecond))
+		rtt.connect()
+
+		server.waitForRequests(t, 1)
+		disconnectWithTimeout(t, rtt)
+		assert.Equal(t, time.Duration(0), rtt.getRTT(), "expected average RTT to be unset")
+	})
+	t.Run("can be restarted after disconnect", func(t *testing.T) {
+		server := newRTTTestServer(false)
+		rtt := newRTTMonitor(server.config(time.Hour))
+		rtt.connect()
+		server.waitForRequests(t, 1)
+		disconnectWithTimeout(t, rtt)
+
+		rtt.reset()
+		rtt.connect()
+		defer disconnectWithTimeout(t, rtt)
+
+		server.waitForRequests(t, 1)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&server.dials), "expected 2 connections to be created")
+	})
+}
@@ -93,8 +93,7 @@ type Server struct {
 	// description related fields
 	desc                   atomic.Value // holds a description.Server
 	updateTopologyCallback atomic.Value
-	averageRTTSet          bool
-	averageRTT             time.Duration
+	rttMonitor             *rttMonitor
 
 	// subscriber related fields
 	subLock             sync.Mutex
@@ -141,6 +140,22 @@ func NewServer(addr address.Address, opts ...ServerOption) (*Server, error) {
 		subscribers: make(map[uint64]chan description.Server),
 	}
 	s.desc.Store(description.NewDefaultServer(addr))
+	s.rttMonitor = newRTTMonitor(&rttConfig{
+		interval: s.cfg.heartbeatInterval,
+		createConnectionFn: func(ctx context.Context) (*connection, error) {
+			conn, err := newConnection(ctx, s.address, s.heartbeatConnectionOpts()...)
+			if err != nil {
+				return nil, err
+			}
+			conn.connect(ctx)
+			return conn, conn.wait()
+		},
+		createOperationFn: func(conn driver.Connection) *operation.IsMaster {
+			return operation.NewIsMaster().
+				ServerAPI(s.cfg.serverAPI).
+				Deployment(driver.SingleConnectionDeployment{conn})
+		},
+	})
 
 	callback := func(desc description.Server) { s.updateDescription(desc) }
 	pc := poolConfig{
@@ -151,7 +166,12 @@ func NewServer(addr address.Address, opts ...ServerOption) (*Server, error) {
 		PoolMonitor: cfg.poolMonitor,
 	}
 
-	s.pool, err = newPool(pc, withServerDescriptionCallback(callback, cfg.connectionOpts...)...)
+	connOpts := cfg.connectionOpts
+	// The description of a load balancer never changes, so handshakes must not update it.
+	if !cfg.loadBalanced {
+		connOpts = withServerDescriptionCallback(callback, connOpts...)
+	}
+	s.pool, err = newPool(pc, connOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -164,10 +184,22 @@ func (s *Server) Connect(updateCallback updateTopologyCallback) error {
 	if !atomic.CompareAndSwapInt32(&s.connectionstate, disconnected, connected) {
 		return ErrServerConnected
 	}
-	s.desc.Store(description.NewDefaultServer(s.address))
+	desc := description.NewDefaultServer(s.address)
+	if s.cfg.loadBalanced {
+		desc.Kind = description.LoadBalancer
+	}
+	s.desc.Store(desc)
 	s.updateTopologyCallback.Store(updateCallback)
-	go s.update()
-	s.closewg.Add(1)
+	s.disconnecting = make(chan struct{})
+	// The RTT monitor is stopped by Disconnect, so it is reset to allow the server to be reconnected.
+	s.rttMonitor.reset()
+	s.publishServerOpeningEvent()
+
+	// A load balancer is not monitored, so no heartbeats are sent to it.
+	if !s.cfg.loadBalanced {
+		go s.update()
+		s.closewg.Add(1)
+	}
 	return s.pool.connect()
 }
 
@@ -187,16 +219,15 @@ func (s *Server) Disconnect(ctx context.Context) error {
 
 	s.updateTopologyCallback.Store((updateTopologyCallback)(nil))
 
-	// For every call to Connect there must be at least 1 goroutine that is
-	// waiting on the done channel.
-	select {
-	case <-ctx.Done():
-		// signal a disconnect and still wait for receiver of done
-		// to finish.
-		close(s.disconnecting)
+	// Closing the disconnecting channel cancels any heartbeat that is in progress, including an awaitable one that
+	// would otherwise block until the server's state changes. For every call to Connect there must be at least 1
+	// goroutine that is waiting on the done channel, unless the server is a load balancer and is not being monitored.
+	close(s.disconnecting)
+	if !s.cfg.loadBalanced {
 		s.done <- struct{}{}
-	case s.done <- struct{}{}:
 	}
+	s.rttMonitor.disconnect()
+
 	err := s.pool.disconnect(ctx)
 	if err != nil {
 		return err
@@ -204,6 +235,7 @@ func (s *Server) Disconnect(ctx context.Context) error {
 
 	s.closewg.Wait()
 	atomic.StoreInt32(&s.connectionstate, disconnected)
+	s.publishServerClosedEvent()
 
 	return nil
 }
@@ -213,7 +245,7 @@ func (s *Server) Connection(ctx context.Context) (driver.Connection, error) {
 
 	if s.pool.monitor != nil {
 		s.pool.monitor.Event(&event.PoolEvent{
-			Type:    "ConnectionCheckOutStarted",
+			Type:    event.GetStarted,
 			Address: s.pool.address.String(),
 		})
 	}
@@ -225,7 +257,8 @@ func (s *Server) Connection(ctx context.Context) (driver.Connection, error) {
 	conn, err := s.pool.get(ctx)
 	if err != nil {
 		wrappedConnErr := unwrapConnectionError(err)
-		if wrappedConnErr == nil {
+		// The serviceId is unknown if the handshake failed, so the pool of a load balancer cannot be cleared.
+		if wrappedConnErr == nil || s.cfg.loadBalanced {
 			return nil, err
 		}
 
@@ -233,7 +266,7 @@ func (s *Server) Connection(ctx context.Context) (driver.Connection, error) {
 		// error, we should set the description.Server appropriately.
 		desc := description.NewServerFromError(s.address, wrappedConnErr)
 		s.updateDescription(desc)
-		s.pool.clear()
+		s.pool.clear(nil)
 
 		return nil, err
 	}
@@ -295,7 +328,12 @@ func (s *Server) RequestImmediateCheck() {
 }
 
 // ProcessError handles SDAM error handling and implements driver.ErrorProcessor.
-func (s *Server) ProcessError(err error) {
+func (s *Server) ProcessError(err error, conn driver.Connection) {
+	if s.cfg.loadBalanced {
+		s.processLoadBalancedError(err, conn)
+		return
+	}
+
 	desc := s.Description()
 	// Invalidate server description if not master or node recovering error occurs.
 	// These errors can be reported as a command error or a write concern error.
@@ -305,7 +343,7 @@ func (s *Server) ProcessError(err error) {
 		// If the node is shutting down or is older than 4.2, we synchronously clear the pool
 		if cerr.NodeIsShuttingDown() || desc.WireVersion == nil || desc.WireVersion.Max < 8 {
 			s.RequestImmediateCheck()
-			s.pool.clear()
+			s.pool.clear(nil)
 		}
 		return
 	}
@@ -315,7 +353,7 @@ func (s *Server) ProcessError(err error) {
 		// If the node is shutting down or is older than 4.2, we synchronously clear the pool
 		if wcerr.NodeIsShuttingDown() || desc.WireVersion == nil || desc.WireVersion.Max < 8 {
 			s.RequestImmediateCheck()
-			s.pool.clear()
+			s.pool.clear(nil)
 		}
 		return
 	}
@@ -335,7 +373,41 @@ func (s *Server) ProcessError(err error) {
 
 	// updates description to unknown
 	s.updateDescription(description.NewServerFromError(s.address, err))
-	s.pool.clear()
+	s.pool.clear(nil)
+}
+
+// processLoadBalancedError handles errors for a server that is a load balancer. The description of a load balancer
+// never changes, and only the connections to the service that returned the error are cleared.
+func (s *Server) processLoadBalancedError(err error, conn driver.Connection) {
+	if conn == nil || conn.Description().ServiceID == nil {
+		return
+	}
+	serviceID := conn.Description().ServiceID
+
+	// Load balanced deployments require servers that are new enough to only need the pool cleared for state change
+	// errors when the node is shutting down.
+	var clear bool
+	switch tt := err.(type) {
+	case driver.Error:
+		clear = tt.NodeIsShuttingDown()
+	case driver.WriteConcernError:
+		clear = tt.NodeIsShuttingDown()
+	}
+	if !clear {
+		wrappedConnErr := unwrapConnectionError(err)
+		if wrappedConnErr == nil {
+			return
+		}
+		// Ignore transient timeout errors.
+		if netErr, ok := wrappedConnErr.(net.Error); ok && netErr.Timeout() {
+			return
+		}
+		if wrappedConnErr == context.Canceled || wrappedConnErr == context.DeadlineExceeded {
+			return
+		}
+	}
+
+	s.pool.clear(serviceID)
 }
 
 // update handles performing heartbeats and updating any subscribers of the
@@ -348,6 +420,7 @@ func (s *Server) update() {
 	defer rateLimiter.Stop()
 	checkNow := s.checkNow
 	done := s.done
+	disconnecting := s.disconnecting
 
 	var doneOnce bool
 	defer func() {
@@ -363,9 +436,6 @@ func (s *Server) update() {
 	var conn *connection
 	var desc description.Server
 
-	desc, conn = s.heartbeat(nil)
-	s.updateDescription(desc)
-
 	closeServer := func() {
 		doneOnce = true
 		s.subLock.Lock()
@@ -388,6 +458,26 @@ func (s *Server) update() {
 		default:
 		}
 
+		desc, conn = s.heartbeat(conn)
+		select {
+		case <-disconnecting:
+			// The heartbeat was cancelled because the server is disconnecting, so its result is discarded.
+			<-done
+			closeServer()
+			return
+		default:
+		}
+		s.updateDescription(desc)
+
+		// A server that reports a topologyVersion supports the streaming protocol. The next heartbeat either reads the
+		// next streamed response or sends an awaitable isMaster, and the server replies to both as soon as its state
+		// changes, so the next heartbeat is started without waiting. The duration of those heartbeats depends on the
+		// server rather than the network, so the RTT is measured separately.
+		if desc.TopologyVersion != nil {
+			s.rttMonitor.connect()
+			continue
+		}
+
 		select {
 		case <-heartbeatTicker.C:
 		case <-checkNow:
@@ -402,9 +492,6 @@ func (s *Server) update() {
 			closeServer()
 			return
 		}
-
-		desc, conn = s.heartbeat(conn)
-		s.updateDescription(desc)
 	}
 }
 
@@ -423,7 +510,11 @@ func (s *Server) updateDescription(desc description.Server) {
 	if ok && callback != nil {
 		desc = callback(desc)
 	}
+	prev := s.Description()
 	s.desc.Store(desc)
+	if !prev.Equal(desc) {
+		s.publishServerDescriptionChangedEvent(prev, desc)
+	}
 
 	s.subLock.Lock()
 	for _, c := range s.subscribers {
@@ -437,6 +528,29 @@ func (s *Server) updateDescription(desc description.Server) {
 	s.subLock.Unlock()
 }
 
+// heartbeatConnectionOpts returns the options for connections used to monitor the server. These connections are not
+// authenticated and their commands are not monitored.
+func (s *Server) heartbeatConnectionOpts() []ConnectionOption {
+	opts := []ConnectionOption{
+		WithConnectTimeout(func(time.Duration) time.Duration { return s.cfg.heartbeatTimeout }),
+		WithReadTimeout(func(time.Duration) time.Duration { return s.cfg.heartbeatTimeout }),
+		WithWriteTimeout(func(time.Duration) time.Duration { return s.cfg.heartbeatTimeout }),
+	}
+	opts = append(opts, s.cfg.connectionOpts...)
+	// We override whatever handshaker is currently attached to the options with a basic
+	// one because need to make sure we don't do auth.
+	opts = append(opts, WithHandshaker(func(h Handshaker) Handshaker {
+		return operation.NewIsMaster().AppName(s.cfg.appname).Compressors(s.cfg.compressionOpts).
+			ServerAPI(s.cfg.serverAPI)
+	}))
+
+	// Override any command monitors specified in options with nil to avoid monitoring heartbeats.
+	opts = append(opts, WithMonitor(func(*event.CommandMonitor) *event.CommandMonitor {
+		return nil
+	}))
+	return opts
+}
+
 // heartbeat sends a heartbeat to the server using the given connection. The connection can be nil.
 func (s *Server) heartbeat(conn *connection) (description.Server, *connection) {
 	const maxRetry = 2
@@ -456,7 +570,9 @@ func (s *Server) heartbeat(conn *connection) (description.Server, *connection) {
 
 	for i := 1; i <= maxRetry; i++ {
 		var now time.Time
+		var heartbeatStart time.Time
 		var descPtr *description.Server
+		var awaited bool
 
 		if conn != nil && conn.expired() {
 			if conn.nc != nil {
@@ -466,26 +582,11 @@ func (s *Server) heartbeat(conn *connection) (description.Server, *connection) {
 		}
 
 		if conn == nil {
-			opts := []ConnectionOption{
-				WithConnectTimeout(func(time.Duration) time.Duration { return s.cfg.heartbeatTimeout }),
-				WithReadTimeout(func(time.Duration) time.Duration { return s.cfg.heartbeatTimeout }),
-				WithWriteTimeout(func(time.Duration) time.Duration { return s.cfg.heartbeatTimeout }),
-			}
-			opts = append(opts, s.cfg.connectionOpts...)
-			// We override whatever handshaker is currently attached to the options with a basic
-			// one because need to make sure we don't do auth.
-			opts = append(opts, WithHandshaker(func(h Handshaker) Handshaker {
-				now = time.Now()
-				return operation.NewIsMaster().AppName(s.cfg.appname).Compressors(s.cfg.compressionOpts)
-			}))
-
-			// Override any command monitors specified in options with nil to avoid monitoring heartbeats.
-			opts = append(opts, WithMonitor(func(*event.CommandMonitor) *event.CommandMonitor {
-				return nil
-			}))
-
-			conn, err = newConnection(ctx, s.address, opts...)
+			now = time.Now()
+			conn, err = newConnection(ctx, s.address, s.heartbeatConnectionOpts()...)
 
+			heartbeatStart = time.Now()
+			s.publishServerHeartbeatStartedEvent(conn.id, false)
 			conn.connect(ctx)
 
 			err = conn.wait()
@@ -497,11 +598,40 @@ func (s *Server) heartbeat(conn *connection) (description.Server, *connection) {
 		// do a heartbeat because a new connection wasn't created so a handshake was not performed
 		if descPtr == nil && err == nil {
 			now = time.Now()
+			heartbeatStart = now
+			previousDesc := s.Description()
+			streamable := previousDesc.TopologyVersion != nil
+			awaited = conn.currentlyStreaming || streamable
+
+			s.publishServerHeartbeatStartedEvent(conn.id, awaited)
+			heartbeatConn := initConnection{conn}
 			op := operation.
 				NewIsMaster().
 				ClusterClock(s.cfg.clock).
-				Deployment(driver.SingleConnectionDeployment{initConnection{conn}})
-			err = op.Execute(ctx)
+				ServerAPI(s.cfg.serverAPI).
+				Deployment(driver.SingleConnectionDeployment{heartbeatConn})
+			switch {
+			case conn.currentlyStreaming:
+				// The server sent the previous response with the moreToCome flag, so it will send the next response
+				// without a new request.
+				err = s.awaitHeartbeat(conn, func() error { return op.StreamResponse(ctx, heartbeatConn) })
+			case streamable:
+				// The server holds an awaitable isMaster until its topologyVersion changes or maxAwaitTimeMS elapses,
+				// so the socket timeout is extended by the heartbeat interval. A heartbeatTimeout of 0 means that
+				// there is no socket timeout. Setting canStream allows the server to stream subsequent responses.
+				socketTimeout := s.cfg.heartbeatTimeout
+				if socketTimeout != 0 {
+					socketTimeout += s.cfg.heartbeatInterval
+				}
+				conn.setSocketTimeout(socketTimeout)
+				conn.canStream = true
+				op = op.TopologyVersion(previousDesc.TopologyVersion).
+					MaxAwaitTimeMS(int64(s.cfg.heartbeatInterval / time.Millisecond))
+				err = s.awaitHeartbeat(conn, func() error { return op.Execute(ctx) })
+			default:
+				conn.setSocketTimeout(s.cfg.heartbeatTimeout)
+				err = op.Execute(ctx)
+			}
 			if err == nil {
 				tmpDesc := op.Result(s.address)
 				descPtr = &tmpDesc
@@ -514,10 +644,15 @@ func (s *Server) heartbeat(conn *connection) (description.Server, *connection) {
 
 		// we do a retry if the server is connected, if succeed return new server desc (see below)
 		if err != nil {
+			s.publishServerHeartbeatFailedEvent(conn.id, time.Since(heartbeatStart), err, awaited)
 			saved = err
 			conn = nil
+			// A heartbeat cancelled by Disconnect is not a sign that the server is unavailable.
+			if ctx.Err() != nil {
+				break
+			}
 			if wrappedConnErr := unwrapConnectionError(err); wrappedConnErr != nil {
-				s.pool.clear()
+				s.pool.clear(nil)
 				// If the server is not connected, give up and exit loop
 				if s.Description().Kind == description.Unknown {
 					break
@@ -527,10 +662,15 @@ func (s *Server) heartbeat(conn *connection) (description.Server, *connection) {
 		}
 
 		desc = *descPtr
-		delay := time.Since(now)
-		desc = desc.SetAverageRTT(s.updateAverageRTT(delay))
+		// The duration of an awaited heartbeat depends on when the server's state changes, so only the duration of
+		// other heartbeats is used as an RTT sample.
+		if !awaited {
+			s.rttMonitor.addSample(time.Since(now))
+		}
+		desc = desc.SetAverageRTT(s.rttMonitor.getRTT())
 		desc.HeartbeatInterval = s.cfg.heartbeatInterval
 		set = true
+		s.publishServerHeartbeatSucceededEvent(conn.id, time.Since(heartbeatStart), desc, awaited)
 
 		break
 	}
@@ -542,15 +682,95 @@ func (s *Server) heartbeat(conn *connection) (description.Server, *connection) {
 	return desc, conn
 }
 
-func (s *Server) updateAverageRTT(delay time.Duration) time.Duration {
-	if !s.averageRTTSet {
-		s.averageRTT = delay
-		s.averageRTTSet = true
-	} else {
-		alpha := 0.2
-		s.averageRTT = time.Duration(alpha*float64(delay) + (1-alpha)*float64(s.averageRTT))
+// awaitHeartbeat runs an awaited heartbeat on conn. Reads are not interrupted by context cancellation and an awaited
+// heartbeat can block until the server's state changes, so the connection is closed if the server is disconnected
+// while the heartbeat is in progress.
+func (s *Server) awaitHeartbeat(conn *connection, fn func() error) error {
+	finished := make(chan struct{})
+	defer close(finished)
+	go func() {
+		select {
+		case <-finished:
+		case <-s.disconnecting:
+			_ = conn.close()
+		}
+	}()
+
+	return fn()
+}
+
+// publishServerOpeningEvent publishes a ServerOpeningEvent to the server monitor, if one is set.
+func (s *Server) publishServerOpeningEvent() {
+	if s.cfg.serverMonitor == nil || s.cfg.serverMonitor.ServerOpening == nil {
+		return
+	}
+	s.cfg.serverMonitor.ServerOpening(&event.ServerOpeningEvent{
+		Address:    s.address,
+		TopologyID: s.cfg.topologyID,
+	})
+}
+
+// publishServerClosedEvent publishes a ServerClosedEvent to the server monitor, if one is set.
+func (s *Server) publishServerClosedEvent() {
+	if s.cfg.serverMonitor == nil || s.cfg.serverMonitor.ServerClosed == nil {
+		return
+	}
+	s.cfg.serverMonitor.ServerClosed(&event.ServerClosedEvent{
+		Address:    s.address,
+		TopologyID: s.cfg.topologyID,
+	})
+}
+
+// publishServerDescriptionChangedEvent publishes a ServerDescriptionChangedEvent to the server monitor, if one is set.
+func (s *Server) publishServerDescriptionChangedEvent(prev description.Server, current description.Server) {
+	if s.cfg.serverMonitor == nil || s.cfg.serverMonitor.ServerDescriptionChanged == nil {
+		return
+	}
+	s.cfg.serverMonitor.ServerDescriptionChanged(&event.ServerDescriptionChangedEvent{
+		Address:             s.address,
+		TopologyID:          s.cfg.topologyID,
+		PreviousDescription: prev,
+		NewDescription:      current,
+	})
+}
+
+// publishServerHeartbeatStartedEvent publishes a ServerHeartbeatStartedEvent to the server monitor, if one is set.
+func (s *Server) publishServerHeartbeatStartedEvent(connectionID string, awaited bool) {
+	if s.cfg.serverMonitor == nil || s.cfg.serverMonitor.ServerHeartbeatStarted == nil {
+		return
+	}
+	s.cfg.serverMonitor.ServerHeartbeatStarted(&event.ServerHeartbeatStartedEvent{
+		ConnectionID: connectionID,
+		Awaited:      awaited,
+	})
+}
+
+// publishServerHeartbeatSucceededEvent publishes a ServerHeartbeatSucceededEvent to the server monitor, if one is set.
+func (s *Server) publishServerHeartbeatSucceededEvent(connectionID string, duration time.Duration, desc description.Server,
+	awaited bool) {
+
+	if s.cfg.serverMonitor == nil || s.cfg.serverMonitor.ServerHeartbeatSucceeded == nil {
+		return
+	}
+	s.cfg.serverMonitor.ServerHeartbeatSucceeded(&event.ServerHeartbeatSucceededEvent{
+		DurationNanos: duration.Nanoseconds(),
+		Reply:         desc,
+		ConnectionID:  connectionID,
+		Awaited:       awaited,
+	})
+}
+
+// publishServerHeartbeatFailedEvent publishes a ServerHeartbeatFailedEvent to the server monitor, if one is set.
+func (s *Server) publishServerHeartbeatFailedEvent(connectionID string, duration time.Duration, err error, awaited bool) {
+	if s.cfg.serverMonitor == nil || s.cfg.serverMonitor.ServerHeartbeatFailed == nil {
+		return
 	}
-	return s.averageRTT
+	s.cfg.serverMonitor.ServerHeartbeatFailed(&event.ServerHeartbeatFailedEvent{
+		DurationNanos: duration.Nanoseconds(),
+		Failure:       err,
+		ConnectionID:  connectionID,
+		Awaited:       awaited,
+	})
 }
 
 // String implements the Stringer interface.
@@ -11,7 +11,9 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/session"
 )
 
@@ -27,8 +29,12 @@ type serverConfig struct {
 	maxConns                  uint64
 	minConns                  uint64
 	poolMonitor               *event.PoolMonitor
+	serverMonitor             *event.ServerMonitor
 	connectionPoolMaxIdleTime time.Duration
 	registry                  *bsoncodec.Registry
+	topologyID                primitive.ObjectID
+	serverAPI                 *driver.ServerAPIOptions
+	loadBalanced              bool
 }
 
 func newServerConfig(opts ...ServerOption) (*serverConfig, error) {
@@ -130,6 +136,23 @@ func WithConnectionPoolMonitor(fn func(*event.PoolMonitor) *event.PoolMonitor) S
 	}
 }
 
+// WithServerMonitor configures the monitor for all SDAM events for a server.
+func WithServerMonitor(fn func(*event.ServerMonitor) *event.ServerMonitor) ServerOption {
+	return func(cfg *serverConfig) error {
+		cfg.serverMonitor = fn(cfg.serverMonitor)
+		return nil
+	}
+}
+
+// withServerTopologyID configures the ID of the topology that the server is a part of. This is reported in SDAM
+// events.
+func withServerTopologyID(id primitive.ObjectID) ServerOption {
+	return func(cfg *serverConfig) error {
+		cfg.topologyID = id
+		return nil
+	}
+}
+
 // WithClock configures the ClusterClock for the server to use.
 func WithClock(fn func(clock *session.ClusterClock) *session.ClusterClock) ServerOption {
 	return func(cfg *serverConfig) error {
@@ -146,3 +169,20 @@ func WithRegistry(fn func(*bsoncodec.Registry) *bsoncodec.Registry) ServerOption
 		return nil
 	}
 }
+
+// WithServerAPI configures the Stable API version declaration that the server's monitor sends with heartbeats.
+func WithServerAPI(fn func(*driver.ServerAPIOptions) *driver.ServerAPIOptions) ServerOption {
+	return func(cfg *serverConfig) error {
+		cfg.serverAPI = fn(cfg.serverAPI)
+		return nil
+	}
+}
+
+// WithServerLoadBalanced configures whether the server is a load balancer. A load balancer is not monitored and its
+// connection pool is cleared separately for each serviceId.
+func WithServerLoadBalanced(fn func(bool) bool) ServerOption {
+	return func(cfg *serverConfig) error {
+		cfg.loadBalanced = fn(cfg.loadBalanced)
+		return nil
+	}
+}
@@ -42,15 +42,15 @@ func TestServerSelectionRTTSpec(t *testing.T) {
 				var test testCase
 				require.NoError(t, json.Unmarshal(content, &test))
 
-				var server Server
+				var monitor rttMonitor
 
 				if test.AvgRttMs != "NULL" {
 					// If not "NULL", then must be a number, so typecast to float64
-					server.updateAverageRTT(time.Duration(test.AvgRttMs.(float64) * float64(time.Millisecond)))
+					monitor.addSample(time.Duration(test.AvgRttMs.(float64) * float64(time.Millisecond)))
 				}
 
-				server.updateAverageRTT(time.Duration(test.NewRttMs * float64(time.Millisecond)))
-				require.Equal(t, server.averageRTT, time.Duration(test.NewAvgRtt*float64(time.Millisecond)))
+				monitor.addSample(time.Duration(test.NewRttMs * float64(time.Millisecond)))
+				require.Equal(t, monitor.getRTT(), time.Duration(test.NewAvgRtt*float64(time.Millisecond)))
 			})
 		}(t, file)
 	}
@@ -17,6 +17,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/address"
@@ -26,6 +28,31 @@ import (
 	"go.mongodb.org/mongo-driver/x/mongo/driver/wiremessage"
 )
 
+// makeStreamableIsMasterReply creates an isMaster reply from a replica set member that reports the given topologyVersion.
+func makeStreamableIsMasterReply(tv description.TopologyVersion, primary bool) bsoncore.Document {
+	return bsoncore.BuildDocumentFromElements(nil,
+		bsoncore.AppendBooleanElement(nil, "ismaster", primary),
+		bsoncore.AppendBooleanElement(nil, "secondary", !primary),
+		bsoncore.AppendStringElement(nil, "setName", "rs"),
+		bsoncore.AppendInt32Element(nil, "minWireVersion", 0),
+		bsoncore.AppendInt32Element(nil, "maxWireVersion", 9),
+		bsoncore.AppendDocumentElement(nil, "topologyVersion", bsoncore.BuildDocumentFromElements(nil,
+			bsoncore.AppendObjectIDElement(nil, "processId", tv.ProcessID),
+			bsoncore.AppendInt64Element(nil, "counter", tv.Counter),
+		)),
+		bsoncore.AppendDoubleElement(nil, "ok", 1),
+	)
+}
+
+// makeMsgMoreToComeReply creates an OP_MSG reply with the moreToCome flag set.
+func makeMsgMoreToComeReply(doc bsoncore.Document) []byte {
+	idx, dst := wiremessage.AppendHeaderStart(nil, 10, 9, wiremessage.OpMsg)
+	dst = wiremessage.AppendMsgFlags(dst, wiremessage.MoreToCome)
+	dst = wiremessage.AppendMsgSectionType(dst, wiremessage.SingleDocument)
+	dst = append(dst, doc...)
+	return bsoncore.UpdateLength(dst, idx, int32(len(dst[idx:])))
+}
+
 func makeIsMasterReply() []byte {
 	didx, doc := bsoncore.AppendDocumentStart(nil)
 	doc = bsoncore.AppendInt32Element(doc, "ok", 1)
@@ -212,7 +239,7 @@ func TestServer(t *testing.T) {
 			Labels:          []string{},
 			TopologyVersion: nil,
 		}
-		s.ProcessError(wce)
+		s.ProcessError(wce, nil)
 
 		// should set ServerDescription to Unknown
 		resultDesc := s.Description()
@@ -236,7 +263,7 @@ func TestServer(t *testing.T) {
 		s.pool.connected = connected
 
 		wce := driver.WriteConcernError{}
-		s.ProcessError(&wce)
+		s.ProcessError(&wce, nil)
 
 		// should not be a LastError
 		require.Nil(t, s.Description().LastError)
@@ -301,6 +328,123 @@ func TestServer(t *testing.T) {
 			t.Fatal("client metadata not expected in heartbeat but found")
 		}
 	})
+	t.Run("heartbeat monitoring", func(t *testing.T) {
+		var started, succeeded, failed int
+		monitor := &event.ServerMonitor{
+			ServerHeartbeatStarted: func(*event.ServerHeartbeatStartedEvent) {
+				started++
+			},
+			ServerHeartbeatSucceeded: func(*event.ServerHeartbeatSucceededEvent) {
+				succeeded++
+			},
+			ServerHeartbeatFailed: func(*event.ServerHeartbeatFailedEvent) {
+				failed++
+			},
+		}
+		dialer := &channelNetConnDialer{}
+		s, err := NewServer(
+			address.Address("localhost:27017"),
+			WithConnectionOptions(func(connOpts ...ConnectionOption) []ConnectionOption {
+				return append(connOpts, WithDialer(func(Dialer) Dialer { return dialer }))
+			}),
+			WithServerMonitor(func(*event.ServerMonitor) *event.ServerMonitor { return monitor }),
+		)
+		require.NoError(t, err)
+
+		desc, conn := s.heartbeat(nil)
+		require.NotNil(t, conn, "no connection dialed")
+		require.Nil(t, desc.LastError, "unexpected heartbeat error: %v", desc.LastError)
+		require.Equal(t, 1, started, "expected 1 started event, got %v", started)
+		require.Equal(t, 1, succeeded, "expected 1 succeeded event, got %v", succeeded)
+		require.Equal(t, 0, failed, "expected 0 failed events, got %v", failed)
+	})
+	t.Run("streaming heartbeat", func(t *testing.T) {
+		var awaited []bool
+		monitor := &event.ServerMonitor{
+			ServerHeartbeatStarted: func(evt *event.ServerHeartbeatStartedEvent) {
+				awaited = append(awaited, evt.Awaited)
+			},
+		}
+		tv := description.TopologyVersion{ProcessID: primitive.NewObjectID(), Counter: 1}
+		cnc := &drivertest.ChannelNetConn{
+			Written:  make(chan []byte, 1),
+			ReadResp: make(chan []byte, 2),
+		}
+		require.NoError(t, cnc.AddResponse(drivertest.MakeReply(makeStreamableIsMasterReply(tv, true))))
+		s, err := NewServer(
+			address.Address("localhost:27017"),
+			WithConnectionOptions(func(connOpts ...ConnectionOption) []ConnectionOption {
+				return append(connOpts, WithDialer(func(Dialer) Dialer {
+					return DialerFunc(func(context.Context, string, string) (net.Conn, error) { return cnc, nil })
+				}))
+			}),
+			WithHeartbeatInterval(func(time.Duration) time.Duration { return 10 * time.Second }),
+			WithServerMonitor(func(*event.ServerMonitor) *event.ServerMonitor { return monitor }),
+		)
+		require.NoError(t, err)
+
+		// The handshake reports a topologyVersion, so the server supports the streaming protocol.
+		desc, conn := s.heartbeat(nil)
+		require.NotNil(t, conn, "no connection dialed")
+		require.Nil(t, desc.LastError, "unexpected heartbeat error: %v", desc.LastError)
+		require.Equal(t, &tv, desc.TopologyVersion)
+		s.updateDescription(desc)
+		_ = cnc.GetWrittenMessage()
+
+		// The next heartbeat is an awaitable isMaster. The server replies with moreToCome set after its topologyVersion
+		// changes.
+		tv.Counter++
+		require.NoError(t, cnc.AddResponse(makeMsgMoreToComeReply(makeStreamableIsMasterReply(tv, false))))
+		desc, conn = s.heartbeat(conn)
+		require.Nil(t, desc.LastError, "unexpected heartbeat error: %v", desc.LastError)
+		require.Equal(t, description.RSSecondary, desc.Kind)
+		require.True(t, conn.currentlyStreaming, "expected connection to be streaming")
+
+		cmd, err := drivertest.GetCommandFromMsgWireMessage(cnc.GetWrittenMessage())
+		require.NoError(t, err)
+		gotTV, err := cmd.LookupErr("topologyVersion")
+		require.NoError(t, err, "expected awaitable isMaster to include topologyVersion")
+		require.Equal(t, tv.Counter-1, gotTV.Document().Lookup("counter").Int64())
+		maxAwait, err := cmd.LookupErr("maxAwaitTimeMS")
+		require.NoError(t, err, "expected awaitable isMaster to include maxAwaitTimeMS")
+		require.Equal(t, int64(10000), maxAwait.Int64())
+		s.updateDescription(desc)
+
+		// While the connection is streaming, the next response is read without sending another command.
+		tv.Counter++
+		require.NoError(t, cnc.AddResponse(makeMsgMoreToComeReply(makeStreamableIsMasterReply(tv, true))))
+		desc, _ = s.heartbeat(conn)
+		require.Nil(t, desc.LastError, "unexpected heartbeat error: %v", desc.LastError)
+		require.Equal(t, description.RSPrimary, desc.Kind)
+		select {
+		case wm := <-cnc.Written:
+			t.Fatalf("expected no command to be written while streaming, got %v", wm)
+		default:
+		}
+
+		require.Equal(t, []bool{false, true, true}, awaited)
+	})
+	t.Run("description changed monitoring", func(t *testing.T) {
+		var events []*event.ServerDescriptionChangedEvent
+		monitor := &event.ServerMonitor{
+			ServerDescriptionChanged: func(evt *event.ServerDescriptionChangedEvent) {
+				events = append(events, evt)
+			},
+		}
+		s, err := NewServer(address.Address("localhost:27017"),
+			WithServerMonitor(func(*event.ServerMonitor) *event.ServerMonitor { return monitor }))
+		require.NoError(t, err)
+
+		newDesc := description.Server{Addr: s.address, Kind: description.Standalone}
+		s.updateDescription(newDesc)
+		require.Equal(t, 1, len(events), "expected 1 event, got %v", len(events))
+		require.Equal(t, description.ServerKind(description.Unknown), events[0].PreviousDescription.Kind)
+		require.Equal(t, description.Standalone, events[0].NewDescription.Kind)
+
+		// Publishing an equal description should not generate an event.
+		s.updateDescription(newDesc)
+		require.Equal(t, 1, len(events), "expected 1 event, got %v", len(events))
+	})
 	t.Run("WithServerAppName", func(t *testing.T) {
 		name := "test"
 
@@ -21,6 +21,9 @@ import (
 
 	"fmt"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/internal/logger"
 	"go.mongodb.org/mongo-driver/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/address"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/description"
@@ -58,6 +61,8 @@ type Topology struct {
 
 	cfg *config
 
+	id primitive.ObjectID // a unique identifier reported in SDAM events
+
 	desc atomic.Value // holds a description.Topology
 
 	dnsResolver *dns.Resolver
@@ -121,6 +126,7 @@ func New(opts ...Option) (*Topology, error) {
 		subscribers:       make(map[uint64]chan description.Topology),
 		servers:           make(map[address.Address]*Server),
 		dnsResolver:       dns.DefaultResolver,
+		id:                primitive.NewObjectID(),
 	}
 	t.desc.Store(description.Topology{})
 	t.updateCallback = func(desc description.Server) description.Server {
@@ -139,8 +145,21 @@ func New(opts ...Option) (*Topology, error) {
 		t.fsm.Kind = description.Single
 	}
 
+	// A load balanced topology contains only the load balancer, which is never monitored.
+	if cfg.loadBalanced {
+		t.fsm.Kind = description.LoadBalanced
+		t.cfg.serverOpts = append(t.cfg.serverOpts, WithServerLoadBalanced(func(bool) bool { return true }))
+	}
+
 	if t.cfg.uri != "" {
-		t.pollingRequired = strings.HasPrefix(t.cfg.uri, "mongodb+srv://")
+		t.pollingRequired = strings.HasPrefix(t.cfg.uri, "mongodb+srv://") && !cfg.loadBalanced
+	}
+
+	t.cfg.serverOpts = append(t.cfg.serverOpts, withServerTopologyID(t.id))
+	if t.cfg.serverMonitor != nil {
+		t.cfg.serverOpts = append(t.cfg.serverOpts, WithServerMonitor(func(*event.ServerMonitor) *event.ServerMonitor {
+			return t.cfg.serverMonitor
+		}))
 	}
 
 	return t, nil
@@ -156,9 +175,26 @@ func (t *Topology) Connect() error {
 	t.desc.Store(description.Topology{})
 	var err error
 	t.serversLock.Lock()
+	t.publishTopologyOpeningEvent()
+	for _, a := range t.cfg.seedList {
+		addr := address.Address(a).Canonicalize()
+		desc := description.Server{Addr: addr}
+		if t.cfg.loadBalanced {
+			desc.Kind = description.LoadBalancer
+		}
+		t.fsm.Servers = append(t.fsm.Servers, desc)
+	}
+
+	newDesc := description.Topology{
+		Kind:                  t.fsm.Kind,
+		Servers:               t.fsm.Servers,
+		SessionTimeoutMinutes: t.fsm.SessionTimeoutMinutes,
+	}
+	t.desc.Store(newDesc)
+	t.publishTopologyDescriptionChangedEvent(description.Topology{}, newDesc)
+
 	for _, a := range t.cfg.seedList {
 		addr := address.Address(a).Canonicalize()
-		t.fsm.Servers = append(t.fsm.Servers, description.Server{Addr: addr})
 		err = t.addServer(addr)
 		if err != nil {
 			return err
@@ -212,6 +248,7 @@ func (t *Topology) Disconnect(ctx context.Context) error {
 	t.desc.Store(description.Topology{})
 
 	atomic.StoreInt32(&t.connectionstate, disconnected)
+	t.publishTopologyClosedEvent()
 	return nil
 }
 
@@ -292,6 +329,10 @@ func (t *Topology) RequestImmediateCheck() {
 
 // SupportsSessions returns true if the topology supports sessions.
 func (t *Topology) SupportsSessions() bool {
+	// Load balanced deployments always support sessions, but the timeout is not known because they are not monitored.
+	if t.cfg.loadBalanced {
+		return true
+	}
 	return t.Description().SessionTimeoutMinutes != 0 && t.Description().Kind != description.Single
 }
 
@@ -304,6 +345,47 @@ func (t *Topology) SupportsRetryWrites() bool {
 // server selection spec, and will time out after severSelectionTimeout or when the
 // parent context is done.
 func (t *Topology) SelectServer(ctx context.Context, ss description.ServerSelector) (driver.Server, error) {
+	if !t.cfg.logger.LevelComponentEnabled(logger.LevelDebug, logger.ComponentServerSelection) {
+		srvr, err := t.selectServer(ctx, ss)
+		if err != nil {
+			return nil, err
+		}
+		return srvr, nil
+	}
+
+	start := time.Now()
+	t.logServerSelection(logger.LevelDebug, "Server selection started", nil)
+	srvr, err := t.selectServer(ctx, ss)
+	if err != nil {
+		t.logServerSelection(logger.LevelDebug, "Server selection failed", err, "durationMS", durationMS(start))
+		return nil, err
+	}
+	t.logServerSelection(logger.LevelDebug, "Server selection succeeded", nil,
+		"serverAddress", srvr.address.String(),
+		"durationMS", durationMS(start),
+	)
+	return srvr, nil
+}
+
+// logServerSelection logs a server selection message along with the current topology description. If err is non-nil,
+// the message is logged as an error.
+func (t *Topology) logServerSelection(level logger.Level, message string, err error, keysAndValues ...interface{}) {
+	if !t.cfg.logger.LevelComponentEnabled(level, logger.ComponentServerSelection) {
+		return
+	}
+	keysAndValues = append(keysAndValues, "topologyDescription", logger.TopologyString(t.Description()))
+	if err != nil {
+		t.cfg.logger.Error(level, logger.ComponentServerSelection, err, message, keysAndValues...)
+		return
+	}
+	t.cfg.logger.Print(level, logger.ComponentServerSelection, message, keysAndValues...)
+}
+
+func durationMS(start time.Time) int64 {
+	return int64(time.Since(start) / time.Millisecond)
+}
+
+func (t *Topology) selectServer(ctx context.Context, ss description.ServerSelector) (*SelectedServer, error) {
 	if atomic.LoadInt32(&t.connectionstate) != connected {
 		return nil, ErrTopologyClosed
 	}
@@ -317,6 +399,7 @@ func (t *Topology) SelectServer(ctx context.Context, ss description.ServerSelect
 
 	var doneOnce bool
 	var sub *driver.Subscription
+	selectionStart := time.Now()
 	selectionState := newServerSelectionState(ss, ssTimeoutCh)
 	for {
 		var suitable []description.Server
@@ -337,6 +420,13 @@ func (t *Topology) SelectServer(ctx context.Context, ss description.ServerSelect
 					return nil, err
 				}
 				defer t.Unsubscribe(sub)
+
+				remaining := "none"
+				if t.cfg.serverSelectionTimeout > 0 {
+					remaining = (t.cfg.serverSelectionTimeout - time.Since(selectionStart)).String()
+				}
+				t.logServerSelection(logger.LevelInfo, "Waiting for suitable server to become available", nil,
+					"remainingTime", remaining)
 			}
 
 			suitable, selectErr = t.selectServerFromSubscription(ctx, sub.Updates, selectionState)
@@ -469,6 +559,11 @@ func (t *Topology) selectServerFromDescription(desc description.Topology,
 	// Unlike selectServerFromSubscription, this code path does not check ctx.Done or selectionState.timeoutChan because
 	// selecting a server from a description is not a blocking operation.
 
+	// The load balancer is the only server in a load balanced topology and is always selectable.
+	if desc.Kind == description.LoadBalanced {
+		return desc.Servers, nil
+	}
+
 	var allowed []description.Server
 	for _, s := range desc.Servers {
 		if s.Kind != description.Unknown {
@@ -582,7 +677,11 @@ func (t *Topology) processSRVResults(parsedHosts []string) bool {
 		Servers:               t.fsm.Servers,
 		SessionTimeoutMinutes: t.fsm.SessionTimeoutMinutes,
 	}
+	prevDesc := t.Description()
 	t.desc.Store(newDesc)
+	if !prevDesc.Equal(newDesc) {
+		t.publishTopologyDescriptionChangedEvent(prevDesc, newDesc)
+	}
 
 	t.subLock.Lock()
 	for _, ch := range t.subscribers {
@@ -637,6 +736,9 @@ func (t *Topology) apply(ctx context.Context, desc description.Server) descripti
 	}
 
 	t.desc.Store(current)
+	if !prev.Equal(current) {
+		t.publishTopologyDescriptionChangedEvent(prev, current)
+	}
 
 	t.subLock.Lock()
 	for _, ch := range t.subscribers {
@@ -667,6 +769,39 @@ func (t *Topology) addServer(addr address.Address) error {
 	return nil
 }
 
+// publishTopologyDescriptionChangedEvent publishes a TopologyDescriptionChangedEvent to the server monitor, if one is
+// set.
+func (t *Topology) publishTopologyDescriptionChangedEvent(prev description.Topology, current description.Topology) {
+	if t.cfg.serverMonitor == nil || t.cfg.serverMonitor.TopologyDescriptionChanged == nil {
+		return
+	}
+	t.cfg.serverMonitor.TopologyDescriptionChanged(&event.TopologyDescriptionChangedEvent{
+		TopologyID:          t.id,
+		PreviousDescription: prev,
+		NewDescription:      current,
+	})
+}
+
+// publishTopologyOpeningEvent publishes a TopologyOpeningEvent to the server monitor, if one is set.
+func (t *Topology) publishTopologyOpeningEvent() {
+	if t.cfg.serverMonitor == nil || t.cfg.serverMonitor.TopologyOpening == nil {
+		return
+	}
+	t.cfg.serverMonitor.TopologyOpening(&event.TopologyOpeningEvent{
+		TopologyID: t.id,
+	})
+}
+
+// publishTopologyClosedEvent publishes a TopologyClosedEvent to the server monitor, if one is set.
+func (t *Topology) publishTopologyClosedEvent() {
+	if t.cfg.serverMonitor == nil || t.cfg.serverMonitor.TopologyClosed == nil {
+		return
+	}
+	t.cfg.serverMonitor.TopologyClosed(&event.TopologyClosedEvent{
+		TopologyID: t.id,
+	})
+}
+
 // String implements the Stringer interface
 func (t *Topology) String() string {
 	desc := t.Description()
@@ -17,6 +17,8 @@ import (
 	"strings"
 	"time"
 
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/internal/logger"
 	"go.mongodb.org/mongo-driver/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/auth"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/connstring"
@@ -34,6 +36,9 @@ type config struct {
 	cs                     connstring.ConnString // This must not be used for any logic in topology.Topology.
 	uri                    string
 	serverSelectionTimeout time.Duration
+	serverMonitor          *event.ServerMonitor
+	logger                 *logger.Logger
+	loadBalanced           bool
 }
 
 func newConfig(opts ...Option) (*config, error) {
@@ -107,6 +112,10 @@ func WithConnString(fn func(connstring.ConnString) connstring.ConnString) Option
 			c.replicaSetName = cs.ReplicaSet
 		}
 
+		if cs.LoadBalancedSet {
+			c.loadBalanced = cs.LoadBalanced
+		}
+
 		var x509Username string
 		if cs.SSL {
 			tlsConfig := new(tls.Config)
@@ -185,6 +194,7 @@ func WithConnString(fn func(connstring.ConnString) connstring.ConnString) Option
 					AppName:       cs.AppName,
 					Authenticator: authenticator,
 					Compressors:   cs.Compressors,
+					LoadBalanced:  cs.LoadBalanced,
 				}
 				if cs.AuthMechanism == "" {
 					// Required for SASL mechanism negotiation during handshake
@@ -195,7 +205,8 @@ func WithConnString(fn func(connstring.ConnString) connstring.ConnString) Option
 		} else {
 			// We need to add a non-auth Handshaker to the connection options
 			connOpts = append(connOpts, WithHandshaker(func(h driver.Handshaker) driver.Handshaker {
-				return operation.NewIsMaster().AppName(cs.AppName).Compressors(cs.Compressors)
+				return operation.NewIsMaster().AppName(cs.AppName).Compressors(cs.Compressors).
+					LoadBalanced(cs.LoadBalanced)
 			}))
 		}
 
@@ -274,6 +285,32 @@ func WithServerSelectionTimeout(fn func(time.Duration) time.Duration) Option {
 	}
 }
 
+// WithTopologyServerMonitor configures the monitor for all SDAM events. The monitor is also used for the events of
+// every server in the topology.
+func WithTopologyServerMonitor(fn func(*event.ServerMonitor) *event.ServerMonitor) Option {
+	return func(cfg *config) error {
+		cfg.serverMonitor = fn(cfg.serverMonitor)
+		return nil
+	}
+}
+
+// WithLogger configures the logger used to log server selection decisions.
+func WithLogger(fn func(*logger.Logger) *logger.Logger) Option {
+	return func(cfg *config) error {
+		cfg.logger = fn(cfg.logger)
+		return nil
+	}
+}
+
+// WithLoadBalanced configures whether the topology is behind a load balancer. A load balanced topology does not
+// monitor its servers and pins cursors and transactions to the connection they were started on.
+func WithLoadBalanced(fn func(bool) bool) Option {
+	return func(cfg *config) error {
+		cfg.loadBalanced = fn(cfg.loadBalanced)
+		return nil
+	}
+}
+
 // WithURI specifies the URI that was used to create the topology.
 func WithURI(fn func(string) string) Option {
 	return func(cfg *config) error {
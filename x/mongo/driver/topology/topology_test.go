@@ -9,15 +9,19 @@ package topology
 import (
 	"context"
 	"errors"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/internal/testutil/assert"
 	"go.mongodb.org/mongo-driver/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/address"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/connstring"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/drivertest"
 )
 
 const testTimeout = 2 * time.Second
@@ -289,7 +293,7 @@ func TestServerSelection(t *testing.T) {
 		serv, err := topo.FindServer(desc.Servers[0])
 		noerr(t, err)
 		atomic.StoreInt32(&serv.connectionstate, connected)
-		serv.ProcessError(driver.Error{Message: "not master"})
+		serv.ProcessError(driver.Error{Message: "not master"}, nil)
 
 		resp := make(chan []description.Server)
 
@@ -509,6 +513,46 @@ func TestMinPoolSize(t *testing.T) {
 	}
 }
 
+func TestTopologyMonitoring(t *testing.T) {
+	var opened, closed int
+	var changed []*event.TopologyDescriptionChangedEvent
+	var lock sync.Mutex
+	monitor := &event.ServerMonitor{
+		TopologyOpening: func(*event.TopologyOpeningEvent) {
+			opened++
+		},
+		TopologyClosed: func(*event.TopologyClosedEvent) {
+			closed++
+		},
+		TopologyDescriptionChanged: func(evt *event.TopologyDescriptionChangedEvent) {
+			lock.Lock()
+			defer lock.Unlock()
+			changed = append(changed, evt)
+		},
+	}
+	topo, err := New(
+		WithSeedList(func(...string) []string { return []string{"localhost:27017"} }),
+		WithTopologyServerMonitor(func(*event.ServerMonitor) *event.ServerMonitor { return monitor }),
+	)
+	assert.Nil(t, err, "New error: %v", err)
+
+	err = topo.Connect()
+	assert.Nil(t, err, "Connect error: %v", err)
+	assert.Equal(t, 1, opened, "expected 1 opening event, got %v", opened)
+	lock.Lock()
+	assert.True(t, len(changed) > 0, "expected a description changed event")
+	first := changed[0]
+	lock.Unlock()
+	assert.Equal(t, topo.id, first.TopologyID, "expected topology ID %v, got %v", topo.id, first.TopologyID)
+	prevServers, newServers := len(first.PreviousDescription.Servers), len(first.NewDescription.Servers)
+	assert.Equal(t, 0, prevServers, "expected 0 previous servers, got %v", prevServers)
+	assert.Equal(t, 1, newServers, "expected 1 new server, got %v", newServers)
+
+	err = topo.Disconnect(context.Background())
+	assert.Nil(t, err, "Disconnect error: %v", err)
+	assert.Equal(t, 1, closed, "expected 1 closed event, got %v", closed)
+}
+
 func TestTopology_String_Race(t *testing.T) {
 	ch := make(chan bool)
 	topo := &Topology{
@@ -557,3 +601,51 @@ func TestTopologyConstruction(t *testing.T) {
 		}
 	})
 }
+
+func TestLoadBalancedTopology(t *testing.T) {
+	serviceIDs := []primitive.ObjectID{primitive.NewObjectID(), primitive.NewObjectID()}
+	lb, err := drivertest.NewLoadBalancer(serviceIDs...)
+	assert.Nil(t, err, "NewLoadBalancer error: %v", err)
+	defer lb.Close()
+
+	cs, err := connstring.ParseAndValidate("mongodb://" + lb.Addr() + "/?loadBalanced=true")
+	assert.Nil(t, err, "ParseAndValidate error: %v", err)
+	topo, err := New(WithConnString(func(connstring.ConnString) connstring.ConnString { return cs }))
+	assert.Nil(t, err, "topology.New error: %v", err)
+	err = topo.Connect()
+	assert.Nil(t, err, "Connect error: %v", err)
+	defer func() { _ = topo.Disconnect(context.Background()) }()
+
+	desc := topo.Description()
+	assert.Equal(t, description.LoadBalanced, desc.Kind, "expected topology kind %v, got %v", description.LoadBalanced,
+		desc.Kind)
+	assert.Equal(t, 1, len(desc.Servers), "expected 1 server, got %d", len(desc.Servers))
+	assert.Equal(t, description.LoadBalancer, desc.Servers[0].Kind, "expected server kind %v, got %v",
+		description.LoadBalancer, desc.Servers[0].Kind)
+	assert.True(t, topo.SupportsSessions(), "expected load balanced topology to support sessions")
+
+	srvr, err := topo.SelectServer(context.Background(), description.WriteSelector())
+	assert.Nil(t, err, "SelectServer error: %v", err)
+	s := srvr.(*SelectedServer).Server
+
+	conn1, err := s.Connection(context.Background())
+	assert.Nil(t, err, "Connection error: %v", err)
+	conn2, err := s.Connection(context.Background())
+	assert.Nil(t, err, "Connection error: %v", err)
+	defer conn2.Close()
+
+	id1, id2 := conn1.Description().ServiceID, conn2.Description().ServiceID
+	assert.NotNil(t, id1, "expected connection to have a serviceId")
+	assert.NotNil(t, id2, "expected connection to have a serviceId")
+	assert.NotEqual(t, *id1, *id2, "expected connections to be routed to different services")
+
+	// A network error only clears the connections for the service that returned it.
+	s.ProcessError(ConnectionError{Wrapped: errors.New("connection reset")}, conn1)
+	assert.Equal(t, uint64(1), s.pool.getGeneration(id1), "expected generation 1 for %v, got %d", id1,
+		s.pool.getGeneration(id1))
+	assert.Equal(t, uint64(0), s.pool.getGeneration(id2), "expected generation 0 for %v, got %d", id2,
+		s.pool.getGeneration(id2))
+	assert.Equal(t, description.LoadBalancer, s.Description().Kind, "expected server kind %v, got %v",
+		description.LoadBalancer, s.Description().Kind)
+	_ = conn1.Close()
+}